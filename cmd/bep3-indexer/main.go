@@ -0,0 +1,13 @@
+package main
+
+import (
+	"os"
+
+	"github.com/e-money/bep3/module/indexer"
+)
+
+func main() {
+	if err := indexer.NewCmd().Execute(); err != nil {
+		os.Exit(1)
+	}
+}
@@ -3,12 +3,15 @@ module github.com/e-money/bep3
 go 1.15
 
 require (
+	github.com/armon/go-metrics v0.3.6
 	github.com/cosmos/cosmos-sdk v0.42.4
+	github.com/cosmos/go-bip39 v1.0.0
 	github.com/gogo/protobuf v1.3.3
 	github.com/golang/protobuf v1.4.3
 	github.com/gorilla/mux v1.8.0
 	github.com/grpc-ecosystem/grpc-gateway v1.16.0
 	github.com/pkg/errors v0.9.1
+	github.com/spf13/cast v1.3.1
 	github.com/spf13/cobra v1.1.1
 	github.com/spf13/viper v1.7.1
 	github.com/stretchr/testify v1.7.0
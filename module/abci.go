@@ -2,13 +2,17 @@ package bep3
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // BeginBlocker on every block expires outdated atomic swaps and removes closed
 // swap from long term storage (default storage time of 1 week)
 func BeginBlocker(ctx sdk.Context, k Keeper) {
+	defer telemetry.ModuleMeasureSince(ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
 	if ctx.BlockTime().After(ModulePermissionsUpgradeTime) {
 		err := k.EnsureModuleAccountPermissions(ctx)
 		if err != nil {
@@ -18,4 +22,10 @@ func BeginBlocker(ctx sdk.Context, k Keeper) {
 	k.UpdateTimeBasedSupplyLimits(ctx)
 	k.UpdateExpiredAtomicSwaps(ctx)
 	k.DeleteClosedAtomicSwapsFromLongtermStorage(ctx)
+	k.DeleteExpiredDailySwapStats(ctx)
+	k.UpdateDeputyLiveness(ctx)
+	k.CompleteMatureDeputyUnbondings(ctx)
+
+	k.EmitSupplyMetrics(ctx)
+	k.EmitBacklogMetrics(ctx)
 }
@@ -56,9 +56,16 @@ func (suite *ABCITestSuite) ResetKeeper() {
 		randomNumberHash := bep3.CalculateRandomHash(randomNumber[:], timestamp)
 
 		// Create atomic swap and check err to confirm creation
-		_, err := suite.keeper.CreateAtomicSwapState(suite.ctx, randomNumberHash, timestamp, swapTimeSpan,
-			suite.addrs[11], suite.addrs[i], TestSenderOtherChain, TestRecipientOtherChain,
-			amount, true)
+		_, err := suite.keeper.CreateAtomicSwapState(suite.ctx, suite.addrs[11], suite.addrs[i], bep3.MsgCreateAtomicSwap{
+			From:                suite.addrs[11].String(),
+			To:                  suite.addrs[i].String(),
+			RecipientOtherChain: TestRecipientOtherChain,
+			SenderOtherChain:    TestSenderOtherChain,
+			RandomNumberHash:    randomNumberHash,
+			Timestamp:           timestamp,
+			Amount:              amount,
+			TimeSpanMin:         swapTimeSpan,
+		})
 		suite.Nil(err)
 
 		// Store swap's calculated ID and secret random number
@@ -112,7 +119,7 @@ func (suite *ABCITestSuite) TestBeginBlocker_UpdateExpiredAtomicSwaps() {
 		{
 			name:            "after deletion",
 			firstCtx:        suite.getContextPlusMinutes(bep3.DefaultSwapTimeSpanMinutes),
-			secondCtx:       suite.getContextPlusMinutes(bep3.DefaultSwapTimeSpanMinutes + int64(bep3.DefaultLongtermStorageDuration)),
+			secondCtx:       suite.getContextPlusMinutes(bep3.DefaultSwapTimeSpanMinutes + bep3.DefaultLongtermStorageRetention.Value/60),
 			expectedStatus:  bep3.NULL,
 			expectInStorage: false,
 		},
@@ -132,7 +139,7 @@ func (suite *ABCITestSuite) TestBeginBlocker_UpdateExpiredAtomicSwaps() {
 			switch tc.expectedStatus {
 			case bep3.Completed:
 				for i, swapID := range suite.swapIDs {
-					_, err := suite.keeper.ClaimAtomicSwapState(tc.firstCtx, suite.addrs[5], swapID, suite.randomNumbers[i])
+					_, err := suite.keeper.ClaimAtomicSwapState(tc.firstCtx, suite.addrs[5], swapID, suite.randomNumbers[i], nil)
 					suite.Nil(err)
 				}
 			case bep3.NULL:
@@ -168,8 +175,13 @@ func (suite *ABCITestSuite) TestBeginBlocker_DeleteClosedAtomicSwapsFromLongterm
 		Claim  Action = 0x02
 	)
 
+	week := bep3.DefaultLongtermStorageRetention
+	blocks := bep3.NewLongtermStorageRetention(bep3.RetentionUnitBlocks, 100)
+	afterWeek := suite.ctx.BlockTime().Add(time.Duration(week.Value) * time.Second)
+
 	testCases := []struct {
 		name            string
+		retention       bep3.LongtermStorageRetention
 		firstCtx        sdk.Context
 		action          Action
 		secondCtx       sdk.Context
@@ -177,13 +189,15 @@ func (suite *ABCITestSuite) TestBeginBlocker_DeleteClosedAtomicSwapsFromLongterm
 	}{
 		{
 			name:            "no action with long storage duration",
+			retention:       week,
 			firstCtx:        suite.ctx,
 			action:          NULL,
-			secondCtx:       suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + int64(bep3.DefaultLongtermStorageDuration)),
+			secondCtx:       suite.ctx.WithBlockTime(afterWeek),
 			expectInStorage: true,
 		},
 		{
 			name:            "claim with short storage duration",
+			retention:       week,
 			firstCtx:        suite.ctx,
 			action:          Claim,
 			secondCtx:       suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 5000),
@@ -191,13 +205,15 @@ func (suite *ABCITestSuite) TestBeginBlocker_DeleteClosedAtomicSwapsFromLongterm
 		},
 		{
 			name:            "claim with long storage duration",
+			retention:       week,
 			firstCtx:        suite.ctx,
 			action:          Claim,
-			secondCtx:       suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + int64(bep3.DefaultLongtermStorageDuration)),
+			secondCtx:       suite.ctx.WithBlockTime(afterWeek),
 			expectInStorage: false,
 		},
 		{
 			name:            "refund with short storage duration",
+			retention:       week,
 			firstCtx:        suite.ctx,
 			action:          Refund,
 			secondCtx:       suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 5000),
@@ -205,9 +221,26 @@ func (suite *ABCITestSuite) TestBeginBlocker_DeleteClosedAtomicSwapsFromLongterm
 		},
 		{
 			name:            "refund with long storage duration",
+			retention:       week,
 			firstCtx:        suite.ctx,
 			action:          Refund,
-			secondCtx:       suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + int64(bep3.DefaultLongtermStorageDuration)),
+			secondCtx:       suite.ctx.WithBlockTime(afterWeek),
+			expectInStorage: false,
+		},
+		{
+			name:            "claim with short block retention",
+			retention:       blocks,
+			firstCtx:        suite.ctx,
+			action:          Claim,
+			secondCtx:       suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 99).WithBlockTime(afterWeek),
+			expectInStorage: true,
+		},
+		{
+			name:            "claim with long block retention",
+			retention:       blocks,
+			firstCtx:        suite.ctx,
+			action:          Claim,
+			secondCtx:       suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 100),
 			expectInStorage: false,
 		},
 	}
@@ -216,25 +249,33 @@ func (suite *ABCITestSuite) TestBeginBlocker_DeleteClosedAtomicSwapsFromLongterm
 		// Reset keeper and run the initial begin blocker
 		suite.ResetKeeper()
 		suite.Run(tc.name, func() {
+			params := suite.keeper.GetParams(suite.ctx)
+			params.LongtermStorageRetention = tc.retention
+			suite.keeper.SetParams(suite.ctx, params)
+
 			bep3.BeginBlocker(tc.firstCtx, suite.keeper)
 
 			switch tc.action {
 			case Claim:
 				for i, swapID := range suite.swapIDs {
-					_, err := suite.keeper.ClaimAtomicSwapState(tc.firstCtx, suite.addrs[5], swapID, suite.randomNumbers[i])
+					_, err := suite.keeper.ClaimAtomicSwapState(tc.firstCtx, suite.addrs[5], swapID, suite.randomNumbers[i], nil)
 					suite.Nil(err)
 				}
 			case Refund:
+				var lastRefund int64
 				for _, swapID := range suite.swapIDs {
 					swap, _ := suite.keeper.GetAtomicSwap(tc.firstCtx, swapID)
 					refundCtx := suite.ctx.WithBlockTime(time.Unix(int64(swap.ExpireTimestamp), 0))
 					bep3.BeginBlocker(refundCtx, suite.keeper)
 					_, err := suite.keeper.RefundAtomicSwapState(refundCtx, suite.addrs[5], swapID)
 					suite.Nil(err)
-					// Add expiration timestamp to second ctx block timestamp
-					tc.secondCtx = tc.secondCtx.WithBlockTime(
-						time.Unix(tc.secondCtx.BlockTime().Unix()+int64(swap.ExpireTimestamp)-swap.Timestamp, 0))
+					if swap.ExpireTimestamp > lastRefund {
+						lastRefund = swap.ExpireTimestamp
+					}
 				}
+				// Retention counts from the refunds, which happen at expiry rather than at the first block
+				tc.secondCtx = tc.secondCtx.WithBlockTime(
+					tc.secondCtx.BlockTime().Add(time.Duration(lastRefund-tc.firstCtx.BlockTime().Unix()) * time.Second))
 			}
 
 			// Run the second begin blocker
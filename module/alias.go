@@ -12,134 +12,277 @@ import (
 // ALIASGEN: github.com/e-money/bep3/module/types
 
 const (
-	EventTypeCreateAtomicSwap      = types.EventTypeCreateAtomicSwap
-	EventTypeClaimAtomicSwap       = types.EventTypeClaimAtomicSwap
-	EventTypeRefundAtomicSwap      = types.EventTypeRefundAtomicSwap
-	EventTypeSwapsExpired          = types.EventTypeSwapsExpired
-	AttributeValueCategory         = types.AttributeValueCategory
-	AttributeKeySender             = types.AttributeKeySender
-	AttributeKeyRecipient          = types.AttributeKeyRecipient
-	AttributeKeyAtomicSwapID       = types.AttributeKeyAtomicSwapID
-	AttributeKeyRandomNumberHash   = types.AttributeKeyRandomNumberHash
-	AttributeKeyTimestamp          = types.AttributeKeyTimestamp
-	AttributeKeySenderOtherChain   = types.AttributeKeySenderOtherChain
-	AttributeKeyExpireTimestamp    = types.AttributeKeyExpireTimestamp
-	AttributeKeyAmount             = types.AttributeKeyAmount
-	AttributeKeyDirection          = types.AttributeKeyDirection
-	AttributeKeyClaimSender        = types.AttributeKeyClaimSender
-	AttributeKeyRandomNumber       = types.AttributeKeyRandomNumber
-	AttributeKeyRefundSender       = types.AttributeKeyRefundSender
-	AttributeKeyAtomicSwapIDs      = types.AttributeKeyAtomicSwapIDs
-	AttributeExpirationBlock       = types.AttributeExpirationBlock
-	ModuleName                     = types.ModuleName
-	StoreKey                       = types.StoreKey
-	RouterKey                      = types.RouterKey
-	QuerierRoute                   = types.QuerierRoute
-	DefaultParamspace              = types.DefaultParamspace
-	DefaultLongtermStorageDuration = types.DefaultLongtermStorageDuration
-	CreateAtomicSwap               = types.CreateAtomicSwap
-	ClaimAtomicSwap                = types.ClaimAtomicSwap
-	RefundAtomicSwap               = types.RefundAtomicSwap
-	CalcSwapID                     = types.CalcSwapID
-	Int64Size                      = types.Int64Size
-	RandomNumberHashLength         = types.RandomNumberHashLength
-	RandomNumberLength             = types.RandomNumberLength
-	AddrByteCount                  = types.AddrByteCount
-	MaxOtherChainAddrLength        = types.MaxOtherChainAddrLength
-	SwapIDLength                   = types.SwapIDLength
-	MaxExpectedIncomeLength        = types.MaxExpectedIncomeLength
-	QueryGetAssetSupply            = types.QueryGetAssetSupply
-	QueryGetAssetSupplies          = types.QueryGetAssetSupplies
-	QueryGetAtomicSwap             = types.QueryGetAtomicSwap
-	QueryGetAtomicSwaps            = types.QueryGetAtomicSwaps
-	QueryGetParams                 = types.QueryGetParams
-	NULL                           = types.NULL
-	Open                           = types.Open
-	Completed                      = types.Completed
-	Expired                        = types.Expired
-	INVALID                        = types.INVALID
-	Incoming                       = types.Incoming
-	Outgoing                       = types.Outgoing
+	EventTypeCreateAtomicSwap         = types.EventTypeCreateAtomicSwap
+	EventTypeClaimAtomicSwap          = types.EventTypeClaimAtomicSwap
+	EventTypeRefundAtomicSwap         = types.EventTypeRefundAtomicSwap
+	EventTypeSwapsExpired             = types.EventTypeSwapsExpired
+	AttributeValueCategory            = types.AttributeValueCategory
+	AttributeKeySender                = types.AttributeKeySender
+	AttributeKeyRecipient             = types.AttributeKeyRecipient
+	AttributeKeyAtomicSwapID          = types.AttributeKeyAtomicSwapID
+	AttributeKeyRandomNumberHash      = types.AttributeKeyRandomNumberHash
+	AttributeKeyTimestamp             = types.AttributeKeyTimestamp
+	AttributeKeySenderOtherChain      = types.AttributeKeySenderOtherChain
+	AttributeKeyRecipientOtherChain   = types.AttributeKeyRecipientOtherChain
+	AttributeKeyExpireTimestamp       = types.AttributeKeyExpireTimestamp
+	AttributeKeyAmount                = types.AttributeKeyAmount
+	AttributeKeyDirection             = types.AttributeKeyDirection
+	AttributeKeyClaimSender           = types.AttributeKeyClaimSender
+	AttributeKeyRandomNumber          = types.AttributeKeyRandomNumber
+	AttributeKeyClaimTip              = types.AttributeKeyClaimTip
+	AttributeKeyRefundSender          = types.AttributeKeyRefundSender
+	AttributeKeyAtomicSwapIDs         = types.AttributeKeyAtomicSwapIDs
+	AttributeExpirationBlock          = types.AttributeExpirationBlock
+	EventTypeUpdateDenyList           = types.EventTypeUpdateDenyList
+	EventTypeAnnotateSwap             = types.EventTypeAnnotateSwap
+	EventTypeDeputyInactive           = types.EventTypeDeputyInactive
+	EventTypeDeputyActive             = types.EventTypeDeputyActive
+	EventTypeDeputyBond               = types.EventTypeDeputyBond
+	EventTypeDeputyUnbond             = types.EventTypeDeputyUnbond
+	EventTypeCompleteUnbond           = types.EventTypeCompleteUnbond
+	EventTypeSlashDeputy              = types.EventTypeSlashDeputy
+	EventTypeSetLightClient           = types.EventTypeSetLightClient
+	AttributeKeyDenied                = types.AttributeKeyDenied
+	AttributeKeyAllowed               = types.AttributeKeyAllowed
+	AttributeKeyMemo                  = types.AttributeKeyMemo
+	AttributeKeyOtherChainTxHash      = types.AttributeKeyOtherChainTxHash
+	AttributeKeyAnnotateSender        = types.AttributeKeyAnnotateSender
+	AttributeKeyDenom                 = types.AttributeKeyDenom
+	AttributeKeyDeputy                = types.AttributeKeyDeputy
+	AttributeKeyLastActiveHeight      = types.AttributeKeyLastActiveHeight
+	AttributeKeyCompletionTime        = types.AttributeKeyCompletionTime
+	AttributeKeySlashReason           = types.AttributeKeySlashReason
+	AttributeKeyChannel               = types.AttributeKeyChannel
+	AttributeKeyPacketSequence        = types.AttributeKeyPacketSequence
+	AttributeKeyError                 = types.AttributeKeyError
+	AttributeKeyPartialClaims         = types.AttributeKeyPartialClaims
+	AttributeKeyRemainingAmount       = types.AttributeKeyRemainingAmount
+	AttributeKeyChainID               = types.AttributeKeyChainID
+	AttributeKeyHeight                = types.AttributeKeyHeight
+	AttributeValueSlashReasonRefund   = types.AttributeValueSlashReasonRefund
+	AttributeValueSlashReasonEvidence = types.AttributeValueSlashReasonEvidence
+	ProposalTypeUpdateDenyList        = types.ProposalTypeUpdateDenyList
+	ProposalTypeDeputySlash           = types.ProposalTypeDeputySlash
+	ProposalTypeSetLightClientState   = types.ProposalTypeSetLightClientState
+	QueryGetDenyList                  = types.QueryGetDenyList
+	ModuleName                        = types.ModuleName
+	StoreKey                          = types.StoreKey
+	RouterKey                         = types.RouterKey
+	QuerierRoute                      = types.QuerierRoute
+	DefaultParamspace                 = types.DefaultParamspace
+	LegacyLongtermStorageKeyLength    = types.LegacyLongtermStorageKeyLength
+	RetentionUnitSeconds              = types.RetentionUnitSeconds
+	RetentionUnitBlocks               = types.RetentionUnitBlocks
+	CreateAtomicSwap                  = types.CreateAtomicSwap
+	ClaimAtomicSwap                   = types.ClaimAtomicSwap
+	RefundAtomicSwap                  = types.RefundAtomicSwap
+	BatchClaimAtomicSwaps             = types.BatchClaimAtomicSwaps
+	BatchRefundAtomicSwaps            = types.BatchRefundAtomicSwaps
+	AnnotateSwap                      = types.AnnotateSwap
+	BondDeputy                        = types.BondDeputy
+	UnbondDeputy                      = types.UnbondDeputy
+	CreateInterchainSwap              = types.CreateInterchainSwap
+	CreateLocalSwap                   = types.CreateLocalSwap
+	PortID                            = types.PortID
+	Version                           = types.Version
+	CalcSwapID                        = types.CalcSwapID
+	Int64Size                         = types.Int64Size
+	RandomNumberHashLength            = types.RandomNumberHashLength
+	RandomNumberLength                = types.RandomNumberLength
+	AddrByteCount                     = types.AddrByteCount
+	MaxOtherChainAddrLength           = types.MaxOtherChainAddrLength
+	SwapIDLength                      = types.SwapIDLength
+	MaxExpectedIncomeLength           = types.MaxExpectedIncomeLength
+	MaxBatchSize                      = types.MaxBatchSize
+	SecondsPerDay                     = types.SecondsPerDay
+	DailySwapStatsRetentionDays       = types.DailySwapStatsRetentionDays
+	MaxMemoLength                     = types.MaxMemoLength
+	MaxOtherChainTxHashLength         = types.MaxOtherChainTxHashLength
+	MetricKeySwaps                    = types.MetricKeySwaps
+	MetricKeySwapsCreated             = types.MetricKeySwapsCreated
+	MetricKeySwapsClaimed             = types.MetricKeySwapsClaimed
+	MetricKeySwapsRefunded            = types.MetricKeySwapsRefunded
+	MetricKeySwapsExpired             = types.MetricKeySwapsExpired
+	MetricKeyOpenSwaps                = types.MetricKeyOpenSwaps
+	MetricKeyCurrentSupply            = types.MetricKeyCurrentSupply
+	MetricKeyIncomingSupply           = types.MetricKeyIncomingSupply
+	MetricKeyOutgoingSupply           = types.MetricKeyOutgoingSupply
+	MetricKeyTimeLimitedUtilization   = types.MetricKeyTimeLimitedUtilization
+	MetricLabelDenom                  = types.MetricLabelDenom
+	MetricLabelDirection              = types.MetricLabelDirection
+	QueryGetAssetSupply               = types.QueryGetAssetSupply
+	QueryGetAssetSupplies             = types.QueryGetAssetSupplies
+	QueryGetAtomicSwap                = types.QueryGetAtomicSwap
+	QueryGetAtomicSwaps               = types.QueryGetAtomicSwaps
+	QueryGetParams                    = types.QueryGetParams
+	NULL                              = types.NULL
+	Open                              = types.Open
+	Completed                         = types.Completed
+	Expired                           = types.Expired
+	INVALID                           = types.INVALID
+	Incoming                          = types.Incoming
+	Outgoing                          = types.Outgoing
+	Local                             = types.Local
 )
 
 var (
 	// functions aliases
-	NewKeeper                  = keeper.NewKeeper
-	NewQuerier                 = keeper.NewQuerier
-	NewAssetSupply             = types.NewAssetSupply
-	NewGenesisState            = types.NewGenesisState
-	DefaultGenesisState        = types.DefaultGenesisState
-	GenerateSecureRandomNumber = types.GenerateSecureRandomNumber
-	CalculateRandomHash        = types.CalculateRandomHash
-	CalculateSwapID            = types.CalculateSwapID
-	GetAtomicSwapByHeightKey   = types.GetAtomicSwapByTimestampKey
-	NewMsgCreateAtomicSwap     = types.NewMsgCreateAtomicSwap
-	NewMsgClaimAtomicSwap      = types.NewMsgClaimAtomicSwap
-	NewMsgRefundAtomicSwap     = types.NewMsgRefundAtomicSwap
-	NewParams                  = types.NewParams
-	DefaultParams              = types.DefaultParams
-	NewAssetParam              = types.NewAssetParam
-	ParamKeyTable              = types.ParamKeyTable
-	NewQueryAssetSupply        = types.NewQueryAssetSupply
-	NewQueryAssetSupplies      = types.NewQueryAssetSupplies
-	NewQueryAtomicSwapByID     = types.NewQueryAtomicSwapByID
-	NewQueryAtomicSwaps        = types.NewQueryAtomicSwaps
-	NewAtomicSwap              = types.NewAtomicSwap
-	NewSwapStatusFromString    = types.NewSwapStatusFromString
-	NewSwapDirectionFromString = types.NewSwapDirectionFromString
-	NewAugmentedAtomicSwap     = types.NewAugmentedAtomicSwap
+	NewKeeper                      = keeper.NewKeeper
+	NewQuerier                     = keeper.NewQuerier
+	NewAssetSupply                 = types.NewAssetSupply
+	NewGenesisState                = types.NewGenesisState
+	DefaultGenesisState            = types.DefaultGenesisState
+	GenerateSecureRandomNumber     = types.GenerateSecureRandomNumber
+	CalculateRandomHash            = types.CalculateRandomHash
+	CalculateLocalSwapID           = types.CalculateLocalSwapID
+	CalculateSwapID                = types.CalculateSwapID
+	GetAtomicSwapByHeightKey       = types.GetAtomicSwapByTimestampKey
+	NewMsgCreateAtomicSwap         = types.NewMsgCreateAtomicSwap
+	NewMsgClaimAtomicSwap          = types.NewMsgClaimAtomicSwap
+	NewMsgRefundAtomicSwap         = types.NewMsgRefundAtomicSwap
+	NewBatchClaimItem              = types.NewBatchClaimItem
+	NewMsgBatchClaimAtomicSwaps    = types.NewMsgBatchClaimAtomicSwaps
+	NewMsgBatchRefundAtomicSwaps   = types.NewMsgBatchRefundAtomicSwaps
+	NewMsgAnnotateSwap             = types.NewMsgAnnotateSwap
+	NewMsgDeputyBond               = types.NewMsgDeputyBond
+	NewMsgDeputyUnbond             = types.NewMsgDeputyUnbond
+	NewMsgCreateInterchainSwap     = types.NewMsgCreateInterchainSwap
+	NewMsgCreateLocalSwap          = types.NewMsgCreateLocalSwap
+	NewSwapStats                   = types.NewSwapStats
+	NewDeputyActivity              = types.NewDeputyActivity
+	NewAssetSuspension             = types.NewAssetSuspension
+	NewDeputyBond                  = types.NewDeputyBond
+	NewDeputyUnbonding             = types.NewDeputyUnbonding
+	GetDeputyUnbondingKey          = types.GetDeputyUnbondingKey
+	GetDayStart                    = types.GetDayStart
+	GetDailySwapStatsKey           = types.GetDailySwapStatsKey
+	GetDailySwapStatsDenomPrefix   = types.GetDailySwapStatsDenomPrefix
+	NewParams                      = types.NewParams
+	NewLongtermStorageRetention    = types.NewLongtermStorageRetention
+	DefaultParams                  = types.DefaultParams
+	NewAssetParam                  = types.NewAssetParam
+	ParamKeyTable                  = types.ParamKeyTable
+	NewQueryAssetSupply            = types.NewQueryAssetSupply
+	NewQueryAssetSupplies          = types.NewQueryAssetSupplies
+	NewQueryAtomicSwapByID         = types.NewQueryAtomicSwapByID
+	NewQueryAtomicSwaps            = types.NewQueryAtomicSwaps
+	NewAtomicSwap                  = types.NewAtomicSwap
+	NewSwapStatusFromString        = types.NewSwapStatusFromString
+	NewSwapDirectionFromString     = types.NewSwapDirectionFromString
+	NewAugmentedAtomicSwap         = types.NewAugmentedAtomicSwap
+	NewUpdateDenyListProposal      = types.NewUpdateDenyListProposal
+	NewDeputySlashProposal         = types.NewDeputySlashProposal
+	NewSetLightClientStateProposal = types.NewSetLightClientStateProposal
+	NormalizeDenyListAddress       = types.NormalizeDenyListAddress
+	ValidateClaimTip               = types.ValidateClaimTip
+	ValidateSwapMetadata           = types.ValidateSwapMetadata
+	NewLightClientState            = types.NewLightClientState
+	ValidateSwapProof              = types.ValidateSwapProof
+	MockHTLCLeaf                   = types.MockHTLCLeaf
 
 	// variable aliases
-	ModuleCdc                       = types.ModuleCdc
-	ErrInvalidTimestamp             = types.ErrInvalidTimestamp
-	ErrInvalidTimeSpan              = types.ErrInvalidTimeSpan
-	ErrInsufficientAmount           = types.ErrInsufficientAmount
-	ErrAssetNotSupported            = types.ErrAssetNotSupported
-	ErrAssetNotActive               = types.ErrAssetNotActive
-	ErrAssetSupplyNotFound          = types.ErrAssetSupplyNotFound
-	ErrExceedsSupplyLimit           = types.ErrExceedsSupplyLimit
-	ErrExceedsAvailableSupply       = types.ErrExceedsAvailableSupply
-	ErrInvalidCurrentSupply         = types.ErrInvalidCurrentSupply
-	ErrInvalidIncomingSupply        = types.ErrInvalidIncomingSupply
-	ErrInvalidOutgoingSupply        = types.ErrInvalidOutgoingSupply
-	ErrInvalidClaimSecret           = types.ErrInvalidClaimSecret
-	ErrAtomicSwapAlreadyExists      = types.ErrAtomicSwapAlreadyExists
-	ErrAtomicSwapNotFound           = types.ErrAtomicSwapNotFound
-	ErrSwapNotRefundable            = types.ErrSwapNotRefundable
-	ErrSwapNotClaimable             = types.ErrSwapNotClaimable
-	ErrInvalidAmount                = types.ErrInvalidAmount
-	ErrInvalidSwapAccount           = types.ErrInvalidSwapAccount
-	AtomicSwapKeyPrefix             = types.AtomicSwapKeyPrefix
-	AtomicSwapByBlockPrefix         = types.AtomicSwapByBlockPrefix
-	AtomicSwapLongtermStoragePrefix = types.AtomicSwapLongtermStoragePrefix
-	AtomicSwapCoinsAccAddr          = types.AtomicSwapCoinsAccAddr
-	KeyAssetParams                  = types.KeyAssetParams
-	DefaultPreviousBlockTime        = types.DefaultPreviousBlockTime
-	DefaultSwapBlockTimestamp       = types.DefaultSwapBlockTimestamp
-	DefaultSwapTimeSpanMinutes      = types.DefaultSwapTimeSpanMinutes
-	ModulePermissionsUpgradeTime    = types.ModulePermissionsUpgradeTime
+	ModuleCdc                          = types.ModuleCdc
+	ErrInvalidTimestamp                = types.ErrInvalidTimestamp
+	ErrInvalidTimeSpan                 = types.ErrInvalidTimeSpan
+	ErrInsufficientAmount              = types.ErrInsufficientAmount
+	ErrAssetNotSupported               = types.ErrAssetNotSupported
+	ErrAssetNotActive                  = types.ErrAssetNotActive
+	ErrAssetSupplyNotFound             = types.ErrAssetSupplyNotFound
+	ErrExceedsSupplyLimit              = types.ErrExceedsSupplyLimit
+	ErrExceedsAvailableSupply          = types.ErrExceedsAvailableSupply
+	ErrInvalidCurrentSupply            = types.ErrInvalidCurrentSupply
+	ErrInvalidIncomingSupply           = types.ErrInvalidIncomingSupply
+	ErrInvalidOutgoingSupply           = types.ErrInvalidOutgoingSupply
+	ErrInvalidClaimSecret              = types.ErrInvalidClaimSecret
+	ErrAtomicSwapAlreadyExists         = types.ErrAtomicSwapAlreadyExists
+	ErrAtomicSwapNotFound              = types.ErrAtomicSwapNotFound
+	ErrSwapNotRefundable               = types.ErrSwapNotRefundable
+	ErrSwapNotClaimable                = types.ErrSwapNotClaimable
+	ErrInvalidAmount                   = types.ErrInvalidAmount
+	ErrInvalidSwapAccount              = types.ErrInvalidSwapAccount
+	ErrAddressDenied                   = types.ErrAddressDenied
+	ErrInvalidDenyListProposal         = types.ErrInvalidDenyListProposal
+	ErrInvalidClaimTip                 = types.ErrInvalidClaimTip
+	ErrInvalidBatch                    = types.ErrInvalidBatch
+	ErrInvalidSwapMetadata             = types.ErrInvalidSwapMetadata
+	ErrSwapAlreadyAnnotated            = types.ErrSwapAlreadyAnnotated
+	ErrDeputyInactive                  = types.ErrDeputyInactive
+	ErrInsufficientDeputyBond          = types.ErrInsufficientDeputyBond
+	ErrInvalidDeputySlash              = types.ErrInvalidDeputySlash
+	ErrInvalidLightClientState         = types.ErrInvalidLightClientState
+	ErrInvalidSwapProof                = types.ErrInvalidSwapProof
+	ErrLightClientNotFound             = types.ErrLightClientNotFound
+	ErrInvalidInterchainSwap           = types.ErrInvalidInterchainSwap
+	ErrInvalidChannel                  = types.ErrInvalidChannel
+	ErrInvalidPartialClaim             = types.ErrInvalidPartialClaim
+	DenyListPrefix                     = types.DenyListPrefix
+	AtomicSwapByRandomNumberHashPrefix = types.AtomicSwapByRandomNumberHashPrefix
+	SwapStatsPrefix                    = types.SwapStatsPrefix
+	DailySwapStatsPrefix               = types.DailySwapStatsPrefix
+	DeputyActivityPrefix               = types.DeputyActivityPrefix
+	AssetSuspensionPrefix              = types.AssetSuspensionPrefix
+	DeputyBondPrefix                   = types.DeputyBondPrefix
+	DeputyUnbondingQueuePrefix         = types.DeputyUnbondingQueuePrefix
+	LightClientStatePrefix             = types.LightClientStatePrefix
+	OpenSwapCountKey                   = types.OpenSwapCountKey
+	AtomicSwapKeyPrefix                = types.AtomicSwapKeyPrefix
+	AtomicSwapByBlockPrefix            = types.AtomicSwapByBlockPrefix
+	AtomicSwapLongtermStoragePrefix    = types.AtomicSwapLongtermStoragePrefix
+	AtomicSwapCoinsAccAddr             = types.AtomicSwapCoinsAccAddr
+	KeyAssetParams                     = types.KeyAssetParams
+	KeyLongtermStorageRetention        = types.KeyLongtermStorageRetention
+	DefaultLongtermStorageRetention    = types.DefaultLongtermStorageRetention
+	DefaultPreviousBlockTime           = types.DefaultPreviousBlockTime
+	DefaultSwapBlockTimestamp          = types.DefaultSwapBlockTimestamp
+	DefaultSwapTimeSpanMinutes         = types.DefaultSwapTimeSpanMinutes
+	ModulePermissionsUpgradeTime       = types.ModulePermissionsUpgradeTime
 )
 
 type (
-	Keeper               = keeper.Keeper
-	AssetSupply          = types.AssetSupply
-	AssetSupplies        = types.AssetSupplies
-	GenesisState         = types.GenesisState
-	MsgCreateAtomicSwap  = types.MsgCreateAtomicSwap
-	MsgClaimAtomicSwap   = types.MsgClaimAtomicSwap
-	MsgRefundAtomicSwap  = types.MsgRefundAtomicSwap
-	Params               = types.Params
-	AssetParam           = types.AssetParam
-	AssetParams          = types.AssetParams
-	QueryAssetSupply     = types.QueryAssetSupply
-	QueryAssetSupplies   = types.QueryAssetSupplies
-	QueryAtomicSwapByID  = types.QueryAtomicSwapByID
-	QueryAtomicSwaps     = types.QueryAtomicSwaps
-	AtomicSwap           = types.AtomicSwap
-	AtomicSwaps          = types.AtomicSwaps
-	SwapStatus           = types.SwapStatus
-	SwapDirection        = types.SwapDirection
-	SupplyLimit          = types.SupplyLimit
-	AugmentedAtomicSwap  = types.AugmentedAtomicSwap
-	AugmentedAtomicSwaps = types.AugmentedAtomicSwaps
+	Keeper                      = keeper.Keeper
+	AssetSupply                 = types.AssetSupply
+	AssetSupplies               = types.AssetSupplies
+	GenesisState                = types.GenesisState
+	MsgCreateAtomicSwap         = types.MsgCreateAtomicSwap
+	MsgClaimAtomicSwap          = types.MsgClaimAtomicSwap
+	MsgRefundAtomicSwap         = types.MsgRefundAtomicSwap
+	BatchClaimItem              = types.BatchClaimItem
+	BatchItemResult             = types.BatchItemResult
+	MsgBatchClaimAtomicSwaps    = types.MsgBatchClaimAtomicSwaps
+	MsgBatchRefundAtomicSwaps   = types.MsgBatchRefundAtomicSwaps
+	MsgAnnotateSwap             = types.MsgAnnotateSwap
+	MsgDeputyBond               = types.MsgDeputyBond
+	MsgDeputyUnbond             = types.MsgDeputyUnbond
+	MsgCreateInterchainSwap     = types.MsgCreateInterchainSwap
+	MsgCreateLocalSwap          = types.MsgCreateLocalSwap
+	InterchainSwapPacketData    = types.InterchainSwapPacketData
+	SwapStats                   = types.SwapStats
+	DailySwapStats              = types.DailySwapStats
+	DeputyActivity              = types.DeputyActivity
+	AssetSuspension             = types.AssetSuspension
+	DeputyBond                  = types.DeputyBond
+	DeputyUnbonding             = types.DeputyUnbonding
+	LightClientState            = types.LightClientState
+	SwapProof                   = types.SwapProof
+	SwapVerifier                = types.SwapVerifier
+	MockSwapVerifier            = types.MockSwapVerifier
+	DeputyHealth                = types.DeputyHealth
+	Params                      = types.Params
+	LongtermStorageRetention    = types.LongtermStorageRetention
+	AssetParam                  = types.AssetParam
+	AssetParams                 = types.AssetParams
+	QueryAssetSupply            = types.QueryAssetSupply
+	QueryAssetSupplies          = types.QueryAssetSupplies
+	QueryAtomicSwapByID         = types.QueryAtomicSwapByID
+	QueryAtomicSwaps            = types.QueryAtomicSwaps
+	AtomicSwap                  = types.AtomicSwap
+	AtomicSwaps                 = types.AtomicSwaps
+	SwapStatus                  = types.SwapStatus
+	SwapDirection               = types.SwapDirection
+	SupplyLimit                 = types.SupplyLimit
+	AugmentedAtomicSwap         = types.AugmentedAtomicSwap
+	AugmentedAtomicSwaps        = types.AugmentedAtomicSwaps
+	UpdateDenyListProposal      = types.UpdateDenyListProposal
+	DeputySlashProposal         = types.DeputySlashProposal
+	SetLightClientStateProposal = types.SetLightClientStateProposal
 )
@@ -0,0 +1,151 @@
+package cli_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/e-money/bep3/module/client/cli"
+	"github.com/e-money/bep3/module/types"
+	"github.com/e-money/bep3/testapp"
+	"github.com/stretchr/testify/suite"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"google.golang.org/grpc"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	cfg     network.Config
+	network *network.Network
+	denom   string
+	deputy  sdk.AccAddress
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	s.cfg = testapp.DefaultNetworkConfig()
+
+	// Validators are funded with <moniker>token, which the first validator swaps out to the deputy
+	s.denom = "node0token"
+	s.deputy = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	genesis := types.DefaultGenesisState()
+	genesis.Params.AssetParams = types.AssetParams{
+		{
+			Denom:  s.denom,
+			CoinID: 714,
+			SupplyLimit: types.SupplyLimit{
+				Limit:          sdk.NewInt(1000000000000),
+				TimeBasedLimit: sdk.ZeroInt(),
+			},
+			Active:          true,
+			DeputyAddress:   s.deputy.String(),
+			FixedFee:        sdk.NewInt(1000),
+			MinSwapAmount:   sdk.OneInt(),
+			MaxSwapAmount:   sdk.NewInt(1000000000),
+			SwapTimestamp:   types.DefaultSwapBlockTimestamp,
+			SwapTimeSpanMin: types.DefaultSwapTimeSpanMinutes,
+		},
+	}
+	genesis.Supplies = types.AssetSupplies{
+		AssetSupplies: []types.AssetSupply{
+			types.NewAssetSupply(sdk.NewInt64Coin(s.denom, 0), sdk.NewInt64Coin(s.denom, 0),
+				sdk.NewInt64Coin(s.denom, 500000000), sdk.NewInt64Coin(s.denom, 0), 0),
+		},
+	}
+	s.cfg.GenesisState[types.ModuleName] = s.cfg.Codec.MustMarshalJSON(genesis)
+
+	s.network = network.New(s.T(), s.cfg)
+	_, err := s.network.WaitForHeight(1)
+	s.Require().NoError(err)
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.network.Cleanup()
+}
+
+func (s *IntegrationTestSuite) TestCreateAtomicSwap() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	args := []string{
+		s.deputy.String(), "0xrecipient", "0xsender", "now", fmt.Sprintf("50000%s", s.denom), "60",
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10))),
+	}
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdCreateAtomicSwap(), args)
+	s.Require().NoError(err)
+
+	var txRes sdk.TxResponse
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &txRes), out.String())
+	s.Require().Equal(uint32(0), txRes.Code, txRes.RawLog)
+
+	// The outgoing swap is locked in the module and counted in the outgoing supply
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cli.QueryGetAssetSupplyCmd(),
+		[]string{s.denom, fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+	var supplyRes types.QueryAssetSupplyResponse
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &supplyRes), out.String())
+	s.Require().Equal(sdk.NewInt64Coin(s.denom, 50000), supplyRes.Supply.OutgoingSupply)
+
+	// Queries over gRPC see the same swap
+	conn, err := grpc.Dial(val.AppConfig.GRPC.Address, grpc.WithInsecure())
+	s.Require().NoError(err)
+	defer conn.Close()
+
+	queryClient := types.NewQueryClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	swapsRes, err := queryClient.Swaps(ctx, &types.QuerySwapsRequest{
+		Params: &types.QueryAtomicSwaps{Page: 1, Limit: 10, Involve: s.deputy.String()},
+	})
+	s.Require().NoError(err)
+	swaps := swapsRes.Swaps.AugmentedAtomicSwaps
+	s.Require().Len(swaps, 1)
+	s.Require().Equal(val.Address.String(), swaps[0].Sender)
+	s.Require().Equal(types.Open, swaps[0].Status)
+	s.Require().Equal(types.Outgoing, swaps[0].Direction)
+
+	// A refund before expiry is rejected by the chain
+	args = []string{
+		swaps[0].ID,
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10))),
+	}
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdRefundAtomicSwap(), args)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &txRes), out.String())
+	s.Require().Equal(types.ErrSwapNotRefundable.ABCICode(), txRes.Code, txRes.RawLog)
+}
+
+func (s *IntegrationTestSuite) TestQueryGRPCGateway() {
+	val := s.network.Validators[0]
+
+	resp, err := rest.GetRequest(fmt.Sprintf("%s/e-money/bep3/capacity/%s", val.APIAddress, s.denom))
+	s.Require().NoError(err)
+
+	var res types.QueryAssetCapacityResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(resp, &res), string(resp))
+	s.Require().Equal(s.denom, res.Capacity.Denom)
+	s.Require().True(res.Capacity.OutgoingCapacity.IsPositive())
+
+	resp, err = rest.GetRequest(fmt.Sprintf("%s/e-money/bep3/capacity/%s", val.APIAddress, "unknown"))
+	s.Require().NoError(err)
+	s.Require().True(strings.Contains(string(resp), types.ErrAssetNotSupported.Error()), string(resp))
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/version"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/e-money/bep3/module/types"
+	"github.com/spf13/cobra"
+)
+
+// GetGenesisCmd returns the cli commands of this module that work on a genesis file
+func GetGenesisCmd() *cobra.Command {
+	// Group bep3 genesis commands under a subcommand
+	bep3GenesisCmd := &cobra.Command{
+		Use:                        "bep3",
+		Short:                      "Genesis file commands for the bep3 module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	bep3GenesisCmd.AddCommand(
+		GetCmdValidateGenesis(),
+	)
+
+	return bep3GenesisCmd
+}
+
+// GetCmdValidateGenesis validates the bep3 state of a genesis file, reporting every problem found
+func GetCmdValidateGenesis() *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate-genesis [file]",
+		Short: "validate the bep3 state of the genesis file at the default location or the given one",
+		Long: `Check the bep3 state of a genesis file, including the consistency of its swaps, asset supplies
+and params, and list every problem found. Problems with a swap name the swap ID.`,
+		Example: fmt.Sprintf("$ %s %s validate-genesis path/to/genesis.json", version.AppName, types.ModuleName),
+		Args:    cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			// Load default if passed no args, otherwise load passed file
+			genFile := serverCtx.Config.GenesisFile()
+			if len(args) > 0 {
+				genFile = args[0]
+			}
+
+			appState, _, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return err
+			}
+			var gs types.GenesisState
+			if err := clientCtx.JSONMarshaler.UnmarshalJSON(appState[types.ModuleName], &gs); err != nil {
+				return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+			}
+
+			errs := gs.ValidateAll()
+			for _, err := range errs {
+				cmd.Println(err)
+			}
+			if len(errs) > 0 {
+				return fmt.Errorf("found %d problems in the %s genesis state of %s", len(errs), types.ModuleName, genFile)
+			}
+
+			cmd.Printf("The %s genesis state of %s is valid\n", types.ModuleName, genFile)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,83 @@
+package cli_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/bep3/module/client/cli"
+	"github.com/e-money/bep3/module/types"
+	"github.com/e-money/bep3/testapp"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	encodingConfig := testapp.MakeEncodingConfig()
+	clientCtx := client.Context{}.WithJSONMarshaler(encodingConfig.Marshaller)
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	deputy := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	newSwap := func(denom string) types.AtomicSwap {
+		randomNumber, err := types.GenerateSecureRandomNumber()
+		require.NoError(t, err)
+		timestamp := tmtime.Now().Unix()
+		return types.NewAtomicSwap(sdk.NewCoins(sdk.NewInt64Coin(denom, 50000)),
+			types.CalculateRandomHash(randomNumber, timestamp), timestamp+3600, timestamp, sender, deputy,
+			"bnb1sender", "bnb1recipient", 0, types.Open, true, types.Incoming)
+	}
+	supported, unsupported := newSwap("bnb"), newSwap("xrp")
+
+	genesis := types.DefaultGenesisState()
+	genesis.Params.AssetParams = types.AssetParams{
+		{
+			Denom:           "bnb",
+			CoinID:          714,
+			SupplyLimit:     types.SupplyLimit{Limit: sdk.NewInt(1000000), TimeBasedLimit: sdk.ZeroInt()},
+			Active:          true,
+			DeputyAddress:   deputy.String(),
+			FixedFee:        sdk.NewInt(1000),
+			MinSwapAmount:   sdk.OneInt(),
+			MaxSwapAmount:   sdk.NewInt(1000000),
+			SwapTimeSpanMin: types.DefaultSwapTimeSpanMinutes,
+		},
+	}
+	genesis.Supplies = types.AssetSupplies{AssetSupplies: []types.AssetSupply{
+		types.NewAssetSupply(sdk.NewInt64Coin("bnb", 50000), sdk.NewInt64Coin("bnb", 0),
+			sdk.NewInt64Coin("bnb", 0), sdk.NewInt64Coin("bnb", 0), 0),
+	}}
+	genesis.AtomicSwaps = types.AtomicSwaps{supported}
+
+	writeGenesis := func(gs *types.GenesisState) string {
+		appState, err := json.Marshal(map[string]json.RawMessage{
+			types.ModuleName: encodingConfig.Marshaller.MustMarshalJSON(gs),
+		})
+		require.NoError(t, err)
+		genDoc := tmtypes.GenesisDoc{ChainID: "test-chain", GenesisTime: time.Now(), AppState: appState}
+		path := filepath.Join(t.TempDir(), "genesis.json")
+		require.NoError(t, genDoc.SaveAs(path))
+		return path
+	}
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdValidateGenesis(), []string{writeGenesis(genesis)})
+	require.NoError(t, err)
+	require.Contains(t, out.String(), "is valid")
+
+	// Every problem is reported rather than just the first
+	genesis.AtomicSwaps = types.AtomicSwaps{supported, unsupported, supported}
+	genesis.Supplies.AssetSupplies[0].CurrentSupply = sdk.NewInt64Coin("bnb", 2000000)
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdValidateGenesis(), []string{writeGenesis(genesis)})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "found 5 problems")
+	require.Contains(t, out.String(), hex.EncodeToString(unsupported.GetSwapID())+": xrp: asset not found")
+	require.Contains(t, out.String(), "found duplicate atomic swap ID "+hex.EncodeToString(supported.GetSwapID()))
+	require.Contains(t, out.String(), "incoming supply 50000bnb does not match amount 100000")
+	require.Contains(t, out.String(), "current supply 2000000bnb is over the supply limit")
+}
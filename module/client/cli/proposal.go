@@ -0,0 +1,292 @@
+package cli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/e-money/bep3/module/types"
+	"github.com/spf13/cobra"
+)
+
+// UpdateDenyListProposalJSON defines an UpdateDenyListProposal with a deposit, as read from a proposal file
+type UpdateDenyListProposalJSON struct {
+	Title       string   `json:"title" yaml:"title"`
+	Description string   `json:"description" yaml:"description"`
+	Add         []string `json:"add" yaml:"add"`
+	Remove      []string `json:"remove" yaml:"remove"`
+	Deposit     string   `json:"deposit" yaml:"deposit"`
+}
+
+// ParseUpdateDenyListProposalJSON reads and parses an UpdateDenyListProposalJSON from a file.
+func ParseUpdateDenyListProposalJSON(proposalFile string) (UpdateDenyListProposalJSON, error) {
+	proposal := UpdateDenyListProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// GetCmdSubmitUpdateDenyListProposal implements the command to submit a bep3 deny list update proposal
+func GetCmdSubmitUpdateDenyListProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bep3-deny-list [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to add or remove addresses on the bep3 deny list",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a bep3 deny list update proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. Addresses may be local bech32 addresses or
+addresses on the other chain.
+
+Example:
+$ %s tx gov submit-proposal bep3-deny-list <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Deny sanctioned addresses",
+  "description": "Bar these addresses from creating, receiving or claiming swaps",
+  "add": ["bnb1uky3me9ggqypmrsvxk7ur6hqkzq7zmv4ed4ng7"],
+  "remove": [],
+  "deposit": "1000ungm"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := ParseUpdateDenyListProposalJSON(args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewUpdateDenyListProposal(proposal.Title, proposal.Description, proposal.Add, proposal.Remove)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// DeputySlashProposalJSON defines a DeputySlashProposal with a deposit, as read from a proposal file
+type DeputySlashProposalJSON struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	SwapID      string `json:"swap_id" yaml:"swap_id"`
+	Deposit     string `json:"deposit" yaml:"deposit"`
+}
+
+// ParseDeputySlashProposalJSON reads and parses a DeputySlashProposalJSON from a file.
+func ParseDeputySlashProposalJSON(proposalFile string) (DeputySlashProposalJSON, error) {
+	proposal := DeputySlashProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// GetCmdSubmitDeputySlashProposal implements the command to submit a bep3 deputy slash proposal
+func GetCmdSubmitDeputySlashProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bep3-deputy-slash [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to slash the bond of a deputy that failed to honor a swap",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a bep3 deputy slash proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. The description should hold the evidence that
+the deputy failed to honor the swap on the other chain.
+
+Example:
+$ %s tx gov submit-proposal bep3-deputy-slash <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Slash deputy for swap 6682c03c",
+  "description": "The deputy claimed the swap but never released the funds on the other chain",
+  "swap_id": "6682c03cc3856879c8fb98c9733c6b0c30758299138166b6523fe94628b1d3af",
+  "deposit": "1000ungm"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := ParseDeputySlashProposalJSON(args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			swapID, err := hex.DecodeString(proposal.SwapID)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewDeputySlashProposal(proposal.Title, proposal.Description, swapID)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// SetLightClientStateProposalJSON defines a SetLightClientStateProposal with a deposit, as read from a proposal file.
+// The root and data of the light client state are hex encoded.
+type SetLightClientStateProposalJSON struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	Denom       string `json:"denom" yaml:"denom"`
+	ChainID     string `json:"chain_id" yaml:"chain_id"`
+	Height      int64  `json:"height" yaml:"height"`
+	Root        string `json:"root" yaml:"root"`
+	Data        string `json:"data" yaml:"data"`
+	Deposit     string `json:"deposit" yaml:"deposit"`
+}
+
+// ParseSetLightClientStateProposalJSON reads and parses a SetLightClientStateProposalJSON from a file.
+func ParseSetLightClientStateProposalJSON(proposalFile string) (SetLightClientStateProposalJSON, error) {
+	proposal := SetLightClientStateProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// GetCmdSubmitSetLightClientStateProposal implements the command to submit a bep3 light client state proposal
+func GetCmdSubmitSetLightClientStateProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bep3-light-client-state [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to set the trusted light client state of an asset's counterparty chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a bep3 light client state proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. The state replaces the light client state of the
+asset, against which the proofs of its incoming swaps are verified.
+
+Example:
+$ %s tx gov submit-proposal bep3-light-client-state <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Trust Binance Chain at height 150000000",
+  "description": "Sets the light client of bnb ahead of enabling verify_incoming",
+  "denom": "bnb",
+  "chain_id": "Binance-Chain-Tigris",
+  "height": 150000000,
+  "root": "6682c03cc3856879c8fb98c9733c6b0c30758299138166b6523fe94628b1d3af",
+  "data": "",
+  "deposit": "1000ungm"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := ParseSetLightClientStateProposalJSON(args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			root, err := hex.DecodeString(proposal.Root)
+			if err != nil {
+				return err
+			}
+			data, err := hex.DecodeString(proposal.Data)
+			if err != nil {
+				return err
+			}
+
+			state := types.NewLightClientState(proposal.Denom, proposal.ChainID, proposal.Height, root, data)
+			content := types.NewSetLightClientStateProposal(proposal.Title, proposal.Description, state)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
@@ -21,6 +21,9 @@ const (
 	flagExpiration = "expiration"
 	flagStatus     = "status"
 	flagDirection  = "direction"
+	flagDaily      = "daily"
+	flagStartTime  = "start-time"
+	flagEndTime    = "end-time"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -39,9 +42,14 @@ func GetQueryCmd() *cobra.Command {
 		QueryCalcRandomNumberHashCmd(),
 		QueryGetAssetSupplyCmd(),
 		QueryGetAssetSuppliesCmd(),
+		QueryAssetCapacityCmd(),
 		QueryGetAtomicSwapCmd(),
 		QueryGetAtomicSwapsCmd(),
 		QueryParamsCmd(),
+		QueryDenyListCmd(),
+		QueryStatsCmd(),
+		QueryDeputyHealthCmd(),
+		QueryDeputyBondCmd(),
 	)
 
 	return bep3QueryCmd
@@ -154,6 +162,32 @@ func QueryGetAssetSupplyCmd() *cobra.Command {
 	return cmd
 }
 
+// QueryAssetCapacityCmd queries how much of an asset can still be swapped under its supply limits
+func QueryAssetCapacityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "capacity [denom]",
+		Short:   "get the remaining incoming and outgoing capacity of an asset under its supply limits",
+		Example: "bep3 capacity bnb",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.AssetCapacity(cmd.Context(), &types.QueryAssetCapacityRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // QueryGetAssetSuppliesCmd queries AssetSupplies in the store
 func QueryGetAssetSuppliesCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -301,7 +335,7 @@ $ emcli q bep3 swaps --page=2 --limit=100
 	cmd.Flags().String(flagInvolve, "", "(optional) filter by atomic swaps that involve an address")
 	cmd.Flags().String(flagExpiration, "", "(optional) filter by atomic swaps that expire before a block height")
 	cmd.Flags().String(flagStatus, "", "(optional) filter by atomic swap status, status: open/completed/expired")
-	cmd.Flags().String(flagDirection, "", "(optional) filter by atomic swap direction, direction: incoming/outgoing")
+	cmd.Flags().String(flagDirection, "", "(optional) filter by atomic swap direction, direction: incoming/outgoing/local")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -336,3 +370,130 @@ func QueryParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// QueryDenyListCmd queries the addresses on the bep3 deny list
+func QueryDenyListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "deny-list",
+		Short:   "get the local and other-chain addresses barred from swaps",
+		Example: "bep3 deny-list",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.DenyList(cmd.Context(), &types.QueryDenyListRequest{})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueryStatsCmd queries the swap counters and volumes of one or all assets
+func QueryStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "stats [denom]",
+		Short:   "get cumulative swap counters and volumes, optionally per day",
+		Example: "bep3 stats bnb --daily --start-time 1609459200",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryStatsRequest{}
+			if len(args) > 0 {
+				req.Denom = args[0]
+			}
+			if req.Daily, err = cmd.Flags().GetBool(flagDaily); err != nil {
+				return err
+			}
+			if req.StartTime, err = cmd.Flags().GetInt64(flagStartTime); err != nil {
+				return err
+			}
+			if req.EndTime, err = cmd.Flags().GetInt64(flagEndTime); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.Stats(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Bool(flagDaily, false, "(optional) include the stats of each UTC day")
+	cmd.Flags().Int64(flagStartTime, 0, "(optional) unix time of the first daily stats to include")
+	cmd.Flags().Int64(flagEndTime, 0, "(optional) unix time of the last daily stats to include")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueryDeputyHealthCmd queries the liveness of the deputy of one or all assets
+func QueryDeputyHealthCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "deputy-health [denom]",
+		Short:   "get when the deputy of one or all assets last acted and whether its outgoing swaps are suspended",
+		Example: "bep3 deputy-health bnb",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			if len(args) > 0 {
+				res, err := queryClient.DeputyHealth(cmd.Context(), &types.QueryDeputyHealthRequest{Denom: args[0]})
+				if err != nil {
+					return err
+				}
+				return cliCtx.PrintProto(res)
+			}
+
+			res, err := queryClient.DeputiesHealth(cmd.Context(), &types.QueryDeputiesHealthRequest{})
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueryDeputyBondCmd queries the bonded and unbonding collateral of a deputy
+func QueryDeputyBondCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "deputy-bond [deputy-address]",
+		Short:   "get the bonded and unbonding collateral of a deputy",
+		Example: "bep3 deputy-bond emoney1xy7hrjy9r0algz9w3gzm8u6mrpq97kwta747gj",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.DeputyBond(cmd.Context(), &types.QueryDeputyBondRequest{DeputyAddress: args[0]})
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
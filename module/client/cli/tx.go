@@ -1,21 +1,48 @@
 package cli
 
 import (
+	"bufio"
+	"bytes"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
 	"github.com/e-money/bep3/module/types"
 	"github.com/spf13/cobra"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	tmtime "github.com/tendermint/tendermint/types/time"
 )
 
+// Create atomic swap flags
+const (
+	flagClaimTip         = "claim-tip"
+	flagByHash           = "by-hash"
+	flagAtomic           = "atomic"
+	flagMemo             = "swap-memo"
+	flagOtherChainTxHash = "other-chain-tx-hash"
+	flagSecretIndex      = "secret-index"
+	flagMnemonicFile     = "secret-mnemonic-file"
+	flagProofFile        = "proof-file"
+	flagPartialClaims    = "partial-claims"
+	flagAmount           = "amount"
+
+	flagRandomNumberHash    = "random-number-hash"
+	flagTimestamp           = "timestamp"
+	flagPacketTimeoutHeight = "packet-timeout-height"
+	flagPacketTimeout       = "packet-timeout"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	bep3TxCmd := &cobra.Command{
@@ -28,8 +55,15 @@ func GetTxCmd() *cobra.Command {
 
 	bep3TxCmd.AddCommand(
 		GetCmdCreateAtomicSwap(),
+		GetCmdCreateInterchainSwap(),
+		GetCmdCreateLocalSwap(),
 		GetCmdClaimAtomicSwap(),
 		GetCmdRefundAtomicSwap(),
+		GetCmdBatchClaimAtomicSwaps(),
+		GetCmdBatchRefundAtomicSwaps(),
+		GetCmdAnnotateSwap(),
+		GetCmdDeputyBond(),
+		GetCmdDeputyUnbond(),
 	)
 
 	return bep3TxCmd
@@ -40,6 +74,12 @@ func GetCmdCreateAtomicSwap() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create [to] [recipient-other-chain] [sender-other-chain] [timestamp] [coins] [height-span]",
 		Short: "create a new atomic swap",
+		Long: strings.TrimSpace(`Create a new atomic swap.
+With --secret-index the random number is derived from a mnemonic, read from --secret-mnemonic-file or
+prompted for, instead of being generated, so it can be regenerated later from the mnemonic, the index
+and the recipients of the swap. Use a different index for every swap created with the same recipients.
+With --dry-run the swap is checked against the current chain state instead of being broadcast, printing
+the swap ID, expiry and fee of the swap or the error creating it would fail with.`),
 		Example: fmt.Sprintf("%s tx %s create emoneyxy7hrjy9r0algz9w3gzm8u6mrpq97kwta747gj 0x1urfermcg92dwq36572cx4xg84wpk3lfpksr5g7 0x1uky3me9ggqypmrsvxk7ur6hqkzq7zmv4ed4ng7 now 100ungm 270 --from validator",
 			version.AppName, types.ModuleName),
 		Args: cobra.ExactArgs(6),
@@ -67,8 +107,7 @@ func GetCmdCreateAtomicSwap() *cobra.Command {
 				}
 			}
 
-			// Generate cryptographically strong pseudo-random number
-			randomNumber, err := types.GenerateSecureRandomNumber()
+			randomNumber, err := swapRandomNumber(cmd, to, recipientOtherChain)
 			if err != nil {
 				return err
 			}
@@ -95,6 +134,59 @@ func GetCmdCreateAtomicSwap() *cobra.Command {
 				randomNumberHash, timestamp, coins, timeSpan,
 			)
 
+			// Optional tip paid from the swap amount to whoever submits the claim
+			strClaimTip, err := cmd.Flags().GetString(flagClaimTip)
+			if err != nil {
+				return err
+			}
+			if len(strClaimTip) != 0 {
+				claimTip, err := sdk.ParseCoinsNormalized(strClaimTip)
+				if err != nil {
+					return err
+				}
+				msg.ClaimTip = claimTip
+			}
+
+			msg.Memo, err = cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+			msg.OtherChainTxHash, err = cmd.Flags().GetString(flagOtherChainTxHash)
+			if err != nil {
+				return err
+			}
+			msg.PartialClaims, err = cmd.Flags().GetBool(flagPartialClaims)
+			if err != nil {
+				return err
+			}
+
+			// Incoming swaps of assets that verify them carry a proof of the HTLC on the other chain
+			proofFile, err := cmd.Flags().GetString(flagProofFile)
+			if err != nil {
+				return err
+			}
+			if len(proofFile) != 0 {
+				contents, err := ioutil.ReadFile(proofFile)
+				if err != nil {
+					return err
+				}
+				var proof types.SwapProof
+				if err := cliCtx.JSONMarshaler.UnmarshalJSON(contents, &proof); err != nil {
+					return err
+				}
+				msg.Proof = &proof
+			}
+
+			// A dry run checks the swap against the current chain state without broadcasting it
+			if cliCtx.Simulate {
+				queryClient := types.NewQueryClient(cliCtx)
+				res, err := queryClient.SimulateCreateSwap(cmd.Context(), &types.QuerySimulateCreateSwapRequest{Msg: msg})
+				if err != nil {
+					return err
+				}
+				return cliCtx.PrintProto(res)
+			}
+
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -107,17 +199,231 @@ func GetCmdCreateAtomicSwap() *cobra.Command {
 			)
 		},
 	}
+	cmd.Flags().String(flagClaimTip, "", "(optional) part of the amount paid to the address that submits the claim, e.g. 10ungm")
+	cmd.Flags().String(flagMemo, "", fmt.Sprintf("(optional) reference stored with the swap, up to %d bytes", types.MaxMemoLength))
+	cmd.Flags().String(flagOtherChainTxHash, "", "(optional) hash of the counterparty transaction on the other chain")
+	cmd.Flags().String(flagProofFile, "", "(optional) JSON file with the proof of the HTLC on the other chain, required for incoming swaps of assets that verify them")
+	cmd.Flags().Bool(flagPartialClaims, false, "(optional) allow the swap to be claimed in parts of its amount, cannot be combined with a claim tip")
+	cmd.Flags().Uint64(flagSecretIndex, 0, "(optional) derive the random number from a mnemonic using this swap index")
+	cmd.Flags().String(flagMnemonicFile, "", "(optional) file holding the mnemonic to derive the random number from, prompted for if not given")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
+// GetCmdCreateInterchainSwap cli command for creating atomic swaps with a Cosmos chain connected over IBC
+func GetCmdCreateInterchainSwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-interchain [src-port] [src-channel] [to] [coins] [time-span]",
+		Short: "create a new atomic swap mirrored on a chain connected over IBC",
+		Long: strings.TrimSpace(`Create a new atomic swap whose counterparty swap is created on the chain at the other end of the
+channel by an IBC packet. The coins are released to the recipient on the other chain once the swap
+is claimed there, and refunded here if the swap expires unclaimed there or the packet times out.
+The packet times out at --packet-timeout-height of the other chain, or --packet-timeout after now.`),
+		Example: fmt.Sprintf("%s tx %s create-interchain bep3 channel-0 emoneyxy7hrjy9r0algz9w3gzm8u6mrpq97kwta747gj 100ungm 60 --from accA",
+			version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			timestamp := tmtime.Now().Unix()
+
+			randomNumber, err := swapRandomNumber(cmd, args[2], "")
+			if err != nil {
+				return err
+			}
+			randomNumberHash := types.CalculateRandomHash(randomNumber, timestamp)
+
+			// Print random number, timestamp, and hash to user's console
+			fmt.Printf("\nRandom number: %s\n", hex.EncodeToString(randomNumber))
+			fmt.Printf("Timestamp: %d\n", timestamp)
+			fmt.Printf("Random number hash: %s\n\n", hex.EncodeToString(randomNumberHash))
+
+			coins, err := sdk.ParseCoinsNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			timeSpan, err := strconv.ParseInt(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateInterchainSwap(
+				from.String(), args[2], randomNumberHash, timestamp, coins, timeSpan, args[0], args[1],
+			)
+
+			timeoutHeight, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
+			if err != nil {
+				return err
+			}
+			msg.TimeoutHeight, err = clienttypes.ParseHeight(timeoutHeight)
+			if err != nil {
+				return err
+			}
+			timeout, err := cmd.Flags().GetDuration(flagPacketTimeout)
+			if err != nil {
+				return err
+			}
+			if timeout > 0 {
+				msg.TimeoutTimestamp = uint64(tmtime.Now().Add(timeout).UnixNano())
+			}
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(flagPacketTimeoutHeight, "0-0", "packet timeout height of the other chain as {revision}-{height}, 0-0 to disable")
+	cmd.Flags().Duration(flagPacketTimeout, 10*time.Minute, "packet timeout after now, 0 to disable")
+	cmd.Flags().Uint64(flagSecretIndex, 0, "(optional) derive the random number from a mnemonic using this swap index")
+	cmd.Flags().String(flagMnemonicFile, "", "(optional) file holding the mnemonic to derive the random number from, prompted for if not given")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdCreateLocalSwap cli command for creating atomic swaps between two accounts of this chain
+func GetCmdCreateLocalSwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-local [to] [coins] [time-span]",
+		Short: "create a new atomic swap with another account of this chain",
+		Long: strings.TrimSpace(`Create a new atomic swap with another account of this chain, to exchange coins of two denoms
+without a deputy. The initiator creates a swap, printing its secret random number, and the counterparty
+locks the coins asked for in return with --random-number-hash and --timestamp of the initiator's swap.
+Claiming the counterparty's swap reveals the random number, which then claims the initiator's swap.
+The counterparty's swap should have the shorter time span, so that it cannot be claimed after the
+initiator's swap has expired.`),
+		Example: fmt.Sprintf(`%[1]s tx %[2]s create-local emoneyxy7hrjy9r0algz9w3gzm8u6mrpq97kwta747gj 100ungm 120 --from accA
+%[1]s tx %[2]s create-local emoney1uky3me9ggqypmrsvxk7ur6hqkzq7zmv4ed4ng7 250eeur 60 --random-number-hash 464105c245199d02a4289475b8b231f3f73918b6f0fdad898825186950d46f36 --timestamp 1618310052 --from accB`,
+			version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+
+			// The counterparty locks its coins under the hash and timestamp of the initiator's swap
+			var randomNumberHash []byte
+			timestamp, err := cmd.Flags().GetInt64(flagTimestamp)
+			if err != nil {
+				return err
+			}
+			strHash, err := cmd.Flags().GetString(flagRandomNumberHash)
+			if err != nil {
+				return err
+			}
+			if len(strHash) != 0 {
+				if timestamp == 0 {
+					return fmt.Errorf("--%s requires the --%s of the swap", flagRandomNumberHash, flagTimestamp)
+				}
+				randomNumberHash, err = hex.DecodeString(strHash)
+				if err != nil {
+					return err
+				}
+			} else {
+				timestamp = tmtime.Now().Unix()
+				randomNumber, err := swapRandomNumber(cmd, args[0], "")
+				if err != nil {
+					return err
+				}
+				randomNumberHash = types.CalculateRandomHash(randomNumber, timestamp)
+
+				// Print random number, timestamp, and hash to user's console
+				fmt.Printf("\nRandom number: %s\n", hex.EncodeToString(randomNumber))
+				fmt.Printf("Timestamp: %d\n", timestamp)
+				fmt.Printf("Random number hash: %s\n\n", hex.EncodeToString(randomNumberHash))
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			timeSpan, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateLocalSwap(from.String(), args[0], randomNumberHash, timestamp, coins, timeSpan)
+			msg.Memo, err = cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(flagRandomNumberHash, "", "(optional) random number hash of the counterparty's swap to lock the coins under")
+	cmd.Flags().Int64(flagTimestamp, 0, "(optional) timestamp of the counterparty's swap, required with --random-number-hash")
+	cmd.Flags().String(flagMemo, "", fmt.Sprintf("(optional) reference stored with the swap, up to %d bytes", types.MaxMemoLength))
+	cmd.Flags().Uint64(flagSecretIndex, 0, "(optional) derive the random number from a mnemonic using this swap index")
+	cmd.Flags().String(flagMnemonicFile, "", "(optional) file holding the mnemonic to derive the random number from, prompted for if not given")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// swapRandomNumber derives the random number of a swap from a mnemonic if --secret-index is given, and otherwise
+// generates a cryptographically strong pseudo-random number
+func swapRandomNumber(cmd *cobra.Command, recipient, recipientOtherChain string) ([]byte, error) {
+	if !cmd.Flags().Changed(flagSecretIndex) {
+		return types.GenerateSecureRandomNumber()
+	}
+	index, err := cmd.Flags().GetUint64(flagSecretIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	mnemonicFile, err := cmd.Flags().GetString(flagMnemonicFile)
+	if err != nil {
+		return nil, err
+	}
+	var mnemonic string
+	if len(mnemonicFile) != 0 {
+		contents, err := ioutil.ReadFile(mnemonicFile)
+		if err != nil {
+			return nil, err
+		}
+		mnemonic = string(contents)
+	} else {
+		mnemonic, err = input.GetString("Enter the mnemonic to derive the random number from:", bufio.NewReader(cmd.InOrStdin()))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	seed, err := types.SecretSeedFromMnemonic(strings.Join(strings.Fields(mnemonic), " "), "")
+	if err != nil {
+		return nil, err
+	}
+	return types.DeriveRandomNumber(seed, index, recipient, recipientOtherChain)
+}
+
 // GetCmdClaimAtomicSwap cli command for claiming an atomic swap
 func GetCmdClaimAtomicSwap() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "claim [swap-id] [random-number]",
-		Short:   "claim coins in an atomic swap using the secret number",
-		Example: fmt.Sprintf("%s tx %s claim 6682c03cc3856879c8fb98c9733c6b0c30758299138166b6523fe94628b1d3af 56f13e6a5cd397447f8b5f8c82fdb5bbf56127db75269f5cc14e50acd8ac9a4c --from accA", version.Name, types.ModuleName),
-		Args:    cobra.ExactArgs(2),
+		Use:   "claim [swap-id] [random-number]",
+		Short: "claim coins in an atomic swap using the secret number",
+		Long: strings.TrimSpace(`Claim coins in an atomic swap using the secret number.
+With --by-hash the first argument is the random number hash of the swap instead of its ID,
+and the open swap locked under that hash is looked up before claiming.
+With --amount a part of the remaining amount of a swap created with partial claims is claimed.`),
+		Example: fmt.Sprintf(`%[1]s tx %[2]s claim 6682c03cc3856879c8fb98c9733c6b0c30758299138166b6523fe94628b1d3af 56f13e6a5cd397447f8b5f8c82fdb5bbf56127db75269f5cc14e50acd8ac9a4c --from accA
+%[1]s tx %[2]s claim 0677bd8a303dd981810f34d8e5cc6507f13b391899b84d3c1be6c6045a17d747 56f13e6a5cd397447f8b5f8c82fdb5bbf56127db75269f5cc14e50acd8ac9a4c --by-hash --from accA`, version.Name, types.ModuleName),
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -139,8 +445,30 @@ func GetCmdClaimAtomicSwap() *cobra.Command {
 				return err
 			}
 
+			byHash, err := cmd.Flags().GetBool(flagByHash)
+			if err != nil {
+				return err
+			}
+			if byHash {
+				swapID, err = resolveClaimableSwapID(cmd, cliCtx, swapID, randomNumber)
+				if err != nil {
+					return err
+				}
+			}
+
 			msg := types.NewMsgClaimAtomicSwap(from, swapID, randomNumber)
 
+			strAmount, err := cmd.Flags().GetString(flagAmount)
+			if err != nil {
+				return err
+			}
+			if len(strAmount) != 0 {
+				msg.Amount, err = sdk.ParseCoinsNormalized(strAmount)
+				if err != nil {
+					return err
+				}
+			}
+
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -149,10 +477,52 @@ func GetCmdClaimAtomicSwap() *cobra.Command {
 			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().Bool(flagByHash, false, "treat the first argument as the swap's random number hash and resolve its swap ID")
+	cmd.Flags().String(flagAmount, "", "(optional) part of the remaining amount to claim from a swap with partial claims, e.g. 10000ungm")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
+// resolveClaimableSwapID looks up the open swap locked under a random number hash that the random number unlocks,
+// preferring the swap received by the claimant if there are several.
+func resolveClaimableSwapID(cmd *cobra.Command, cliCtx client.Context, randomNumberHash, randomNumber []byte) ([]byte, error) {
+	queryClient := types.NewQueryClient(cliCtx)
+	res, err := queryClient.SwapsByRandomNumberHash(cmd.Context(), &types.QuerySwapsByRandomNumberHashRequest{
+		RandomNumberHash: randomNumberHash,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates, ownCandidates []string
+	for _, swap := range res.Swaps.AugmentedAtomicSwaps {
+		if swap.Status != types.Open {
+			continue
+		}
+		if !bytes.Equal(types.CalculateRandomHash(randomNumber, swap.Timestamp), randomNumberHash) {
+			continue
+		}
+		candidates = append(candidates, swap.ID)
+		if swap.Recipient == cliCtx.GetFromAddress().String() {
+			ownCandidates = append(ownCandidates, swap.ID)
+		}
+	}
+	// Both swaps of a local swap pair are locked under the same hash, and each party claims the one it receives
+	if len(candidates) > 1 && len(ownCandidates) == 1 {
+		candidates = ownCandidates
+	}
+
+	switch len(candidates) {
+	case 0:
+		return nil, fmt.Errorf("no open swap found for random number hash %X that the random number unlocks", randomNumberHash)
+	case 1:
+		return hex.DecodeString(candidates[0])
+	default:
+		return nil, fmt.Errorf("random number hash %X matches several open swaps, claim by swap ID instead: %s",
+			randomNumberHash, strings.Join(candidates, ", "))
+	}
+}
+
 // GetCmdRefundAtomicSwap cli command for claiming an atomic swap
 func GetCmdRefundAtomicSwap() *cobra.Command {
 	cmd := &cobra.Command{
@@ -186,3 +556,209 @@ func GetCmdRefundAtomicSwap() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdAnnotateSwap cli command for attaching the counterparty tx hash to an atomic swap
+func GetCmdAnnotateSwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "annotate [swap-id] [other-chain-tx-hash]",
+		Short:   "attach the counterparty transaction hash to an atomic swap, deputy only",
+		Example: fmt.Sprintf("%s tx %s annotate 6682c03cc3856879c8fb98c9733c6b0c30758299138166b6523fe94628b1d3af 9B5A1F4D08D0B2D8E7F2A5C1C6DC8E6F2D0E4B3A1C9F8E7D6C5B4A3928171605 --from deputy", version.Name, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+
+			swapID, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAnnotateSwap(from, swapID, args[1])
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdDeputyBond cli command for bonding deputy collateral
+func GetCmdDeputyBond() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "deputy-bond [amount]",
+		Short:   "bond collateral that is slashed if the deputy fails to honor outgoing swaps",
+		Example: fmt.Sprintf("%s tx %s deputy-bond 1000000ungm --from deputy", version.Name, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeputyBond(cliCtx.GetFromAddress(), amount)
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdDeputyUnbond cli command for unbonding deputy collateral
+func GetCmdDeputyUnbond() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "deputy-unbond [amount]",
+		Short:   "unbond deputy collateral, which is returned after the unbonding period",
+		Example: fmt.Sprintf("%s tx %s deputy-unbond 1000000ungm --from deputy", version.Name, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeputyUnbond(cliCtx.GetFromAddress(), amount)
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdBatchClaimAtomicSwaps cli command for claiming several atomic swaps in one msg
+func GetCmdBatchClaimAtomicSwaps() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-batch [batch-file]",
+		Short: "claim several atomic swaps listed in a JSON file",
+		Long: `Claim several atomic swaps in a single transaction. The batch file holds a JSON
+list of swap IDs and their random numbers:
+
+[
+  {
+    "swap_id": "6682c03cc3856879c8fb98c9733c6b0c30758299138166b6523fe94628b1d3af",
+    "random_number": "56f13e6a5cd397447f8b5f8c82fdb5bbf56127db75269f5cc14e50acd8ac9a4c"
+  }
+]
+
+With --atomic the whole batch fails when any claim fails, otherwise each claim is
+applied on its own and failures are reported per swap.`,
+		Example: fmt.Sprintf("%s tx %s claim-batch claims.json --atomic --from accA", version.Name, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var claims []types.BatchClaimItem
+			if err := readBatchFile(args[0], &claims); err != nil {
+				return err
+			}
+
+			atomic, err := cmd.Flags().GetBool(flagAtomic)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBatchClaimAtomicSwaps(cliCtx.GetFromAddress(), claims, atomic)
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Bool(flagAtomic, false, "fail the whole batch if any claim fails")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdBatchRefundAtomicSwaps cli command for refunding several atomic swaps in one msg
+func GetCmdBatchRefundAtomicSwaps() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refund-batch [batch-file]",
+		Short: "refund several atomic swaps listed in a JSON file",
+		Long: `Refund several atomic swaps in a single transaction. The batch file holds a JSON
+list of swap IDs:
+
+[
+  "6682c03cc3856879c8fb98c9733c6b0c30758299138166b6523fe94628b1d3af"
+]
+
+With --atomic the whole batch fails when any refund fails, otherwise each refund is
+applied on its own and failures are reported per swap.`,
+		Example: fmt.Sprintf("%s tx %s refund-batch refunds.json --from accA", version.Name, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var swapIDs []tmbytes.HexBytes
+			if err := readBatchFile(args[0], &swapIDs); err != nil {
+				return err
+			}
+
+			atomic, err := cmd.Flags().GetBool(flagAtomic)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBatchRefundAtomicSwaps(cliCtx.GetFromAddress(), swapIDs, atomic)
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Bool(flagAtomic, false, "fail the whole batch if any refund fails")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func readBatchFile(path string, target interface{}) error {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(contents, target); err != nil {
+		return fmt.Errorf("invalid batch file %s: %w", path, err)
+	}
+	return nil
+}
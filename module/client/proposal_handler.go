@@ -0,0 +1,15 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/e-money/bep3/module/client/cli"
+	"github.com/e-money/bep3/module/client/rest"
+)
+
+// ProposalHandler is the bep3 deny list update proposal handler, DeputySlashProposalHandler the bep3 deputy slash
+// proposal handler and SetLightClientStateProposalHandler the bep3 light client state proposal handler.
+var (
+	ProposalHandler                    = govclient.NewProposalHandler(cli.GetCmdSubmitUpdateDenyListProposal, rest.ProposalRESTHandler)
+	DeputySlashProposalHandler         = govclient.NewProposalHandler(cli.GetCmdSubmitDeputySlashProposal, rest.DeputySlashProposalRESTHandler)
+	SetLightClientStateProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitSetLightClientStateProposal, rest.SetLightClientStateProposalRESTHandler)
+)
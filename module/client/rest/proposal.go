@@ -0,0 +1,147 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/e-money/bep3/module/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+// UpdateDenyListProposalReq defines the properties of a deny list proposal request's body
+type UpdateDenyListProposalReq struct {
+	BaseReq     rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Add         []string       `json:"add" yaml:"add"`
+	Remove      []string       `json:"remove" yaml:"remove"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// DeputySlashProposalReq defines the properties of a deputy slash proposal request's body
+type DeputySlashProposalReq struct {
+	BaseReq     rest.BaseReq     `json:"base_req" yaml:"base_req"`
+	Title       string           `json:"title" yaml:"title"`
+	Description string           `json:"description" yaml:"description"`
+	SwapID      tmbytes.HexBytes `json:"swap_id" yaml:"swap_id"`
+	Proposer    sdk.AccAddress   `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins        `json:"deposit" yaml:"deposit"`
+}
+
+// SetLightClientStateProposalReq defines the properties of a light client state proposal request's body
+type SetLightClientStateProposalReq struct {
+	BaseReq     rest.BaseReq           `json:"base_req" yaml:"base_req"`
+	Title       string                 `json:"title" yaml:"title"`
+	Description string                 `json:"description" yaml:"description"`
+	State       types.LightClientState `json:"state" yaml:"state"`
+	Proposer    sdk.AccAddress         `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins              `json:"deposit" yaml:"deposit"`
+}
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the deny list update REST handler with a given sub-route.
+func ProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "bep3_deny_list",
+		Handler:  postProposalHandlerFn(cliCtx),
+	}
+}
+
+func postProposalHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateDenyListProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewUpdateDenyListProposal(req.Title, req.Description, req.Add, req.Remove)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
+// DeputySlashProposalRESTHandler returns a ProposalRESTHandler that exposes the deputy slash REST handler with a given sub-route.
+func DeputySlashProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "bep3_deputy_slash",
+		Handler:  postDeputySlashProposalHandlerFn(cliCtx),
+	}
+}
+
+func postDeputySlashProposalHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req DeputySlashProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewDeputySlashProposal(req.Title, req.Description, req.SwapID)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
+// SetLightClientStateProposalRESTHandler returns a ProposalRESTHandler that exposes the light client state REST handler with a given sub-route.
+func SetLightClientStateProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "bep3_light_client_state",
+		Handler:  postSetLightClientStateProposalHandlerFn(cliCtx),
+	}
+}
+
+func postSetLightClientStateProposalHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SetLightClientStateProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewSetLightClientStateProposal(req.Title, req.Description, req.State)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/e-money/bep3/module/types"
 	"github.com/gorilla/mux"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 )
@@ -35,6 +36,11 @@ type PostCreateSwapReq struct {
 	Amount              sdk.Coins        `json:"amount" yaml:"amount"`
 	TimeSpan            int64            `json:"time_span" yaml:"time_span"`
 	CrossChain          bool             `json:"cross_chain" yaml:"cross_chain"`
+	ClaimTip            sdk.Coins        `json:"claim_tip" yaml:"claim_tip"`
+	Memo                string           `json:"memo" yaml:"memo"`
+	OtherChainTxHash    string           `json:"other_chain_tx_hash" yaml:"other_chain_tx_hash"`
+	Proof               *types.SwapProof `json:"proof" yaml:"proof"`
+	PartialClaims       bool             `json:"partial_claims" yaml:"partial_claims"`
 }
 
 // PostClaimSwapReq defines the properties of a swap claim request's body
@@ -43,6 +49,7 @@ type PostClaimSwapReq struct {
 	From         sdk.AccAddress   `json:"from" yaml:"from"`
 	SwapID       tmbytes.HexBytes `json:"swap_id" yaml:"swap_id"`
 	RandomNumber tmbytes.HexBytes `json:"random_number" yaml:"random_number"`
+	Amount       sdk.Coins        `json:"amount" yaml:"amount"`
 }
 
 // PostRefundSwapReq defines the properties of swap refund request's body
@@ -51,3 +58,18 @@ type PostRefundSwapReq struct {
 	From    sdk.AccAddress   `json:"from" yaml:"from"`
 	SwapID  tmbytes.HexBytes `json:"swap_id" yaml:"swap_id"`
 }
+
+// PostDeputyBondReq defines the properties of a deputy bond or unbond request's body
+type PostDeputyBondReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	From    sdk.AccAddress `json:"from" yaml:"from"`
+	Amount  sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// PostAnnotateSwapReq defines the properties of a swap annotate request's body
+type PostAnnotateSwapReq struct {
+	BaseReq          rest.BaseReq     `json:"base_req" yaml:"base_req"`
+	From             sdk.AccAddress   `json:"from" yaml:"from"`
+	SwapID           tmbytes.HexBytes `json:"swap_id" yaml:"swap_id"`
+	OtherChainTxHash string           `json:"other_chain_tx_hash" yaml:"other_chain_tx_hash"`
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/std"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -21,13 +22,14 @@ type EncodingConfig struct {
 	Amino             *codec.LegacyAmino
 }
 
-// MakeEncodingConfig creates an EncodingConfig for a selected codec.
+// MakeEncodingConfig creates the default EncodingConfig, a protobuf based configuration signing with
+// SIGN_MODE_DIRECT.
 func MakeEncodingConfig() EncodingConfig {
-	// default to Amino
-	return MakeAminoEncodingConfig()
+	return MakeProtoEncodingConfig()
 }
 
-// MakeAminoEncodingConfig creates an EncodingConfig for an amino based configuration.
+// MakeAminoEncodingConfig creates an EncodingConfig for an amino based configuration. It is kept for clients
+// that still sign amino JSON StdTxs and should not be used by new apps.
 func MakeAminoEncodingConfig() EncodingConfig {
 	cdc := codec.NewLegacyAmino()
 	interfaceRegistry := types.NewInterfaceRegistry()
@@ -45,6 +47,7 @@ func MakeAminoEncodingConfig() EncodingConfig {
 	ModuleBasics := module.NewBasicManager(
 		bank.AppModuleBasic{},
 		auth.AppModuleBasic{},
+		AppModuleBasic{},
 	)
 
 	ModuleBasics.RegisterLegacyAminoCodec(cfg.Amino)
@@ -58,8 +61,14 @@ func getAminoMarshaller(cdc *codec.LegacyAmino) *codec.AminoCodec {
 	return marshaller
 }
 
-// MakeProtoEncodingConfig creates an EncodingConfig for a protobuf based configuration.
-func MakeProtoEncodingConfig() EncodingConfig {
+// MakeProtoEncodingConfig creates an EncodingConfig for a protobuf based configuration. Transactions are
+// signed with SIGN_MODE_DIRECT unless other sign modes are given, e.g. SIGN_MODE_LEGACY_AMINO_JSON for
+// hardware wallets.
+func MakeProtoEncodingConfig(signModes ...signing.SignMode) EncodingConfig {
+	if len(signModes) == 0 {
+		signModes = []signing.SignMode{signing.SignMode_SIGN_MODE_DIRECT}
+	}
+
 	cdc := codec.NewLegacyAmino()
 	interfaceRegistry := types.NewInterfaceRegistry()
 	marshaller := getProtoMarshaller(interfaceRegistry)
@@ -67,7 +76,7 @@ func MakeProtoEncodingConfig() EncodingConfig {
 	cfg := EncodingConfig{
 		InterfaceRegistry: interfaceRegistry,
 		Marshaller:        marshaller,
-		TxConfig:          tx.NewTxConfig(marshaller, tx.DefaultSignModes),
+		TxConfig:          tx.NewTxConfig(marshaller, signModes),
 		Amino:             cdc,
 	}
 
@@ -76,6 +85,7 @@ func MakeProtoEncodingConfig() EncodingConfig {
 	ModuleBasics := module.NewBasicManager(
 		bank.AppModuleBasic{},
 		auth.AppModuleBasic{},
+		AppModuleBasic{},
 	)
 
 	ModuleBasics.RegisterLegacyAminoCodec(cfg.Amino)
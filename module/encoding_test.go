@@ -0,0 +1,103 @@
+package bep3_test
+
+import (
+	"testing"
+
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	bep3 "github.com/e-money/bep3/module"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodingConfigs(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      bep3.EncodingConfig
+		signMode signing.SignMode
+	}{
+		{"default", bep3.MakeEncodingConfig(), signing.SignMode_SIGN_MODE_DIRECT},
+		{"protobuf amino json", bep3.MakeProtoEncodingConfig(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON), signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON},
+		{"amino", bep3.MakeAminoEncodingConfig(), signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.signMode, tc.cfg.TxConfig.SignModeHandler().DefaultMode())
+			testSignatureRoundtrip(t, tc.cfg)
+			testGenesisRoundtrip(t, tc.cfg)
+		})
+	}
+}
+
+func testSignatureRoundtrip(t *testing.T, cfg bep3.EncodingConfig) {
+	priv := secp256k1.GenPrivKey()
+	from := sdk.AccAddress(priv.PubKey().Address())
+	signMode := cfg.TxConfig.SignModeHandler().DefaultMode()
+
+	timestamp := ts(0)
+	randomNumber, _ := bep3.GenerateSecureRandomNumber()
+	msg := bep3.NewMsgCreateAtomicSwap(from.String(), from.String(), TestRecipientOtherChain, TestSenderOtherChain,
+		bep3.CalculateRandomHash(randomNumber, timestamp), timestamp, cs(c("bnb", 50000)), bep3.DefaultSwapTimeSpanMinutes)
+
+	txBuilder := cfg.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(msg))
+	txBuilder.SetGasLimit(200000)
+	txBuilder.SetFeeAmount(cs(c("ungm", 10)))
+	txBuilder.SetMemo("bep3")
+
+	signerData := authsigning.SignerData{ChainID: "bep3-test", AccountNumber: 7, Sequence: 3}
+
+	// Direct mode signs over the signer infos, which must be set before signing
+	require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   priv.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signMode},
+		Sequence: signerData.Sequence,
+	}))
+	sig, err := clienttx.SignWithPrivKey(signMode, signerData, txBuilder, priv, cfg.TxConfig, signerData.Sequence)
+	require.NoError(t, err)
+	require.NoError(t, txBuilder.SetSignatures(sig))
+
+	require.NoError(t, authsigning.VerifySignature(priv.PubKey(), signerData, sig.Data,
+		cfg.TxConfig.SignModeHandler(), txBuilder.GetTx()))
+
+	bz, err := cfg.TxConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+	decoded, err := cfg.TxConfig.TxDecoder()(bz)
+	require.NoError(t, err)
+
+	require.Len(t, decoded.GetMsgs(), 1)
+	require.Equal(t, msg.String(), decoded.GetMsgs()[0].String())
+
+	sigTx, ok := decoded.(authsigning.SigVerifiableTx)
+	require.True(t, ok)
+	sigs, err := sigTx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.NoError(t, authsigning.VerifySignature(priv.PubKey(), signerData, sigs[0].Data,
+		cfg.TxConfig.SignModeHandler(), sigTx))
+
+	// A signature does not verify for another chain
+	signerData.ChainID = "other-chain"
+	require.Error(t, authsigning.VerifySignature(priv.PubKey(), signerData, sigs[0].Data,
+		cfg.TxConfig.SignModeHandler(), sigTx))
+}
+
+func testGenesisRoundtrip(t *testing.T, cfg bep3.EncodingConfig) {
+	deputy := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	gs := baseGenState(deputy)
+	swap, supply := loadSwapAndSupply(deputy, 0)
+	gs.AtomicSwaps = bep3.AtomicSwaps{swap}
+	gs.Supplies.AssetSupplies[0] = supply
+	require.NoError(t, gs.Validate())
+
+	bz, err := cfg.Marshaller.MarshalJSON(&gs)
+	require.NoError(t, err)
+
+	var decoded bep3.GenesisState
+	require.NoError(t, cfg.Marshaller.UnmarshalJSON(bz, &decoded))
+	require.NoError(t, decoded.Validate())
+	require.True(t, gs.Equal(decoded), "genesis changed in roundtrip:\n%s\n%s", gs, decoded)
+}
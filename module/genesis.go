@@ -7,7 +7,8 @@ import (
 	"github.com/e-money/bep3/module/types"
 )
 
-// InitGenesis initializes the store state from a genesis state.
+// InitGenesis initializes the store state from a genesis state. The state must pass GenesisState.Validate, which
+// checks the swaps, supplies and params for consistency with each other.
 func InitGenesis(ctx sdk.Context, keeper Keeper, accountKeeper types.AccountKeeper, gs GenesisState) {
 	// Check if the module account exists
 	moduleAcc := accountKeeper.GetModuleAccount(ctx, ModuleName)
@@ -19,92 +20,69 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, accountKeeper types.AccountKeep
 		panic(fmt.Sprintf("failed to validate %s genesis state: %s", ModuleName, err))
 	}
 
+	if gs.ExportHeight != 0 {
+		// The state was exported for a zero height genesis. Rebase it onto the block before the first one of this
+		// chain, which is at the initial height, or at height 1 if the context height is 0.
+		height := ctx.BlockHeight() - 1
+		if height < 0 {
+			height = 0
+		}
+		gs = gs.Rebase(height, ctx.BlockTime())
+	}
+
 	keeper.SetPreviousBlockTime(ctx, gs.PreviousBlockTime)
 
 	keeper.SetParams(ctx, gs.Params)
+	for _, address := range gs.DenyList {
+		keeper.SetDeniedAddress(ctx, address)
+	}
 	for _, supply := range gs.Supplies.AssetSupplies {
 		keeper.SetAssetSupply(ctx, supply, supply.GetDenom())
 	}
+	for _, stats := range gs.Stats {
+		keeper.SetSwapStats(ctx, stats)
+	}
+	for _, daily := range gs.DailyStats {
+		keeper.SetDailySwapStats(ctx, daily)
+	}
+	for _, activity := range gs.DeputyActivity {
+		keeper.SetDeputyActivity(ctx, activity)
+	}
+	for _, suspension := range gs.SuspendedAssets {
+		keeper.SetAssetSuspension(ctx, suspension)
+	}
+	for _, bond := range gs.DeputyBonds {
+		keeper.SetDeputyBond(ctx, bond)
+	}
+	for _, unbonding := range gs.DeputyUnbondings {
+		keeper.SetDeputyUnbonding(ctx, unbonding)
+	}
+	for _, state := range gs.LightClientStates {
+		keeper.SetLightClientState(ctx, state)
+	}
+	if err := keeper.BindPort(ctx); err != nil {
+		panic(fmt.Sprintf("failed to bind %s port: %s", PortID, err))
+	}
 
-	var incomingSupplies sdk.Coins
-	var outgoingSupplies sdk.Coins
 	for _, swap := range gs.AtomicSwaps {
-		if swap.Validate() != nil {
-			panic(fmt.Sprintf("invalid swap %s", swap.GetSwapID()))
-		}
-
-		// Atomic swap assets must be both supported and active
-		err := keeper.ValidateLiveAsset(ctx, swap.Amount[0])
-		if err != nil {
-			panic(err)
+		// Swaps closed before close times were recorded are retained from genesis on
+		if swap.Status == Completed && swap.ClosedTime == 0 {
+			swap.ClosedTime = ctx.BlockTime().Unix()
 		}
 
 		keeper.SetAtomicSwap(ctx, swap)
 
 		// Add swap to block index or longterm storage based on swap.Status
-		// Increment incoming or outgoing supply based on swap.Direction
-		switch swap.Direction {
-		case Incoming:
-			switch swap.Status {
-			case Open:
-				// This index expires unclaimed swaps
+		switch swap.Status {
+		case Open:
+			// This index expires unclaimed swaps. Outgoing interchain swaps are settled over IBC instead.
+			if swap.IBCPacket == nil || swap.Direction != Outgoing {
 				keeper.InsertIntoByTimestamp(ctx, swap)
-				incomingSupplies = incomingSupplies.Add(swap.Amount...)
-			case Expired:
-				incomingSupplies = incomingSupplies.Add(swap.Amount...)
-			case Completed:
-				// This index stores swaps until deletion
-				keeper.InsertIntoLongtermStorage(ctx, swap)
-			default:
-				panic(fmt.Sprintf("swap %s has invalid status %s", swap.GetSwapID(), swap.Status.String()))
 			}
-		case Outgoing:
-			switch swap.Status {
-			case Open:
-				keeper.InsertIntoByTimestamp(ctx, swap)
-				outgoingSupplies = outgoingSupplies.Add(swap.Amount...)
-			case Expired:
-				outgoingSupplies = outgoingSupplies.Add(swap.Amount...)
-			case Completed:
-				keeper.InsertIntoLongtermStorage(ctx, swap)
-			default:
-				panic(fmt.Sprintf("swap %s has invalid status %s", swap.GetSwapID(), swap.Status.String()))
-			}
-		default:
-			panic(fmt.Sprintf("swap %s has invalid direction %s", swap.GetSwapID(), swap.Direction.String()))
-		}
-	}
-
-	// Asset's given incoming/outgoing supply much match the amount of coins in incoming/outgoing atomic swaps
-	supplies := keeper.GetAllAssetSupplies(ctx)
-	for _, supply := range supplies.AssetSupplies {
-		incomingSupply := incomingSupplies.AmountOf(supply.GetDenom())
-		if !supply.IncomingSupply.Amount.Equal(incomingSupply) {
-			panic(fmt.Sprintf("asset's incoming supply %s does not match amount %s in incoming atomic swaps",
-				supply.IncomingSupply, incomingSupply))
-		}
-		outgoingSupply := outgoingSupplies.AmountOf(supply.GetDenom())
-		if !supply.OutgoingSupply.Amount.Equal(outgoingSupply) {
-			panic(fmt.Sprintf("asset's outgoing supply %s does not match amount %s in outgoing atomic swaps",
-				supply.OutgoingSupply, outgoingSupply))
-		}
-		limit, err := keeper.GetSupplyLimit(ctx, supply.GetDenom())
-		if err != nil {
-			panic(err)
+		case Completed:
+			// This index stores swaps until deletion
+			keeper.InsertIntoLongtermStorage(ctx, swap)
 		}
-		if supply.CurrentSupply.Amount.GT(limit.Limit) {
-			panic(fmt.Sprintf("asset's current supply %s is over the supply limit %s", supply.CurrentSupply, limit.Limit))
-		}
-		if supply.IncomingSupply.Amount.GT(limit.Limit) {
-			panic(fmt.Sprintf("asset's incoming supply %s is over the supply limit %s", supply.IncomingSupply, limit.Limit))
-		}
-		if supply.IncomingSupply.Amount.Add(supply.CurrentSupply.Amount).GT(limit.Limit) {
-			panic(fmt.Sprintf("asset's incoming supply + current supply %s is over the supply limit %s", supply.IncomingSupply.Add(supply.CurrentSupply), limit.Limit))
-		}
-		if supply.OutgoingSupply.Amount.GT(limit.Limit) {
-			panic(fmt.Sprintf("asset's outgoing supply %s is over the supply limit %s", supply.OutgoingSupply, limit.Limit))
-		}
-
 	}
 }
 
@@ -117,5 +95,22 @@ func ExportGenesis(ctx sdk.Context, k Keeper) (data *GenesisState) {
 	if !found {
 		previousBlockTime = DefaultPreviousBlockTime
 	}
-	return NewGenesisState(params, swaps, supplies, previousBlockTime)
+	denyList := k.GetDenyList(ctx)
+	stats := k.GetAllSwapStats(ctx)
+	dailyStats := k.GetAllDailySwapStats(ctx)
+	deputyActivity := k.GetAllDeputyActivity(ctx)
+	suspendedAssets := k.GetAllAssetSuspensions(ctx)
+	deputyBonds := k.GetAllDeputyBonds(ctx)
+	deputyUnbondings := k.GetAllDeputyUnbondings(ctx)
+	lightClientStates := k.GetAllLightClientStates(ctx)
+	return NewGenesisState(params, swaps, supplies, previousBlockTime, denyList, stats, dailyStats, deputyActivity,
+		suspendedAssets, deputyBonds, deputyUnbondings, lightClientStates)
+}
+
+// ExportGenesisForZeroHeight exports the store values for a zero height genesis. The export height is recorded, so
+// that InitGenesis rebases the heights and times of the swaps, deputy activity and unbondings onto the new chain.
+func ExportGenesisForZeroHeight(ctx sdk.Context, k Keeper) *GenesisState {
+	gs := ExportGenesis(ctx, k)
+	gs.ExportHeight = ctx.BlockHeight()
+	return gs
 }
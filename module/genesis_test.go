@@ -9,9 +9,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bep3 "github.com/e-money/bep3/module"
+	"github.com/e-money/bep3/module/simulation"
 	bep3types "github.com/e-money/bep3/module/types"
 	app "github.com/e-money/bep3/testapp"
 	"github.com/stretchr/testify/suite"
+	tmtime "github.com/tendermint/tendermint/types/time"
 )
 
 type GenesisTestSuite struct {
@@ -69,6 +71,24 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 			},
 			expectPass: true,
 		},
+		{
+			name: "import deny list",
+			genState: func() app.GenesisState {
+				gs := baseGenState(suite.addrs[0])
+				gs.DenyList = []string{suite.addrs[1].String(), TestRecipientOtherChain}
+				return app.GenesisState{"bep3": bep3.ModuleCdc.MustMarshalJSON(&gs)}
+			},
+			expectPass: true,
+		},
+		{
+			name: "duplicate deny list address",
+			genState: func() app.GenesisState {
+				gs := baseGenState(suite.addrs[0])
+				gs.DenyList = []string{TestRecipientOtherChain, TestRecipientOtherChain}
+				return app.GenesisState{"bep3": bep3.ModuleCdc.MustMarshalJSON(&gs)}
+			},
+			expectPass: false,
+		},
 		{
 			name: "0 deputy fees",
 			genState: func() app.GenesisState {
@@ -149,7 +169,7 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 
 				// Deterministic randomizer
 				r := rand.New(rand.NewSource(1))
-				limit := sdk.NewInt(int64(bep3.MaxSupplyLimit))
+				limit := sdk.NewInt(int64(simulation.MaxSupplyLimit))
 				for idx, denom := range bep3Denoms {
 					bep3Coins[idx] = sdk.NewCoin(denom, limit)
 
@@ -172,7 +192,7 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 							},
 							Active:          true,
 							DeputyAddress:   suite.addrs[0].String(),
-							FixedFee:        bep3.GenRandFixedFee(r),
+							FixedFee:        simulation.GenRandFixedFee(r),
 							MinSwapAmount:   sdk.OneInt(),
 							MaxSwapAmount:   limit,
 							SwapTimestamp:   time.Now().Unix(),
@@ -312,7 +332,7 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 			name: "time lock cannot be < 1 minute",
 			genState: func() app.GenesisState {
 				gs := baseGenState(suite.addrs[0])
-				gs.Params.AssetParams[0].SwapTimeSpanMin = 59
+				gs.Params.AssetParams[0].SwapTimeSpanMin = 0
 				return app.GenesisState{"bep3": bep3.ModuleCdc.MustMarshalJSON(&gs)}
 			},
 			expectPass: false,
@@ -375,6 +395,82 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 	}
 }
 
+func (suite *GenesisTestSuite) TestExportDenyList() {
+	gs := baseGenState(suite.addrs[0])
+	gs.DenyList = []string{TestRecipientOtherChain}
+	suite.appModule.InitGenesis(suite.ctx, suite.jsonMarshaler, bep3.ModuleCdc.MustMarshalJSON(&gs))
+
+	suite.keeper.SetDeniedAddress(suite.ctx, suite.addrs[1].String())
+	exported := bep3.ExportGenesis(suite.ctx, suite.keeper)
+	suite.ElementsMatch([]string{TestRecipientOtherChain, suite.addrs[1].String()}, exported.DenyList)
+}
+
+func (suite *GenesisTestSuite) TestExportStats() {
+	stats := bep3.NewSwapStats("bnb")
+	stats.Created = 3
+	stats.Claimed = 1
+	stats.IncomingVolume = c("bnb", 50000)
+	daily := bep3.DailySwapStats{Day: bep3.SecondsPerDay, Stats: stats}
+
+	gs := baseGenState(suite.addrs[0])
+	gs.Stats = []bep3.SwapStats{stats}
+	gs.DailyStats = []bep3.DailySwapStats{daily}
+	suite.appModule.InitGenesis(suite.ctx, suite.jsonMarshaler, bep3.ModuleCdc.MustMarshalJSON(&gs))
+
+	exported := bep3.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Equal([]bep3.SwapStats{stats}, exported.Stats)
+	suite.Equal([]bep3.DailySwapStats{daily}, exported.DailyStats)
+}
+
+func (suite *GenesisTestSuite) TestExportForZeroHeight() {
+	exportTime := tmtime.Now()
+	openSwap, supply := loadSwapAndSupply(suite.addrs[1], 0)
+	openSwap.ExpireTimestamp = exportTime.Add(time.Hour).Unix()
+	closedSwap, _ := loadSwapAndSupply(suite.addrs[2], 1)
+	closedSwap.Status = bep3.Completed
+	closedSwap.ClosedBlock = 90
+	closedSwap.ClosedTime = exportTime.Add(-time.Minute).Unix()
+
+	gs := baseGenState(suite.addrs[0])
+	gs.AtomicSwaps = bep3.AtomicSwaps{openSwap, closedSwap}
+	gs.Supplies.AssetSupplies[0] = supply
+	gs.PreviousBlockTime = exportTime
+	gs.DeputyActivity = []bep3.DeputyActivity{bep3.NewDeputyActivity(suite.addrs[0].String(), 95, exportTime)}
+	suite.ctx = suite.ctx.WithBlockHeight(100).WithBlockTime(exportTime)
+	suite.appModule.InitGenesis(suite.ctx, suite.jsonMarshaler, bep3.ModuleCdc.MustMarshalJSON(&gs))
+
+	exported := bep3.ExportGenesisForZeroHeight(suite.ctx, suite.keeper)
+	suite.Equal(int64(100), exported.ExportHeight)
+
+	// Import into a new chain starting at height 1 a day later
+	suite.SetupTest()
+	genesisTime := exportTime.Add(24 * time.Hour)
+	suite.ctx = suite.ctx.WithBlockHeight(0).WithBlockTime(genesisTime)
+	suite.appModule.InitGenesis(suite.ctx, suite.jsonMarshaler, bep3.ModuleCdc.MustMarshalJSON(exported))
+
+	imported := bep3.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Equal(int64(0), imported.ExportHeight)
+	suite.Equal(genesisTime, imported.PreviousBlockTime)
+	swap, found := suite.keeper.GetAtomicSwap(suite.ctx, openSwap.GetSwapID())
+	suite.Require().True(found)
+	suite.Equal(genesisTime.Add(time.Hour).Unix(), swap.ExpireTimestamp)
+	swap, found = suite.keeper.GetAtomicSwap(suite.ctx, closedSwap.GetSwapID())
+	suite.Require().True(found)
+	suite.Equal(int64(0), swap.ClosedBlock)
+	suite.Equal(genesisTime.Add(-time.Minute).Unix(), swap.ClosedTime)
+	activity, found := suite.keeper.GetDeputyActivity(suite.ctx, suite.addrs[0].String())
+	suite.Require().True(found)
+	suite.Equal(int64(0), activity.LastActiveHeight)
+
+	// The open swap expires an hour after genesis rather than an hour after the export
+	bep3.BeginBlocker(suite.ctx.WithBlockHeight(1).WithBlockTime(genesisTime.Add(time.Minute)), suite.keeper)
+	swap, _ = suite.keeper.GetAtomicSwap(suite.ctx, openSwap.GetSwapID())
+	suite.Equal(bep3.Open, swap.Status)
+	bep3.BeginBlocker(suite.ctx.WithBlockHeight(2).WithBlockTime(genesisTime.Add(time.Hour)), suite.keeper)
+	swap, _ = suite.keeper.GetAtomicSwap(suite.ctx, openSwap.GetSwapID())
+	suite.Equal(bep3.Expired, swap.Status)
+}
+
 func TestGenesisTestSuite(t *testing.T) {
 	suite.Run(t, new(GenesisTestSuite))
 }
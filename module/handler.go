@@ -3,6 +3,7 @@ package bep3
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/e-money/bep3/module/keeper"
 )
 
@@ -22,8 +23,45 @@ func NewHandler(k Keeper) sdk.Handler {
 		case *MsgRefundAtomicSwap:
 			res, err := msgServer.RefundAtomicSwap(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *MsgBatchClaimAtomicSwaps:
+			res, err := msgServer.BatchClaimAtomicSwaps(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *MsgBatchRefundAtomicSwaps:
+			res, err := msgServer.BatchRefundAtomicSwaps(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *MsgAnnotateSwap:
+			res, err := msgServer.AnnotateSwap(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *MsgDeputyBond:
+			res, err := msgServer.DeputyBond(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *MsgDeputyUnbond:
+			res, err := msgServer.DeputyUnbond(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *MsgCreateInterchainSwap:
+			res, err := msgServer.CreateInterchainSwap(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *MsgCreateLocalSwap:
+			res, err := msgServer.CreateLocalSwap(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
 	}
 }
+
+// NewProposalHandler creates a govtypes.Handler for bep3 governance proposals
+func NewProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *UpdateDenyListProposal:
+			return keeper.HandleUpdateDenyListProposal(ctx, k, c)
+		case *DeputySlashProposal:
+			return keeper.HandleDeputySlashProposal(ctx, k, c)
+		case *SetLightClientStateProposal:
+			return keeper.HandleSetLightClientStateProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", ModuleName, c)
+		}
+	}
+}
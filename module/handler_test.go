@@ -53,12 +53,16 @@ func (suite *HandlerTestSuite) AddAtomicSwap() (tmbytes.HexBytes, tmbytes.HexByt
 
 	// Create atomic swap and check err to confirm creation
 
-	_, err := suite.keeper.CreateAtomicSwapState(
-		suite.ctx, randomNumberHash, timestamp, expireTimeSpan,
-		suite.addrs[0], suite.addrs[1], TestSenderOtherChain,
-		TestRecipientOtherChain,
-		amount, true,
-	)
+	_, err := suite.keeper.CreateAtomicSwapState(suite.ctx, suite.addrs[0], suite.addrs[1], bep3.MsgCreateAtomicSwap{
+		From:                suite.addrs[0].String(),
+		To:                  suite.addrs[1].String(),
+		RecipientOtherChain: TestRecipientOtherChain,
+		SenderOtherChain:    TestSenderOtherChain,
+		RandomNumberHash:    randomNumberHash,
+		Timestamp:           timestamp,
+		Amount:              amount,
+		TimeSpanMin:         expireTimeSpan,
+	})
 	suite.Nil(err)
 
 	swapID := bep3.CalculateSwapID(
@@ -156,6 +160,34 @@ func (suite *HandlerTestSuite) TestInvalidMsg() {
 	suite.Require().Nil(res)
 }
 
+func (suite *HandlerTestSuite) TestUpdateDenyListProposal() {
+	proposalHandler := bep3.NewProposalHandler(suite.keeper)
+	denied := suite.addrs[1].String()
+
+	err := proposalHandler(suite.ctx, bep3.NewUpdateDenyListProposal("title", "description",
+		[]string{denied, TestRecipientOtherChain}, nil))
+	suite.Require().NoError(err)
+	suite.True(suite.keeper.IsAddressDenied(suite.ctx, denied))
+	suite.True(suite.keeper.IsAddressDenied(suite.ctx, TestRecipientOtherChain))
+
+	// Denied recipients cannot receive new swaps
+	timestamp := ts(0)
+	randomNumber, _ := bep3.GenerateSecureRandomNumber()
+	msg := bep3.NewMsgCreateAtomicSwap(
+		suite.addrs[0].String(), denied, TestRecipientOtherChain, TestSenderOtherChain,
+		bep3.CalculateRandomHash(randomNumber[:], timestamp), timestamp, cs(c("bnb", int64(10000))),
+		bep3.DefaultSwapTimeSpanMinutes)
+	res, err := suite.handler(suite.ctx, msg)
+	suite.Require().Error(err)
+	suite.Nil(res)
+
+	err = proposalHandler(suite.ctx, bep3.NewUpdateDenyListProposal("title", "description",
+		nil, []string{denied}))
+	suite.Require().NoError(err)
+	suite.False(suite.keeper.IsAddressDenied(suite.ctx, denied))
+	suite.Equal([]string{TestRecipientOtherChain}, suite.keeper.GetDenyList(suite.ctx))
+}
+
 func TestHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(HandlerTestSuite))
 }
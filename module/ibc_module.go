@@ -0,0 +1,136 @@
+package bep3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	porttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/05-port/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+	bep3types "github.com/e-money/bep3/module/types"
+)
+
+var _ porttypes.IBCModule = AppModule{}
+
+// ValidateInterchainSwapChannelParams does validation of a newly created interchain swap channel. Channels must be
+// UNORDERED, so that a swap waiting to be claimed does not hold up other swaps, use the bep3 port and use the current
+// supported version.
+func ValidateInterchainSwapChannelParams(order channeltypes.Order, portID, version string) error {
+	if order != channeltypes.UNORDERED {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s ", channeltypes.UNORDERED, order)
+	}
+	if portID != PortID {
+		return sdkerrors.Wrapf(porttypes.ErrInvalidPort, "invalid port: %s, expected %s", portID, PortID)
+	}
+	if version != Version {
+		return sdkerrors.Wrapf(ErrInvalidChannel, "got version %s, expected %s", version, Version)
+	}
+	return nil
+}
+
+// OnChanOpenInit implements the IBCModule interface. Only channels governance has assigned to an asset can be opened.
+func (am AppModule) OnChanOpenInit(ctx sdk.Context, order channeltypes.Order, connectionHops []string, portID string,
+	channelID string, chanCap *capabilitytypes.Capability, counterparty channeltypes.Counterparty, version string) error {
+	if err := ValidateInterchainSwapChannelParams(order, portID, version); err != nil {
+		return err
+	}
+	if err := am.keeper.ValidateInterchainSwapChannel(ctx, channelID); err != nil {
+		return err
+	}
+	return am.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID))
+}
+
+// OnChanOpenTry implements the IBCModule interface. Only channels governance has assigned to an asset can be opened.
+func (am AppModule) OnChanOpenTry(ctx sdk.Context, order channeltypes.Order, connectionHops []string, portID,
+	channelID string, chanCap *capabilitytypes.Capability, counterparty channeltypes.Counterparty, version,
+	counterpartyVersion string) error {
+	if err := ValidateInterchainSwapChannelParams(order, portID, version); err != nil {
+		return err
+	}
+	if counterpartyVersion != Version {
+		return sdkerrors.Wrapf(ErrInvalidChannel, "got counterparty version %s, expected %s", counterpartyVersion, Version)
+	}
+	if err := am.keeper.ValidateInterchainSwapChannel(ctx, channelID); err != nil {
+		return err
+	}
+
+	// The capability is already owned in the case of crossing hellos
+	if am.keeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
+		return nil
+	}
+	return am.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID))
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (am AppModule) OnChanOpenAck(ctx sdk.Context, portID, channelID string, counterpartyVersion string) error {
+	if counterpartyVersion != Version {
+		return sdkerrors.Wrapf(ErrInvalidChannel, "got counterparty version %s, expected %s", counterpartyVersion, Version)
+	}
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (am AppModule) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnChanCloseInit implements the IBCModule interface. Channels cannot be closed while they may have open swaps, whose
+// acknowledgements settle the swaps of the counterparty chain.
+func (am AppModule) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "user cannot close channel")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (am AppModule) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. The packet creates the mirrored incoming swap, and is acknowledged
+// asynchronously once the swap is claimed or expires. Packets that cannot create a swap are acknowledged with an
+// error right away, which refunds the swap on the counterparty chain.
+func (am AppModule) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) (*sdk.Result, []byte, error) {
+	var data InterchainSwapPacketData
+	if err := bep3types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal interchain swap packet data: %s", err)
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := am.keeper.OnRecvInterchainSwap(cacheCtx, packet, data); err != nil {
+		ack := channeltypes.NewErrorAcknowledgement(err.Error())
+		return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, ack.GetBytes(), nil
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil, nil
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (am AppModule) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) (*sdk.Result, error) {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal interchain swap packet acknowledgement: %s", err)
+	}
+	var data InterchainSwapPacketData
+	if err := bep3types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal interchain swap packet data: %s", err)
+	}
+
+	if err := am.keeper.OnAcknowledgeInterchainSwap(ctx, data, ack); err != nil {
+		return nil, err
+	}
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (am AppModule) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) (*sdk.Result, error) {
+	var data InterchainSwapPacketData
+	if err := bep3types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal interchain swap packet data: %s", err)
+	}
+
+	if err := am.keeper.OnTimeoutInterchainSwap(ctx, data); err != nil {
+		return nil, err
+	}
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
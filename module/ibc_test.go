@@ -0,0 +1,323 @@
+package bep3_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+	bep3 "github.com/e-money/bep3/module"
+	app "github.com/e-money/bep3/testapp"
+	"github.com/e-money/bep3/testapp/ibctesting"
+	"github.com/stretchr/testify/suite"
+)
+
+const testChannel = "channel-0"
+
+// InterchainSwapTestSuite swaps bnb from chain A to chain B over a channel between the bep3 ports of the chains. The
+// sender accounts of both chains hold bnb that was swapped in, and relay the packets.
+type InterchainSwapTestSuite struct {
+	suite.Suite
+
+	coordinator      *ibctesting.Coordinator
+	chainA, chainB   *ibctesting.TestChain
+	clientA, clientB string
+	connA, connB     *ibctesting.TestConnection
+	channelA         ibctesting.TestChannel
+
+	recipient sdk.AccAddress
+}
+
+func (suite *InterchainSwapTestSuite) SetupTest() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	deputy := addrs[0]
+	suite.recipient = addrs[1]
+
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2, cs(c("bnb", 10000000000)),
+		func(chain *ibctesting.TestChain, genesisState app.GenesisState) {
+			genState := baseGenState(deputy)
+			genState.Params.AssetParams[0].IBCChannel = testChannel
+			genState.Supplies.AssetSupplies[0].CurrentSupply = c("bnb", 10000000000)
+			genesisState[bep3.ModuleName] = chain.App.AppCodec().MustMarshalJSON(&genState)
+		})
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(0))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+
+	suite.clientA, suite.clientB, suite.connA, suite.connB, suite.channelA, _ = suite.coordinator.Setup(
+		suite.chainA, suite.chainB, bep3.PortID, channeltypes.UNORDERED)
+	suite.Require().Equal(testChannel, suite.channelA.ID)
+}
+
+// createSwap creates an interchain swap of amount from the sender on chain A to the recipient on chain B, and returns
+// the random number of the swap and the packet sent
+func (suite *InterchainSwapTestSuite) createSwap(amount sdk.Coins) ([]byte, channeltypes.Packet) {
+	randomNumber, err := bep3.GenerateSecureRandomNumber()
+	suite.Require().NoError(err)
+	timestamp := suite.chainA.CurrentHeader.Time.Unix()
+	sender := suite.chainA.SenderAccount.GetAddress()
+	msg := bep3.NewMsgCreateInterchainSwap(sender.String(), suite.recipient.String(),
+		bep3.CalculateRandomHash(randomNumber, timestamp), timestamp, amount, 60, bep3.PortID, suite.channelA.ID)
+	msg.TimeoutTimestamp = uint64(suite.chainA.CurrentHeader.Time.Add(time.Hour).UnixNano())
+
+	_, err = suite.coordinator.SendMsgs(suite.chainA, suite.chainB, suite.clientB, msg)
+	suite.Require().NoError(err)
+
+	swap := suite.outgoingSwapA(msg.RandomNumberHash)
+	suite.Equal(bep3.Outgoing, swap.Direction)
+	suite.Require().NotNil(swap.IBCPacket)
+	return randomNumber, *swap.IBCPacket
+}
+
+func (suite *InterchainSwapTestSuite) outgoingSwapA(randomNumberHash []byte) bep3.AtomicSwap {
+	swapID := bep3.CalculateSwapID(randomNumberHash, suite.chainA.SenderAccount.GetAddress(), "")
+	swap, found := suite.chainA.App.Bep3Keeper.GetAtomicSwap(suite.chainA.GetContext(), swapID)
+	suite.Require().True(found)
+	return swap
+}
+
+func (suite *InterchainSwapTestSuite) packetData(packet channeltypes.Packet) bep3.InterchainSwapPacketData {
+	var data bep3.InterchainSwapPacketData
+	suite.Require().NoError(bep3.ModuleCdc.UnmarshalJSON(packet.Data, &data))
+	return data
+}
+
+func (suite *InterchainSwapTestSuite) incomingSwapB(packet channeltypes.Packet) bep3.AtomicSwap {
+	data := suite.packetData(packet)
+	swapID := bep3.CalculateSwapID(data.RandomNumberHash, suite.chainB.App.AccountKeeper.GetModuleAddress(bep3.ModuleName), data.Sender)
+	swap, found := suite.chainB.App.Bep3Keeper.GetAtomicSwap(suite.chainB.GetContext(), swapID)
+	suite.Require().True(found)
+	return swap
+}
+
+// recvPacket relays a packet from chain A to chain B, and returns the acknowledgement written right away, if any
+func (suite *InterchainSwapTestSuite) recvPacket(packet channeltypes.Packet) []byte {
+	res, err := suite.coordinator.RecvPacket(suite.chainA, suite.chainB, suite.clientA, packet)
+	suite.Require().NoError(err)
+	for _, event := range res.Events {
+		if event.Type != channeltypes.EventTypeWriteAck {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == channeltypes.AttributeKeyAck {
+				return attr.Value
+			}
+		}
+	}
+	return nil
+}
+
+// relayAck relays the acknowledgement of a packet written on chain B back to chain A
+func (suite *InterchainSwapTestSuite) relayAck(packet channeltypes.Packet, ack []byte) {
+	err := suite.coordinator.AcknowledgePacket(suite.chainA, suite.chainB, suite.clientB, packet, ack)
+	suite.Require().NoError(err)
+}
+
+// setIBCChannel assigns the channel to bnb on the chain, as a governance proposal would
+func (suite *InterchainSwapTestSuite) setIBCChannel(chain *ibctesting.TestChain, channelID string) {
+	asset, err := chain.App.Bep3Keeper.GetAsset(chain.GetContext(), "bnb")
+	suite.Require().NoError(err)
+	asset.IBCChannel = channelID
+	chain.App.Bep3Keeper.SetAsset(chain.GetContext(), asset)
+}
+
+func (suite *InterchainSwapTestSuite) balance(chain *ibctesting.TestChain, addr sdk.AccAddress) sdk.Int {
+	return chain.App.BankKeeper.GetBalance(chain.GetContext(), addr, "bnb").Amount
+}
+
+func (suite *InterchainSwapTestSuite) supply(chain *ibctesting.TestChain) bep3.AssetSupply {
+	supply, found := chain.App.Bep3Keeper.GetAssetSupply(chain.GetContext(), "bnb")
+	suite.Require().True(found)
+	return supply
+}
+
+func (suite *InterchainSwapTestSuite) TestClaim() {
+	amount := cs(c("bnb", 50000))
+	randomNumber, packet := suite.createSwap(amount)
+	sender := suite.chainA.SenderAccount.GetAddress()
+	suite.Equal(i(10000000000-50000), suite.balance(suite.chainA, sender))
+	suite.Equal(amount[0], suite.supply(suite.chainA).OutgoingSupply)
+
+	// The packet creates the mirrored swap, which is acknowledged once claimed
+	suite.Nil(suite.recvPacket(packet))
+	swapB := suite.incomingSwapB(packet)
+	suite.Equal(bep3.Incoming, swapB.Direction)
+	suite.Equal(suite.recipient.String(), swapB.Recipient)
+	suite.Equal(sender.String(), swapB.SenderOtherChain)
+	suite.Equal(amount[0], suite.supply(suite.chainB).IncomingSupply)
+
+	// The outgoing swap is settled over IBC only
+	swapA := suite.outgoingSwapA(swapB.RandomNumberHash)
+	claim := bep3.NewMsgClaimAtomicSwap(sender, swapA.GetSwapID(), randomNumber)
+	_, err := suite.chainA.SendMsgs(claim)
+	suite.Require().ErrorIs(err, bep3.ErrSwapNotClaimable)
+
+	claim = bep3.NewMsgClaimAtomicSwap(suite.chainB.SenderAccount.GetAddress(), swapB.GetSwapID(), randomNumber)
+	_, err = suite.coordinator.SendMsgs(suite.chainB, suite.chainA, suite.clientA, claim)
+	suite.Require().NoError(err)
+	suite.Equal(i(50000), suite.balance(suite.chainB, suite.recipient))
+	suite.True(suite.supply(suite.chainB).IncomingSupply.IsZero())
+
+	// The acknowledgement carries the random number, and burns the escrowed amount
+	suite.relayAck(packet, channeltypes.NewResultAcknowledgement(randomNumber).GetBytes())
+	swapA = suite.outgoingSwapA(swapB.RandomNumberHash)
+	suite.Equal(bep3.Completed, swapA.Status)
+	suite.Equal(i(10000000000-50000), suite.balance(suite.chainA, sender))
+	supplyA := suite.supply(suite.chainA)
+	suite.True(supplyA.OutgoingSupply.IsZero())
+	suite.Equal(i(10000000000-50000), supplyA.CurrentSupply.Amount)
+	suite.Equal(i(10000000000-50000), suite.chainA.App.BankKeeper.GetSupply(suite.chainA.GetContext()).GetTotal().AmountOf("bnb"))
+}
+
+func (suite *InterchainSwapTestSuite) TestExpiry() {
+	_, packet := suite.createSwap(cs(c("bnb", 50000)))
+	suite.Nil(suite.recvPacket(packet))
+
+	// The mirrored swap expires unclaimed, which refunds the outgoing swap
+	suite.coordinator.IncrementTimeBy(2 * time.Hour)
+	suite.coordinator.CommitBlock(suite.chainB)
+	suite.Equal(bep3.Expired, suite.incomingSwapB(packet).Status)
+	suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainA, suite.chainB, suite.clientA))
+
+	suite.relayAck(packet, channeltypes.NewErrorAcknowledgement("swap expired").GetBytes())
+	suite.Equal(i(10000000000), suite.balance(suite.chainA, suite.chainA.SenderAccount.GetAddress()))
+	suite.True(suite.supply(suite.chainA).OutgoingSupply.IsZero())
+}
+
+func (suite *InterchainSwapTestSuite) TestRejected() {
+	// Swaps of inactive assets are not created, and the packet is acknowledged with an error right away
+	asset, err := suite.chainB.App.Bep3Keeper.GetAsset(suite.chainB.GetContext(), "bnb")
+	suite.Require().NoError(err)
+	asset.Active = false
+	suite.chainB.App.Bep3Keeper.SetAsset(suite.chainB.GetContext(), asset)
+
+	_, packet := suite.createSwap(cs(c("bnb", 50000)))
+	ack := suite.recvPacket(packet)
+	suite.Require().NotNil(ack)
+	suite.Contains(string(ack), bep3.ErrAssetNotActive.Error())
+	suite.True(suite.supply(suite.chainB).IncomingSupply.IsZero())
+
+	suite.relayAck(packet, ack)
+	suite.Equal(i(10000000000), suite.balance(suite.chainA, suite.chainA.SenderAccount.GetAddress()))
+	suite.True(suite.supply(suite.chainA).OutgoingSupply.IsZero())
+}
+
+func (suite *InterchainSwapTestSuite) TestOtherChannel() {
+	// Only the channel assigned to the asset can mint it, so a channel opened before governance assigned another
+	// channel to the asset is rejected
+	_, packet := suite.createSwap(cs(c("bnb", 50000)))
+	suite.setIBCChannel(suite.chainB, "channel-1")
+	ack := suite.recvPacket(packet)
+	suite.Require().NotNil(ack)
+	suite.Contains(string(ack), bep3.ErrInvalidChannel.Error())
+	suite.True(suite.supply(suite.chainB).IncomingSupply.IsZero())
+
+	// Swaps cannot be sent over such channels either
+	suite.setIBCChannel(suite.chainA, "channel-1")
+	timestamp := suite.chainA.CurrentHeader.Time.Unix()
+	msg := bep3.NewMsgCreateInterchainSwap(suite.chainA.SenderAccount.GetAddress().String(), suite.recipient.String(),
+		bep3.CalculateRandomHash([]byte{1}, timestamp), timestamp, cs(c("bnb", 50000)), 60, bep3.PortID, testChannel)
+	msg.TimeoutHeight = clienttypes.NewHeight(0, 1000)
+	_, err := suite.chainA.SendMsgs(msg)
+	suite.Require().ErrorIs(err, bep3.ErrInvalidChannel)
+}
+
+func (suite *InterchainSwapTestSuite) TestOtherChainSender() {
+	// The sender is an address of the sending chain, which need not be a Bech32 address of this chain. The packet is
+	// sent by hand, as the bep3 module sends its own addresses.
+	_, packet := suite.createSwap(cs(c("bnb", 50000)))
+	data := suite.packetData(packet)
+	data.Sender = "0x9fB29AAc15b9A4B7F17c3385939b007540f4d791"
+	packet.Data = data.GetBytes()
+
+	ctx := suite.chainA.GetContext()
+	chanCap, found := suite.chainA.App.ScopedBep3Keeper.GetCapability(ctx, host.ChannelCapabilityPath(bep3.PortID, testChannel))
+	suite.Require().True(found)
+	packet.Sequence, found = suite.chainA.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(ctx, bep3.PortID, testChannel)
+	suite.Require().True(found)
+	suite.Require().NoError(suite.chainA.App.IBCKeeper.ChannelKeeper.SendPacket(ctx, chanCap, packet))
+	suite.coordinator.CommitBlock(suite.chainA)
+	suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainB, suite.chainA, suite.clientB))
+
+	suite.Nil(suite.recvPacket(packet))
+	swapB := suite.incomingSwapB(packet)
+	suite.Equal(data.Sender, swapB.SenderOtherChain)
+}
+
+func (suite *InterchainSwapTestSuite) TestIncorrectRandomNumber() {
+	// An acknowledgement that does not carry the random number of the swap refunds it rather than leaving it open,
+	// such as one written by a counterparty chain that does not check claims
+	_, packet := suite.createSwap(cs(c("bnb", 50000)))
+	suite.Nil(suite.recvPacket(packet))
+
+	ack := channeltypes.NewResultAcknowledgement([]byte{1}).GetBytes()
+	ctx := suite.chainB.GetContext()
+	chanCap, found := suite.chainB.App.ScopedBep3Keeper.GetCapability(ctx, host.ChannelCapabilityPath(bep3.PortID, packet.DestinationChannel))
+	suite.Require().True(found)
+	suite.Require().NoError(suite.chainB.App.IBCKeeper.ChannelKeeper.WriteAcknowledgement(ctx, chanCap, packet, ack))
+	suite.coordinator.CommitBlock(suite.chainB)
+	suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainA, suite.chainB, suite.clientA))
+
+	suite.relayAck(packet, ack)
+	swapA := suite.outgoingSwapA(suite.packetData(packet).RandomNumberHash)
+	suite.Equal(bep3.Completed, swapA.Status)
+	suite.Equal(i(10000000000), suite.balance(suite.chainA, suite.chainA.SenderAccount.GetAddress()))
+	suite.True(suite.supply(suite.chainA).OutgoingSupply.IsZero())
+	suite.Equal(i(10000000000), suite.supply(suite.chainA).CurrentSupply.Amount)
+}
+
+func (suite *InterchainSwapTestSuite) TestTimeout() {
+	// Packets must time out
+	timestamp := suite.chainA.CurrentHeader.Time.Unix()
+	msg := bep3.NewMsgCreateInterchainSwap(suite.chainA.SenderAccount.GetAddress().String(), suite.recipient.String(),
+		bep3.CalculateRandomHash([]byte{1}, timestamp), timestamp, cs(c("bnb", 50000)), 60, bep3.PortID, testChannel)
+	suite.Require().ErrorIs(msg.ValidateBasic(), bep3.ErrInvalidInterchainSwap)
+
+	_, packet := suite.createSwap(cs(c("bnb", 50000)))
+	suite.coordinator.IncrementTimeBy(2 * time.Hour)
+	suite.Require().NoError(suite.coordinator.TimeoutPacket(suite.chainA, suite.chainB, suite.clientA, packet))
+	suite.Equal(bep3.Completed, suite.outgoingSwapA(suite.packetData(packet).RandomNumberHash).Status)
+	suite.Equal(i(10000000000), suite.balance(suite.chainA, suite.chainA.SenderAccount.GetAddress()))
+	suite.True(suite.supply(suite.chainA).OutgoingSupply.IsZero())
+}
+
+func (suite *InterchainSwapTestSuite) TestChannelHandshake() {
+	// Only channels assigned to an asset can be opened
+	_, _, err := suite.coordinator.ChanOpenInit(suite.chainA, suite.chainB, suite.connA, suite.connB, bep3.PortID,
+		bep3.PortID, channeltypes.UNORDERED)
+	suite.Require().ErrorIs(err, bep3.ErrInvalidChannel)
+
+	// The channels must be unordered and of the interchain swap version
+	suite.setIBCChannel(suite.chainA, "channel-1")
+	_, _, err = suite.coordinator.ChanOpenInit(suite.chainA, suite.chainB, suite.connA, suite.connB, bep3.PortID,
+		bep3.PortID, channeltypes.ORDERED)
+	suite.Require().Error(err)
+	suite.connA.NextChannelVersion = "ics20-1"
+	_, _, err = suite.coordinator.ChanOpenInit(suite.chainA, suite.chainB, suite.connA, suite.connB, bep3.PortID,
+		bep3.PortID, channeltypes.UNORDERED)
+	suite.Require().Error(err)
+	suite.connA.NextChannelVersion = bep3.Version
+
+	channelA, channelB, err := suite.coordinator.ChanOpenInit(suite.chainA, suite.chainB, suite.connA, suite.connB,
+		bep3.PortID, bep3.PortID, channeltypes.UNORDERED)
+	suite.Require().NoError(err)
+	suite.Equal("channel-1", channelA.ID)
+	suite.Equal("channel-1", channelB.ID)
+	err = suite.coordinator.ChanOpenTry(suite.chainB, suite.chainA, channelB, channelA, suite.connB, channeltypes.UNORDERED)
+	suite.Require().ErrorIs(err, bep3.ErrInvalidChannel)
+
+	suite.setIBCChannel(suite.chainB, "channel-1")
+	suite.Require().NoError(suite.coordinator.ChanOpenTry(suite.chainB, suite.chainA, channelB, channelA, suite.connB, channeltypes.UNORDERED))
+	suite.Require().NoError(suite.coordinator.ChanOpenAck(suite.chainA, suite.chainB, channelA, channelB))
+	suite.Require().NoError(suite.coordinator.ChanOpenConfirm(suite.chainB, suite.chainA, channelB, channelA))
+	suite.Equal(channeltypes.OPEN, suite.chainB.GetChannel(channelB).State)
+
+	// Channels cannot be closed, as that would leave their swaps unsettled
+	_, err = suite.chainA.SendMsgs(channeltypes.NewMsgChannelCloseInit(bep3.PortID, testChannel, suite.chainA.SenderAccount.GetAddress()))
+	suite.Require().Error(err)
+}
+
+func TestInterchainSwapTestSuite(t *testing.T) {
+	suite.Run(t, new(InterchainSwapTestSuite))
+}
@@ -0,0 +1,97 @@
+package indexer
+
+import (
+	"context"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/e-money/bep3/module/types"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc"
+)
+
+const (
+	flagNode         = "node"
+	flagDBDir        = "db-dir"
+	flagDBBackend    = "db-backend"
+	flagGRPCAddress  = "grpc-address"
+	flagStartHeight  = "start-height"
+	flagPollInterval = "poll-interval"
+)
+
+// NewCmd returns a command running the indexer against a node and serving its history over gRPC.
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bep3-indexer",
+		Short: "index the full history of bep3 atomic swaps and serve it over gRPC",
+		Long: `Follow the block results of a node, record every bep3 atomic swap in a local
+database and serve the swap history over gRPC. The index outlives the module's
+long-term storage of closed swaps and resumes from the last indexed block.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			node, _ := cmd.Flags().GetString(flagNode)
+			dbDir, _ := cmd.Flags().GetString(flagDBDir)
+			dbBackend, _ := cmd.Flags().GetString(flagDBBackend)
+			grpcAddress, _ := cmd.Flags().GetString(flagGRPCAddress)
+			startHeight, _ := cmd.Flags().GetInt64(flagStartHeight)
+			pollInterval, _ := cmd.Flags().GetDuration(flagPollInterval)
+
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+
+			db, err := dbm.NewDB("bep3-indexer", dbm.BackendType(dbBackend), dbDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			client, err := rpchttp.New(node, "/websocket")
+			if err != nil {
+				return err
+			}
+
+			idx := New(db, logger)
+
+			listener, err := net.Listen("tcp", grpcAddress)
+			if err != nil {
+				return err
+			}
+			server := grpc.NewServer()
+			types.RegisterIndexerServer(server, NewQueryServer(idx))
+			go func() {
+				if err := server.Serve(listener); err != nil {
+					logger.Error("gRPC server stopped", "err", err)
+				}
+			}()
+			defer server.GracefulStop()
+			logger.Info("serving swap history", "address", grpcAddress, "node", node)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go func() {
+				sigs := make(chan os.Signal, 1)
+				signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+				<-sigs
+				cancel()
+			}()
+
+			if err := idx.Run(ctx, NewRPCBlockSource(client), startHeight, pollInterval); err != context.Canceled {
+				return err
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagNode, "tcp://localhost:26657", "<host>:<port> of the Tendermint RPC interface to follow")
+	cmd.Flags().String(flagDBDir, "bep3-indexer", "directory of the index database")
+	cmd.Flags().String(flagDBBackend, string(dbm.GoLevelDBBackend), "database backend of the index")
+	cmd.Flags().String(flagGRPCAddress, "0.0.0.0:9190", "address the history gRPC service listens on")
+	cmd.Flags().Int64(flagStartHeight, 1, "height to start indexing from when the index is empty")
+	cmd.Flags().Duration(flagPollInterval, 5*time.Second, "interval between polls for new blocks")
+	return cmd
+}
@@ -0,0 +1,84 @@
+package indexer
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/e-money/bep3/module/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.IndexerServer = queryServer{}
+
+type queryServer struct {
+	idx *Indexer
+}
+
+// NewQueryServer returns an implementation of the Indexer gRPC service backed by idx.
+func NewQueryServer(idx *Indexer) types.IndexerServer {
+	return queryServer{idx: idx}
+}
+
+func (s queryServer) SwapHistory(_ context.Context, req *types.QuerySwapHistoryRequest) (*types.QuerySwapHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	swapID, err := hex.DecodeString(req.SwapID)
+	if err != nil || len(swapID) != types.SwapIDLength {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid swap id %q", req.SwapID)
+	}
+
+	swap, found := s.idx.GetSwap(swapID)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "swap %s not indexed", req.SwapID)
+	}
+
+	return &types.QuerySwapHistoryResponse{Swap: swap}, nil
+}
+
+func (s queryServer) SwapsHistory(_ context.Context, req *types.QuerySwapsHistoryRequest) (*types.QuerySwapsHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Status != types.NULL && !req.Status.IsValid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid status %d", req.Status)
+	}
+
+	store := s.idx.store()
+	index := prefix.NewStore(store, SwapByHeightPrefix)
+	if req.Address != "" {
+		index = prefix.NewStore(store, append(append([]byte{}, SwapByAddressPrefix...), GetAddressPrefix(req.Address)...))
+	}
+
+	var swaps []types.IndexedSwap
+	pageRes, err := query.FilteredPaginate(index, req.Pagination, func(_, swapID []byte, accumulate bool) (bool, error) {
+		swap, found := getSwap(store, swapID)
+		if !found {
+			return false, nil
+		}
+		if req.Status != types.NULL && swap.Status != req.Status {
+			return false, nil
+		}
+		if accumulate {
+			swaps = append(swaps, swap)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QuerySwapsHistoryResponse{Swaps: swaps, Pagination: pageRes}, nil
+}
+
+func (s queryServer) IndexerStatus(_ context.Context, req *types.QueryIndexerStatusRequest) (*types.QueryIndexerStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryIndexerStatusResponse{LastIndexedHeight: s.idx.LastIndexedHeight()}, nil
+}
@@ -0,0 +1,367 @@
+// Package indexer keeps the full history of bep3 atomic swaps outside of consensus state.
+//
+// The module prunes closed swaps from its store once their long-term storage period has passed. The
+// indexer follows a node's block results instead, records every swap it sees in a local database and
+// serves that history over gRPC, so explorers and auditors do not need archive nodes.
+package indexer
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/bep3/module/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// Indexer records bep3 swaps from block events into a local database.
+type Indexer struct {
+	db     dbm.DB
+	logger log.Logger
+}
+
+// New creates an Indexer backed by db.
+func New(db dbm.DB, logger log.Logger) *Indexer {
+	return &Indexer{
+		db:     db,
+		logger: logger.With("module", "bep3-indexer"),
+	}
+}
+
+func (idx *Indexer) store() sdk.KVStore {
+	return dbadapter.Store{DB: idx.db}
+}
+
+// LastIndexedHeight returns the height of the last block written to the index, or zero if none was.
+func (idx *Indexer) LastIndexedHeight() int64 {
+	bz := idx.store().Get(LastHeightKey)
+	if bz == nil {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+// GetSwap returns the indexed record of a swap.
+func (idx *Indexer) GetSwap(swapID []byte) (types.IndexedSwap, bool) {
+	return getSwap(idx.store(), swapID)
+}
+
+// Run keeps the index in sync with source until ctx is cancelled. Failures are logged and retried on the
+// next poll.
+func (idx *Indexer) Run(ctx context.Context, source BlockSource, fromHeight int64, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := idx.Sync(ctx, source, fromHeight); err != nil && ctx.Err() == nil {
+			idx.logger.Error("failed to sync swap index", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync indexes all blocks between the last indexed block and the latest block of source. An empty index
+// starts at fromHeight.
+func (idx *Indexer) Sync(ctx context.Context, source BlockSource, fromHeight int64) error {
+	latest, err := source.LatestHeight(ctx)
+	if err != nil {
+		return err
+	}
+
+	height := idx.LastIndexedHeight() + 1
+	if height == 1 && fromHeight > 1 {
+		height = fromHeight
+	}
+
+	for ; height <= latest; height++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		block, err := source.Block(ctx, height)
+		if err != nil {
+			return err
+		}
+		if err := idx.IndexBlock(block); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexBlock applies the bep3 events of a block to the index. Blocks must be indexed in order, blocks at or
+// below the last indexed height are ignored.
+func (idx *Indexer) IndexBlock(block Block) error {
+	last := idx.LastIndexedHeight()
+	if block.Height <= last {
+		return nil
+	}
+	if last != 0 && block.Height != last+1 {
+		return fmt.Errorf("cannot index block %d, last indexed block is %d", block.Height, last)
+	}
+
+	// Changes are written once the whole block is processed. The last height sorts after all other keys
+	// so a block interrupted mid-write is indexed again on restart.
+	store := cachekv.NewStore(idx.store())
+
+	for _, event := range block.BeginBlockEvents {
+		if err := applyEvent(store, block, "", event); err != nil {
+			return err
+		}
+	}
+	for _, tx := range block.Txs {
+		if tx.Code != abci.CodeTypeOK {
+			continue
+		}
+		for _, event := range tx.Events {
+			if err := applyEvent(store, block, tx.Hash, event); err != nil {
+				return err
+			}
+		}
+	}
+	for _, event := range block.EndBlockEvents {
+		if err := applyEvent(store, block, "", event); err != nil {
+			return err
+		}
+	}
+
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(block.Height))
+	store.Set(LastHeightKey, bz)
+	store.Write()
+	return nil
+}
+
+func applyEvent(store sdk.KVStore, block Block, txHash string, event abci.Event) error {
+	attrs := make(map[string]string, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		attrs[string(attr.Key)] = string(attr.Value)
+	}
+
+	switch event.Type {
+	case types.EventTypeCreateAtomicSwap:
+		return applyCreate(store, block, txHash, attrs)
+	case types.EventTypeClaimAtomicSwap:
+		return applyClaim(store, block, txHash, attrs)
+	case types.EventTypeRefundAtomicSwap:
+		return applyRefund(store, block, txHash, attrs)
+	case types.EventTypeSwapsExpired:
+		return applyExpired(store, block, attrs)
+	case types.EventTypeAnnotateSwap:
+		return applyAnnotate(store, attrs)
+	default:
+		return nil
+	}
+}
+
+func applyCreate(store sdk.KVStore, block Block, txHash string, attrs map[string]string) error {
+	swapID, err := decodeHexAttribute(attrs, types.AttributeKeyAtomicSwapID)
+	if err != nil {
+		return err
+	}
+	randomNumberHash, err := decodeHexAttribute(attrs, types.AttributeKeyRandomNumberHash)
+	if err != nil {
+		return err
+	}
+	amount, err := sdk.ParseCoinsNormalized(attrs[types.AttributeKeyAmount])
+	if err != nil {
+		return fmt.Errorf("swap %X: invalid amount: %w", swapID, err)
+	}
+	claimTip, err := sdk.ParseCoinsNormalized(attrs[types.AttributeKeyClaimTip])
+	if err != nil {
+		return fmt.Errorf("swap %X: invalid claim tip: %w", swapID, err)
+	}
+	timestamp, err := parseIntAttribute(attrs, types.AttributeKeyTimestamp)
+	if err != nil {
+		return err
+	}
+	expireTimestamp, err := parseIntAttribute(attrs, types.AttributeKeyExpireTimestamp)
+	if err != nil {
+		return err
+	}
+
+	swap := types.IndexedSwap{
+		SwapID:              swapID,
+		RandomNumberHash:    randomNumberHash,
+		Amount:              amount,
+		ClaimTip:            claimTip,
+		Sender:              attrs[types.AttributeKeySender],
+		Recipient:           attrs[types.AttributeKeyRecipient],
+		SenderOtherChain:    attrs[types.AttributeKeySenderOtherChain],
+		RecipientOtherChain: attrs[types.AttributeKeyRecipientOtherChain],
+		Timestamp:           timestamp,
+		ExpireTimestamp:     expireTimestamp,
+		Direction:           types.NewSwapDirectionFromString(attrs[types.AttributeKeyDirection]),
+		Status:              types.Open,
+		CreatedHeight:       block.Height,
+		CreatedTime:         block.Time,
+		CreatedTxHash:       txHash,
+		Memo:                attrs[types.AttributeKeyMemo],
+		OtherChainTxHash:    attrs[types.AttributeKeyOtherChainTxHash],
+	}
+	if attrs[types.AttributeKeyPartialClaims] == "true" {
+		swap.RemainingAmount = amount
+	}
+	setSwap(store, swap)
+	return nil
+}
+
+func applyClaim(store sdk.KVStore, block Block, txHash string, attrs map[string]string) error {
+	swapID, err := decodeHexAttribute(attrs, types.AttributeKeyAtomicSwapID)
+	if err != nil {
+		return err
+	}
+	randomNumber, err := decodeHexAttribute(attrs, types.AttributeKeyRandomNumber)
+	if err != nil {
+		return err
+	}
+	remainingAmount, err := sdk.ParseCoinsNormalized(attrs[types.AttributeKeyRemainingAmount])
+	if err != nil {
+		return fmt.Errorf("swap %X: invalid remaining amount: %w", swapID, err)
+	}
+
+	swap := getOrInitSwap(store, swapID, attrs)
+	if swap.Recipient == "" {
+		swap.Recipient = attrs[types.AttributeKeyRecipient]
+	}
+	swap.ClaimSender = attrs[types.AttributeKeyClaimSender]
+	swap.RandomNumber = randomNumber
+	swap.RemainingAmount = remainingAmount
+	// A partially claimed swap stays open until its remaining amount is claimed
+	if remainingAmount.Empty() {
+		swap.Status = types.Completed
+		closeSwap(&swap, block, txHash)
+	}
+	setSwap(store, swap)
+	return nil
+}
+
+func applyRefund(store sdk.KVStore, block Block, txHash string, attrs map[string]string) error {
+	swapID, err := decodeHexAttribute(attrs, types.AttributeKeyAtomicSwapID)
+	if err != nil {
+		return err
+	}
+
+	swap := getOrInitSwap(store, swapID, attrs)
+	if swap.Sender == "" {
+		swap.Sender = attrs[types.AttributeKeySender]
+	}
+	swap.Status = types.Completed
+	swap.RefundSender = attrs[types.AttributeKeyRefundSender]
+	closeSwap(&swap, block, txHash)
+	setSwap(store, swap)
+	return nil
+}
+
+func applyExpired(store sdk.KVStore, block Block, attrs map[string]string) error {
+	// The swap IDs are emitted as a formatted string slice, e.g. "[id1 id2]"
+	ids := strings.Fields(strings.Trim(attrs[types.AttributeKeyAtomicSwapIDs], "[]"))
+	for _, id := range ids {
+		swapID, err := decodeHex(types.AttributeKeyAtomicSwapIDs, id)
+		if err != nil {
+			return err
+		}
+		swap := getOrInitSwap(store, swapID, attrs)
+		swap.Status = types.Expired
+		swap.ExpiredHeight = block.Height
+		setSwap(store, swap)
+	}
+	return nil
+}
+
+func applyAnnotate(store sdk.KVStore, attrs map[string]string) error {
+	swapID, err := decodeHexAttribute(attrs, types.AttributeKeyAtomicSwapID)
+	if err != nil {
+		return err
+	}
+
+	swap := getOrInitSwap(store, swapID, attrs)
+	swap.OtherChainTxHash = attrs[types.AttributeKeyOtherChainTxHash]
+	setSwap(store, swap)
+	return nil
+}
+
+func closeSwap(swap *types.IndexedSwap, block Block, txHash string) {
+	swap.ClosedHeight = block.Height
+	swap.ClosedTime = block.Time
+	swap.ClosedTxHash = txHash
+}
+
+// getOrInitSwap returns the indexed swap or, for swaps created before the index started, a record holding
+// what the current event reveals about it.
+func getOrInitSwap(store sdk.KVStore, swapID []byte, attrs map[string]string) types.IndexedSwap {
+	swap, found := getSwap(store, swapID)
+	if found {
+		return swap
+	}
+
+	swap = types.IndexedSwap{SwapID: swapID}
+	if rnh, err := decodeHexAttribute(attrs, types.AttributeKeyRandomNumberHash); err == nil {
+		swap.RandomNumberHash = rnh
+	}
+	return swap
+}
+
+func getSwap(store sdk.KVStore, swapID []byte) (types.IndexedSwap, bool) {
+	bz := prefix.NewStore(store, SwapPrefix).Get(swapID)
+	if bz == nil {
+		return types.IndexedSwap{}, false
+	}
+
+	var swap types.IndexedSwap
+	if err := swap.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return swap, true
+}
+
+// setSwap stores a swap and refreshes its height and address indexes.
+func setSwap(store sdk.KVStore, swap types.IndexedSwap) {
+	bz, err := swap.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	prefix.NewStore(store, SwapPrefix).Set(swap.SwapID, bz)
+
+	prefix.NewStore(store, SwapByHeightPrefix).Set(GetSwapByHeightKey(swap.CreatedHeight, swap.SwapID), swap.SwapID)
+	for _, address := range []string{swap.Sender, swap.Recipient} {
+		if address == "" {
+			continue
+		}
+		prefix.NewStore(store, SwapByAddressPrefix).Set(GetSwapByAddressKey(address, swap.CreatedHeight, swap.SwapID), swap.SwapID)
+	}
+}
+
+func decodeHexAttribute(attrs map[string]string, key string) ([]byte, error) {
+	return decodeHex(key, attrs[key])
+}
+
+func decodeHex(key, value string) ([]byte, error) {
+	bz, err := hex.DecodeString(value)
+	if err != nil || len(bz) == 0 {
+		return nil, fmt.Errorf("invalid %s attribute %q", key, value)
+	}
+	return bz, nil
+}
+
+func parseIntAttribute(attrs map[string]string, key string) (int64, error) {
+	v, err := strconv.ParseInt(attrs[key], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s attribute %q", key, attrs[key])
+	}
+	return v, nil
+}
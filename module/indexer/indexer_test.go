@@ -0,0 +1,280 @@
+package indexer_test
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/e-money/bep3/module/indexer"
+	"github.com/e-money/bep3/module/types"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+type IndexerTestSuite struct {
+	suite.Suite
+
+	idx     *indexer.Indexer
+	server  types.IndexerServer
+	source  *fakeSource
+	deputy  sdk.AccAddress
+	user    sdk.AccAddress
+	swapIDs [][]byte
+}
+
+func (suite *IndexerTestSuite) SetupTest() {
+	suite.idx = indexer.New(dbm.NewMemDB(), log.NewNopLogger())
+	suite.server = indexer.NewQueryServer(suite.idx)
+	suite.source = &fakeSource{}
+	suite.deputy = sdk.AccAddress(crypto.AddressHash([]byte("deputy")))
+	suite.user = sdk.AccAddress(crypto.AddressHash([]byte("user")))
+
+	suite.swapIDs = nil
+	for i := 0; i < 3; i++ {
+		rnh := types.CalculateRandomHash([]byte{byte(i)}, int64(i))
+		suite.swapIDs = append(suite.swapIDs, types.CalculateSwapID(rnh, suite.deputy, "bnb1sender"))
+	}
+}
+
+func (suite *IndexerTestSuite) TestSwapLifecycle() {
+	secret := make([]byte, types.RandomNumberLength)
+	// Block 1 creates three swaps, one of them in a failed tx
+	suite.source.add(nil,
+		indexer.Tx{Hash: "AA", Events: []abci.Event{suite.createEvent(0), suite.createEvent(1)}},
+		indexer.Tx{Hash: "BB", Code: 5, Events: []abci.Event{suite.createEvent(2)}},
+	)
+	// Block 2 claims the first swap
+	suite.source.add(nil, indexer.Tx{Hash: "CC", Events: []abci.Event{suite.claimEvent(0, secret)}})
+	// Block 3 expires the second swap and the deputy annotates it, block 4 refunds it
+	suite.source.add([]abci.Event{suite.expiredEvent(1)}, indexer.Tx{Hash: "DA", Events: []abci.Event{suite.annotateEvent(1, "0xbeef")}})
+	suite.source.add(nil, indexer.Tx{Hash: "DD", Events: []abci.Event{suite.refundEvent(1)}})
+
+	suite.Require().NoError(suite.idx.Sync(context.Background(), suite.source, 1))
+	suite.Equal(int64(4), suite.idx.LastIndexedHeight())
+
+	claimed, found := suite.idx.GetSwap(suite.swapIDs[0])
+	suite.Require().True(found)
+	suite.Equal(types.Completed, claimed.Status)
+	suite.Equal(types.Incoming, claimed.Direction)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("bnb", 50000)), claimed.Amount)
+	suite.Equal("bnb1recipient", claimed.RecipientOtherChain)
+	suite.Equal(int64(1), claimed.CreatedHeight)
+	suite.Equal("AA", claimed.CreatedTxHash)
+	suite.Equal(int64(2), claimed.ClosedHeight)
+	suite.Equal("CC", claimed.ClosedTxHash)
+	suite.Equal(suite.user.String(), claimed.ClaimSender)
+	suite.Equal(secret, []byte(claimed.RandomNumber))
+	suite.Equal("memo 0", claimed.Memo)
+	suite.Empty(claimed.OtherChainTxHash)
+
+	refunded, found := suite.idx.GetSwap(suite.swapIDs[1])
+	suite.Require().True(found)
+	suite.Equal(types.Completed, refunded.Status)
+	suite.Equal(int64(3), refunded.ExpiredHeight)
+	suite.Equal(int64(4), refunded.ClosedHeight)
+	suite.Equal(suite.deputy.String(), refunded.RefundSender)
+	suite.Equal("0xbeef", refunded.OtherChainTxHash)
+
+	// Events of failed txs are ignored
+	_, found = suite.idx.GetSwap(suite.swapIDs[2])
+	suite.False(found)
+}
+
+func (suite *IndexerTestSuite) TestPartialClaims() {
+	secret := make([]byte, types.RandomNumberLength)
+	create := suite.createEvent(0)
+	create.Attributes = append(create.Attributes, abci.EventAttribute{Key: []byte(types.AttributeKeyPartialClaims), Value: []byte("true")})
+	suite.source.add(nil, indexer.Tx{Hash: "AA", Events: []abci.Event{create}})
+	suite.source.add(nil, indexer.Tx{Hash: "BB", Events: []abci.Event{suite.partialClaimEvent(0, secret, "20000bnb", "30000bnb")}})
+	suite.Require().NoError(suite.idx.Sync(context.Background(), suite.source, 1))
+
+	swap, found := suite.idx.GetSwap(suite.swapIDs[0])
+	suite.Require().True(found)
+	suite.Equal(types.Open, swap.Status)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("bnb", 30000)), swap.RemainingAmount)
+	suite.Equal(int64(0), swap.ClosedHeight)
+
+	suite.source.add(nil, indexer.Tx{Hash: "CC", Events: []abci.Event{suite.partialClaimEvent(0, secret, "30000bnb", "")}})
+	suite.Require().NoError(suite.idx.Sync(context.Background(), suite.source, 1))
+
+	swap, found = suite.idx.GetSwap(suite.swapIDs[0])
+	suite.Require().True(found)
+	suite.Equal(types.Completed, swap.Status)
+	suite.Empty(swap.RemainingAmount)
+	suite.Equal(int64(3), swap.ClosedHeight)
+	suite.Equal("CC", swap.ClosedTxHash)
+}
+
+func (suite *IndexerTestSuite) TestSyncResumes() {
+	suite.source.add(nil, indexer.Tx{Hash: "AA", Events: []abci.Event{suite.createEvent(0)}})
+	suite.Require().NoError(suite.idx.Sync(context.Background(), suite.source, 1))
+	suite.Equal(int64(1), suite.idx.LastIndexedHeight())
+
+	suite.source.add(nil, indexer.Tx{Hash: "BB", Events: []abci.Event{suite.claimEvent(0, make([]byte, 32))}})
+	suite.Require().NoError(suite.idx.Sync(context.Background(), suite.source, 1))
+	suite.Equal(int64(2), suite.idx.LastIndexedHeight())
+
+	// Blocks already indexed are skipped, gaps are rejected
+	suite.Require().NoError(suite.idx.IndexBlock(suite.source.blocks[0]))
+	suite.Error(suite.idx.IndexBlock(indexer.Block{Height: 5}))
+
+	swap, found := suite.idx.GetSwap(suite.swapIDs[0])
+	suite.Require().True(found)
+	suite.Equal(types.Completed, swap.Status)
+}
+
+func (suite *IndexerTestSuite) TestSyncFromHeight() {
+	suite.source.add(nil, indexer.Tx{Hash: "AA", Events: []abci.Event{suite.createEvent(0)}})
+	suite.source.add(nil, indexer.Tx{Hash: "BB", Events: []abci.Event{suite.claimEvent(0, make([]byte, 32))}})
+
+	// Starting after the swap was created still records its claim
+	suite.Require().NoError(suite.idx.Sync(context.Background(), suite.source, 2))
+	swap, found := suite.idx.GetSwap(suite.swapIDs[0])
+	suite.Require().True(found)
+	suite.Equal(types.Completed, swap.Status)
+	suite.Equal(int64(0), swap.CreatedHeight)
+	suite.Equal(suite.deputy.String(), swap.Recipient)
+}
+
+func (suite *IndexerTestSuite) TestQueries() {
+	suite.source.add(nil, indexer.Tx{Hash: "AA", Events: []abci.Event{suite.createEvent(0), suite.createEvent(1)}})
+	suite.source.add(nil, indexer.Tx{Hash: "BB", Events: []abci.Event{suite.createEvent(2), suite.claimEvent(1, make([]byte, 32))}})
+	suite.Require().NoError(suite.idx.Sync(context.Background(), suite.source, 1))
+	ctx := context.Background()
+
+	res, err := suite.server.SwapHistory(ctx, &types.QuerySwapHistoryRequest{SwapID: hex.EncodeToString(suite.swapIDs[2])})
+	suite.Require().NoError(err)
+	suite.Equal(int64(2), res.Swap.CreatedHeight)
+
+	_, err = suite.server.SwapHistory(ctx, &types.QuerySwapHistoryRequest{SwapID: hex.EncodeToString(make([]byte, 32))})
+	suite.Error(err)
+	_, err = suite.server.SwapHistory(ctx, &types.QuerySwapHistoryRequest{SwapID: "zz"})
+	suite.Error(err)
+
+	all, err := suite.server.SwapsHistory(ctx, &types.QuerySwapsHistoryRequest{})
+	suite.Require().NoError(err)
+	suite.Len(all.Swaps, 3)
+
+	byAddress, err := suite.server.SwapsHistory(ctx, &types.QuerySwapsHistoryRequest{Address: suite.user.String()})
+	suite.Require().NoError(err)
+	suite.Len(byAddress.Swaps, 3)
+
+	none, err := suite.server.SwapsHistory(ctx, &types.QuerySwapsHistoryRequest{Address: "emoney1unknown"})
+	suite.Require().NoError(err)
+	suite.Empty(none.Swaps)
+
+	open, err := suite.server.SwapsHistory(ctx, &types.QuerySwapsHistoryRequest{Status: types.Open})
+	suite.Require().NoError(err)
+	suite.Len(open.Swaps, 2)
+
+	page, err := suite.server.SwapsHistory(ctx, &types.QuerySwapsHistoryRequest{Pagination: &query.PageRequest{Limit: 2}})
+	suite.Require().NoError(err)
+	suite.Len(page.Swaps, 2)
+	suite.NotNil(page.Pagination.NextKey)
+
+	status, err := suite.server.IndexerStatus(ctx, &types.QueryIndexerStatusRequest{})
+	suite.Require().NoError(err)
+	suite.Equal(int64(2), status.LastIndexedHeight)
+}
+
+func (suite *IndexerTestSuite) createEvent(i int) abci.Event {
+	return abci.Event(sdk.NewEvent(
+		types.EventTypeCreateAtomicSwap,
+		sdk.NewAttribute(types.AttributeKeySender, suite.deputy.String()),
+		sdk.NewAttribute(types.AttributeKeyRecipient, suite.user.String()),
+		sdk.NewAttribute(types.AttributeKeyAtomicSwapID, hex.EncodeToString(suite.swapIDs[i])),
+		sdk.NewAttribute(types.AttributeKeyRandomNumberHash, hex.EncodeToString(types.CalculateRandomHash([]byte{byte(i)}, int64(i)))),
+		sdk.NewAttribute(types.AttributeKeyTimestamp, fmt.Sprintf("%d", i)),
+		sdk.NewAttribute(types.AttributeKeySenderOtherChain, "bnb1sender"),
+		sdk.NewAttribute(types.AttributeKeyRecipientOtherChain, "bnb1recipient"),
+		sdk.NewAttribute(types.AttributeKeyExpireTimestamp, "1000"),
+		sdk.NewAttribute(types.AttributeKeyAmount, "50000bnb"),
+		sdk.NewAttribute(types.AttributeKeyDirection, types.Incoming.String()),
+		sdk.NewAttribute(types.AttributeKeyClaimTip, ""),
+		sdk.NewAttribute(types.AttributeKeyMemo, fmt.Sprintf("memo %d", i)),
+		sdk.NewAttribute(types.AttributeKeyOtherChainTxHash, ""),
+	))
+}
+
+func (suite *IndexerTestSuite) claimEvent(i int, randomNumber []byte) abci.Event {
+	return abci.Event(sdk.NewEvent(
+		types.EventTypeClaimAtomicSwap,
+		sdk.NewAttribute(types.AttributeKeyClaimSender, suite.user.String()),
+		sdk.NewAttribute(types.AttributeKeyRecipient, suite.deputy.String()),
+		sdk.NewAttribute(types.AttributeKeyAtomicSwapID, hex.EncodeToString(suite.swapIDs[i])),
+		sdk.NewAttribute(types.AttributeKeyRandomNumberHash, hex.EncodeToString(types.CalculateRandomHash([]byte{byte(i)}, int64(i)))),
+		sdk.NewAttribute(types.AttributeKeyRandomNumber, hex.EncodeToString(randomNumber)),
+		sdk.NewAttribute(types.AttributeKeyClaimTip, ""),
+	))
+}
+
+func (suite *IndexerTestSuite) partialClaimEvent(i int, randomNumber []byte, amount, remainingAmount string) abci.Event {
+	event := suite.claimEvent(i, randomNumber)
+	event.Attributes = append(event.Attributes,
+		abci.EventAttribute{Key: []byte(types.AttributeKeyAmount), Value: []byte(amount)},
+		abci.EventAttribute{Key: []byte(types.AttributeKeyRemainingAmount), Value: []byte(remainingAmount)},
+	)
+	return event
+}
+
+func (suite *IndexerTestSuite) refundEvent(i int) abci.Event {
+	return abci.Event(sdk.NewEvent(
+		types.EventTypeRefundAtomicSwap,
+		sdk.NewAttribute(types.AttributeKeyRefundSender, suite.deputy.String()),
+		sdk.NewAttribute(types.AttributeKeySender, suite.deputy.String()),
+		sdk.NewAttribute(types.AttributeKeyAtomicSwapID, hex.EncodeToString(suite.swapIDs[i])),
+		sdk.NewAttribute(types.AttributeKeyRandomNumberHash, hex.EncodeToString(types.CalculateRandomHash([]byte{byte(i)}, int64(i)))),
+	))
+}
+
+func (suite *IndexerTestSuite) annotateEvent(i int, otherChainTxHash string) abci.Event {
+	return abci.Event(sdk.NewEvent(
+		types.EventTypeAnnotateSwap,
+		sdk.NewAttribute(types.AttributeKeyAnnotateSender, suite.deputy.String()),
+		sdk.NewAttribute(types.AttributeKeyAtomicSwapID, hex.EncodeToString(suite.swapIDs[i])),
+		sdk.NewAttribute(types.AttributeKeyRandomNumberHash, hex.EncodeToString(types.CalculateRandomHash([]byte{byte(i)}, int64(i)))),
+		sdk.NewAttribute(types.AttributeKeyOtherChainTxHash, otherChainTxHash),
+	))
+}
+
+func (suite *IndexerTestSuite) expiredEvent(i int) abci.Event {
+	return abci.Event(sdk.NewEvent(
+		types.EventTypeSwapsExpired,
+		sdk.NewAttribute(types.AttributeKeyAtomicSwapIDs, fmt.Sprintf("%s", []string{hex.EncodeToString(suite.swapIDs[i])})),
+		sdk.NewAttribute(types.AttributeExpirationBlock, "3"),
+	))
+}
+
+// fakeSource serves blocks from memory, numbered from 1.
+type fakeSource struct {
+	blocks []indexer.Block
+}
+
+func (s *fakeSource) add(beginBlockEvents []abci.Event, txs ...indexer.Tx) {
+	height := int64(len(s.blocks) + 1)
+	s.blocks = append(s.blocks, indexer.Block{
+		Height:           height,
+		Time:             time.Unix(1600000000+height*5, 0).UTC(),
+		BeginBlockEvents: beginBlockEvents,
+		Txs:              txs,
+	})
+}
+
+func (s *fakeSource) LatestHeight(context.Context) (int64, error) {
+	return int64(len(s.blocks)), nil
+}
+
+func (s *fakeSource) Block(_ context.Context, height int64) (indexer.Block, error) {
+	return s.blocks[height-1], nil
+}
+
+func TestIndexerTestSuite(t *testing.T) {
+	suite.Run(t, new(IndexerTestSuite))
+}
@@ -0,0 +1,29 @@
+package indexer
+
+import (
+	"github.com/e-money/bep3/module/types"
+)
+
+// Key prefixes of the indexer database. The database is local to the indexer and independent of the
+// module's consensus store.
+var (
+	SwapPrefix          = []byte{0x00} // prefix for keys that store IndexedSwaps
+	SwapByHeightPrefix  = []byte{0x01} // prefix for keys of the swaps by creation height index
+	SwapByAddressPrefix = []byte{0x02} // prefix for keys of the swaps by sender and recipient index
+	LastHeightKey       = []byte{0x03} // key of the last indexed block height
+)
+
+// GetSwapByHeightKey returns the key of a swap in the creation height index.
+func GetSwapByHeightKey(height int64, swapID []byte) []byte {
+	return types.GetAtomicSwapByHeightKey(uint64(height), swapID)
+}
+
+// GetAddressPrefix returns the prefix of all swaps sent or received by address in the address index.
+func GetAddressPrefix(address string) []byte {
+	return append([]byte{byte(len(address))}, address...)
+}
+
+// GetSwapByAddressKey returns the key of a swap in the address index, ordered by creation height.
+func GetSwapByAddressKey(address string, height int64, swapID []byte) []byte {
+	return append(GetAddressPrefix(address), GetSwapByHeightKey(height, swapID)...)
+}
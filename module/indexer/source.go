@@ -0,0 +1,77 @@
+package indexer
+
+import (
+	"context"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+)
+
+// Block holds the events of a block that the indexer consumes.
+type Block struct {
+	Height           int64
+	Time             time.Time
+	BeginBlockEvents []abci.Event
+	Txs              []Tx
+	EndBlockEvents   []abci.Event
+}
+
+// Tx holds the result of a transaction within a Block.
+type Tx struct {
+	Hash   string
+	Code   uint32
+	Events []abci.Event
+}
+
+// BlockSource provides the blocks the indexer follows.
+type BlockSource interface {
+	LatestHeight(ctx context.Context) (int64, error)
+	Block(ctx context.Context, height int64) (Block, error)
+}
+
+type rpcBlockSource struct {
+	client rpcclient.Client
+}
+
+// NewRPCBlockSource creates a BlockSource reading blocks and block results from a Tendermint RPC client.
+func NewRPCBlockSource(client rpcclient.Client) BlockSource {
+	return rpcBlockSource{client: client}
+}
+
+func (s rpcBlockSource) LatestHeight(ctx context.Context) (int64, error) {
+	status, err := s.client.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+func (s rpcBlockSource) Block(ctx context.Context, height int64) (Block, error) {
+	block, err := s.client.Block(ctx, &height)
+	if err != nil {
+		return Block{}, err
+	}
+	results, err := s.client.BlockResults(ctx, &height)
+	if err != nil {
+		return Block{}, err
+	}
+
+	txs := make([]Tx, len(results.TxsResults))
+	for i, res := range results.TxsResults {
+		txs[i] = Tx{
+			Hash:   tmbytes.HexBytes(block.Block.Data.Txs[i].Hash()).String(),
+			Code:   res.Code,
+			Events: res.Events,
+		}
+	}
+
+	return Block{
+		Height:           height,
+		Time:             block.Block.Time,
+		BeginBlockEvents: results.BeginBlockEvents,
+		Txs:              txs,
+		EndBlockEvents:   results.EndBlockEvents,
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bep3 "github.com/e-money/bep3/module"
+	"github.com/e-money/bep3/module/simulation"
 	"github.com/e-money/bep3/module/types"
 	app "github.com/e-money/bep3/testapp"
 	tmtime "github.com/tendermint/tendermint/types/time"
@@ -42,7 +43,7 @@ func getBep3Coins() ([]string, sdk.Coins) {
 	// bep3 genesis for supported coins
 	bep3Denoms := []string{"bnb", "inc", "echf", "edkk", "eeur", "enok", "esek", "ungm"}
 	coins := make(sdk.Coins, len(bep3Denoms))
-	amount := sdk.NewInt(int64(bep3.MaxSupplyLimit))
+	amount := sdk.NewInt(int64(simulation.MaxSupplyLimit))
 
 	for idx, denom := range bep3Denoms {
 		coins[idx] = sdk.NewCoin(denom, amount)
@@ -91,6 +92,7 @@ func baseGenState(deputy sdk.AccAddress) bep3.GenesisState {
 					SwapTimestamp:   bep3.DefaultSwapBlockTimestamp,
 				},
 			},
+			LongtermStorageRetention: bep3.DefaultLongtermStorageRetention,
 		},
 		Supplies: bep3.AssetSupplies{
 			AssetSupplies: []types.AssetSupply{
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/e-money/bep3/module/types"
@@ -183,3 +185,53 @@ func (k Keeper) UpdateTimeBasedSupplyLimits(ctx sdk.Context) {
 	}
 	k.SetPreviousBlockTime(ctx, ctx.BlockTime())
 }
+
+// GetAssetCapacity returns how much of an asset can still be swapped in and out under its supply limits, and when
+// the time-based supply limit's window resets.
+func (k Keeper) GetAssetCapacity(ctx sdk.Context, denom string) (types.AssetCapacity, error) {
+	asset, err := k.GetAsset(ctx, denom)
+	if err != nil {
+		return types.AssetCapacity{}, err
+	}
+	supply, found := k.GetAssetSupply(ctx, denom)
+	if !found {
+		return types.AssetCapacity{}, sdkerrors.Wrap(types.ErrAssetNotSupported, denom)
+	}
+
+	// Mirrors the limits checked by IncrementIncomingAssetSupply and IncrementOutgoingAssetSupply
+	limit := asset.SupplyLimit
+	absolute := remainingCapacity(limit.Limit, supply.CurrentSupply.Amount.Add(supply.IncomingSupply.Amount))
+	capacity := types.AssetCapacity{
+		Denom:                     denom,
+		IncomingCapacity:          sdk.NewCoin(denom, absolute),
+		AbsoluteIncomingCapacity:  sdk.NewCoin(denom, absolute),
+		TimeLimited:               limit.TimeLimited,
+		TimeBasedIncomingCapacity: sdk.NewCoin(denom, sdk.ZeroInt()),
+		OutgoingCapacity:          sdk.NewCoin(denom, remainingCapacity(supply.CurrentSupply.Amount, supply.OutgoingSupply.Amount)),
+	}
+
+	if limit.TimeLimited {
+		timeBased := remainingCapacity(limit.TimeBasedLimit, supply.TimeLimitedCurrentSupply.Amount.Add(supply.IncomingSupply.Amount))
+		capacity.TimeBasedIncomingCapacity = sdk.NewCoin(denom, timeBased)
+		capacity.IncomingCapacity = sdk.NewCoin(denom, sdk.MinInt(absolute, timeBased))
+
+		// The window resets in the first block after its period has elapsed since the time it was last updated
+		previousBlockTime, found := k.GetPreviousBlockTime(ctx)
+		if !found {
+			previousBlockTime = ctx.BlockTime()
+		}
+		capacity.WindowResetTime = previousBlockTime.Add(time.Duration(limit.TimePeriod - supply.TimeElapsed))
+		if untilReset := capacity.WindowResetTime.Sub(ctx.BlockTime()); untilReset > 0 {
+			capacity.TimeUntilWindowReset = int64(untilReset)
+		}
+	}
+	return capacity, nil
+}
+
+// remainingCapacity returns how far used is below limit, or zero if it is not.
+func remainingCapacity(limit, used sdk.Int) sdk.Int {
+	if used.GTE(limit) {
+		return sdk.ZeroInt()
+	}
+	return limit.Sub(used)
+}
@@ -672,6 +672,7 @@ func (suite *AssetTestSuite) TestUpdateTimeBasedSupplyLimits() {
 						SwapTimestamp: bep3.DefaultSwapBlockTimestamp,
 					},
 				},
+				LongtermStorageRetention: types.DefaultLongtermStorageRetention,
 			}
 			suite.keeper.SetParams(suite.ctx, newParams)
 			suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(tc.args.duration))
@@ -692,3 +693,33 @@ func (suite *AssetTestSuite) TestUpdateTimeBasedSupplyLimits() {
 func TestAssetTestSuite(t *testing.T) {
 	suite.Run(t, new(AssetTestSuite))
 }
+
+func (suite *AssetTestSuite) TestGetAssetCapacity() {
+	capacity, err := suite.keeper.GetAssetCapacity(suite.ctx, "bnb")
+	suite.Require().NoError(err)
+	suite.Equal(c("bnb", 5), capacity.IncomingCapacity)
+	suite.Equal(c("bnb", 5), capacity.AbsoluteIncomingCapacity)
+	suite.Equal(c("bnb", 35), capacity.OutgoingCapacity)
+	suite.False(capacity.TimeLimited)
+	suite.Zero(capacity.TimeUntilWindowReset)
+
+	// The time-based limit is the tighter one
+	capacity, err = suite.keeper.GetAssetCapacity(suite.ctx, "inc")
+	suite.Require().NoError(err)
+	suite.Equal(c("inc", 5), capacity.IncomingCapacity)
+	suite.Equal(c("inc", 85), capacity.AbsoluteIncomingCapacity)
+	suite.Equal(c("inc", 5), capacity.TimeBasedIncomingCapacity)
+	suite.Equal(c("inc", 0), capacity.OutgoingCapacity)
+	suite.True(capacity.TimeLimited)
+	suite.True(suite.ctx.BlockTime().Add(time.Hour).Equal(capacity.WindowResetTime))
+	suite.Equal(int64(time.Hour), capacity.TimeUntilWindowReset)
+
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(20 * time.Minute))
+	bep3.BeginBlocker(ctx, suite.keeper)
+	capacity, err = suite.keeper.GetAssetCapacity(ctx, "inc")
+	suite.Require().NoError(err)
+	suite.Equal(int64(40*time.Minute), capacity.TimeUntilWindowReset)
+
+	_, err = suite.keeper.GetAssetCapacity(suite.ctx, "xyz")
+	suite.Require().ErrorIs(err, types.ErrAssetNotSupported)
+}
@@ -0,0 +1,315 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/e-money/bep3/module/types"
+)
+
+// ------------------------------------------
+//				Deputy Bonds
+// ------------------------------------------
+
+// GetDeputyBond returns the collateral bonded by a deputy.
+func (k Keeper) GetDeputyBond(ctx sdk.Context, deputy string) (types.DeputyBond, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DeputyBondPrefix)
+	bz := store.Get([]byte(deputy))
+	if bz == nil {
+		return types.DeputyBond{}, false
+	}
+	var bond types.DeputyBond
+	k.cdc.MustUnmarshalBinaryBare(bz, &bond)
+	return bond, true
+}
+
+// SetDeputyBond stores the collateral bonded by a deputy. An empty bond is removed from the store.
+func (k Keeper) SetDeputyBond(ctx sdk.Context, bond types.DeputyBond) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DeputyBondPrefix)
+	if bond.Amount.Empty() {
+		store.Delete([]byte(bond.DeputyAddress))
+		return
+	}
+	store.Set([]byte(bond.DeputyAddress), k.cdc.MustMarshalBinaryBare(&bond))
+}
+
+// IterateDeputyBonds provides an iterator over all deputy bonds.
+func (k Keeper) IterateDeputyBonds(ctx sdk.Context, cb func(bond types.DeputyBond) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.DeputyBondPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var bond types.DeputyBond
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &bond)
+
+		if cb(bond) {
+			break
+		}
+	}
+}
+
+// GetAllDeputyBonds returns all deputy bonds.
+func (k Keeper) GetAllDeputyBonds(ctx sdk.Context) (bonds []types.DeputyBond) {
+	k.IterateDeputyBonds(ctx, func(bond types.DeputyBond) bool {
+		bonds = append(bonds, bond)
+		return false
+	})
+	return
+}
+
+// ------------------------------------------
+//				Deputy Unbonding Queue
+// ------------------------------------------
+
+// GetDeputyUnbonding returns the collateral of a deputy that completes unbonding at the given time.
+func (k Keeper) GetDeputyUnbonding(ctx sdk.Context, deputy string, completionTime time.Time) (types.DeputyUnbonding, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DeputyUnbondingQueuePrefix)
+	bz := store.Get(types.GetDeputyUnbondingKey(completionTime, deputy))
+	if bz == nil {
+		return types.DeputyUnbonding{}, false
+	}
+	var unbonding types.DeputyUnbonding
+	k.cdc.MustUnmarshalBinaryBare(bz, &unbonding)
+	return unbonding, true
+}
+
+// SetDeputyUnbonding inserts an unbonding into the queue. An empty unbonding is removed from the queue.
+func (k Keeper) SetDeputyUnbonding(ctx sdk.Context, unbonding types.DeputyUnbonding) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DeputyUnbondingQueuePrefix)
+	key := types.GetDeputyUnbondingKey(unbonding.CompletionTime, unbonding.DeputyAddress)
+	if unbonding.Amount.Empty() {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshalBinaryBare(&unbonding))
+}
+
+// IterateDeputyUnbondings provides an iterator over the unbonding queue, ordered by completion time.
+func (k Keeper) IterateDeputyUnbondings(ctx sdk.Context, cb func(unbonding types.DeputyUnbonding) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.DeputyUnbondingQueuePrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var unbonding types.DeputyUnbonding
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &unbonding)
+
+		if cb(unbonding) {
+			break
+		}
+	}
+}
+
+// GetAllDeputyUnbondings returns the unbonding queue, ordered by completion time.
+func (k Keeper) GetAllDeputyUnbondings(ctx sdk.Context) (unbondings []types.DeputyUnbonding) {
+	k.IterateDeputyUnbondings(ctx, func(unbonding types.DeputyUnbonding) bool {
+		unbondings = append(unbondings, unbonding)
+		return false
+	})
+	return
+}
+
+// GetDeputyUnbondings returns the pending unbondings of a deputy, ordered by completion time.
+func (k Keeper) GetDeputyUnbondings(ctx sdk.Context, deputy string) (unbondings []types.DeputyUnbonding) {
+	k.IterateDeputyUnbondings(ctx, func(unbonding types.DeputyUnbonding) bool {
+		if unbonding.DeputyAddress == deputy {
+			unbondings = append(unbondings, unbonding)
+		}
+		return false
+	})
+	return
+}
+
+// ------------------------------------------
+//				Bonding
+// ------------------------------------------
+
+// DeputyBondState moves collateral from an account into the module account and adds it to the account's bond.
+func (k Keeper) DeputyBondState(ctx sdk.Context, from sdk.AccAddress, amount sdk.Coins) error {
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	bond, found := k.GetDeputyBond(ctx, from.String())
+	if !found {
+		bond = types.NewDeputyBond(from.String(), sdk.NewCoins())
+	}
+	bond.Amount = bond.Amount.Add(amount...)
+	k.SetDeputyBond(ctx, bond)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeputyBond,
+			sdk.NewAttribute(types.AttributeKeyDeputy, from.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+	return nil
+}
+
+// DeputyUnbondState removes collateral from a deputy's bond and queues it to be returned once the unbonding
+// period has passed. The collateral can still be slashed while it is unbonding.
+func (k Keeper) DeputyUnbondState(ctx sdk.Context, from sdk.AccAddress, amount sdk.Coins) (time.Time, error) {
+	bond, found := k.GetDeputyBond(ctx, from.String())
+	if !found || !bond.Amount.IsAllGTE(amount) {
+		return time.Time{}, sdkerrors.Wrapf(types.ErrInsufficientDeputyBond, "cannot unbond %s from bond %s", amount, bond.Amount)
+	}
+	bond.Amount = bond.Amount.Sub(amount)
+	k.SetDeputyBond(ctx, bond)
+
+	completionTime := ctx.BlockTime().Add(k.deputyUnbondingPeriod(ctx))
+	unbonding, found := k.GetDeputyUnbonding(ctx, from.String(), completionTime)
+	if !found {
+		unbonding = types.NewDeputyUnbonding(from.String(), sdk.NewCoins(), completionTime)
+	}
+	unbonding.Amount = unbonding.Amount.Add(amount...)
+	k.SetDeputyUnbonding(ctx, unbonding)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeputyUnbond,
+			sdk.NewAttribute(types.AttributeKeyDeputy, from.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	)
+	return completionTime, nil
+}
+
+// deputyUnbondingPeriod returns the module's unbonding period, the longest unbonding period of all assets. It
+// applies to every bonder, so that collateral bonded ahead of becoming an asset's deputy remains slashable.
+func (k Keeper) deputyUnbondingPeriod(ctx sdk.Context) time.Duration {
+	var period int64
+	assets, _ := k.GetAssets(ctx)
+	for _, asset := range assets {
+		if asset.DeputyUnbondingPeriod > period {
+			period = asset.DeputyUnbondingPeriod
+		}
+	}
+	return time.Duration(period) * time.Second
+}
+
+// CompleteMatureDeputyUnbondings returns the collateral of all unbondings that have completed by the block time.
+func (k Keeper) CompleteMatureDeputyUnbondings(ctx sdk.Context) {
+	var mature []types.DeputyUnbonding
+	k.IterateDeputyUnbondings(ctx, func(unbonding types.DeputyUnbonding) bool {
+		if unbonding.CompletionTime.After(ctx.BlockTime()) {
+			return true
+		}
+		mature = append(mature, unbonding)
+		return false
+	})
+
+	for _, unbonding := range mature {
+		deputy, err := sdk.AccAddressFromBech32(unbonding.DeputyAddress)
+		if err != nil {
+			panic(err)
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, deputy, unbonding.Amount); err != nil {
+			panic(err)
+		}
+		k.SetDeputyUnbonding(ctx, types.NewDeputyUnbonding(unbonding.DeputyAddress, sdk.NewCoins(), unbonding.CompletionTime))
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompleteUnbond,
+				sdk.NewAttribute(types.AttributeKeyDeputy, unbonding.DeputyAddress),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, unbonding.Amount.String()),
+			),
+		)
+	}
+}
+
+// ValidateDeputyBond checks that the deputy of an asset has bonded the collateral the asset requires.
+func (k Keeper) ValidateDeputyBond(ctx sdk.Context, asset types.AssetParam) error {
+	if asset.DeputyBond.Empty() {
+		return nil
+	}
+	bond, _ := k.GetDeputyBond(ctx, asset.DeputyAddress)
+	if !bond.Amount.IsAllGTE(asset.DeputyBond) {
+		return sdkerrors.Wrapf(types.ErrInsufficientDeputyBond, "%s requires deputy %s to bond %s, bonded %s",
+			asset.Denom, asset.DeputyAddress, asset.DeputyBond, bond.Amount)
+	}
+	return nil
+}
+
+// ------------------------------------------
+//				Slashing
+// ------------------------------------------
+
+// SlashDeputy burns the asset's deputy slash amount from the collateral of the deputy of a cross-chain swap the
+// deputy failed to honor. The bond is slashed before collateral that is unbonding. A swap can only be slashed
+// once.
+func (k Keeper) SlashDeputy(ctx sdk.Context, swap types.AtomicSwap, reason string) (sdk.Coins, error) {
+	if swap.Direction != types.Incoming && swap.Direction != types.Outgoing {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidDeputySlash, "swap %s is not cross-chain", hex.EncodeToString(swap.GetSwapID()))
+	}
+	if swap.IBCPacket != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidDeputySlash, "swap %s is settled over IBC", hex.EncodeToString(swap.GetSwapID()))
+	}
+	if swap.DeputySlashed {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidDeputySlash, "deputy of swap %s has already been slashed", hex.EncodeToString(swap.GetSwapID()))
+	}
+	asset, err := k.GetAsset(ctx, swap.Amount[0].Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// The deputy of a swap is its counterparty on this chain, even if the asset's deputy has changed since
+	deputy := swap.Recipient
+	if swap.Direction == types.Incoming {
+		deputy = swap.Sender
+	}
+	remaining := asset.DeputySlashAmount
+	slashed := sdk.NewCoins()
+
+	bond, found := k.GetDeputyBond(ctx, deputy)
+	if found {
+		amount := minCoins(remaining, bond.Amount)
+		bond.Amount = bond.Amount.Sub(amount)
+		k.SetDeputyBond(ctx, bond)
+		remaining = remaining.Sub(amount)
+		slashed = slashed.Add(amount...)
+	}
+	for _, unbonding := range k.GetDeputyUnbondings(ctx, deputy) {
+		if remaining.Empty() {
+			break
+		}
+		amount := minCoins(remaining, unbonding.Amount)
+		unbonding.Amount = unbonding.Amount.Sub(amount)
+		k.SetDeputyUnbonding(ctx, unbonding)
+		remaining = remaining.Sub(amount)
+		slashed = slashed.Add(amount...)
+	}
+
+	if !slashed.Empty() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, slashed); err != nil {
+			return nil, err
+		}
+	}
+
+	swap.DeputySlashed = true
+	k.SetAtomicSwap(ctx, swap)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashDeputy,
+			sdk.NewAttribute(types.AttributeKeyDeputy, deputy),
+			sdk.NewAttribute(types.AttributeKeyAtomicSwapID, hex.EncodeToString(swap.GetSwapID())),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, slashed.String()),
+			sdk.NewAttribute(types.AttributeKeySlashReason, reason),
+		),
+	)
+	return slashed, nil
+}
+
+// minCoins returns the coins of a capped at the amounts of b.
+func minCoins(a, b sdk.Coins) sdk.Coins {
+	min := sdk.NewCoins()
+	for _, coin := range a {
+		min = min.Add(sdk.NewCoin(coin.Denom, sdk.MinInt(coin.Amount, b.AmountOf(coin.Denom))))
+	}
+	return min
+}
@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bep3 "github.com/e-money/bep3/module"
+	"github.com/e-money/bep3/module/keeper"
+	"github.com/e-money/bep3/module/types"
+)
+
+func (suite *AtomicSwapTestSuite) TestDeputyBondAndSlash() {
+	const bondDenom = "ungm"
+	collateral := cs(c(bondDenom, 10000))
+	suite.Require().NoError(suite.bankKeeper.MintCoins(suite.ctx, types.ModuleName, collateral))
+	suite.Require().NoError(suite.bankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, suite.deputy, collateral))
+
+	asset, err := suite.keeper.GetAsset(suite.ctx, BNB_DENOM)
+	suite.Require().NoError(err)
+	asset.DeputyBond = cs(c(bondDenom, 1000))
+	asset.DeputyUnbondingPeriod = 600
+	asset.DeputySlashAmount = cs(c(bondDenom, 400))
+	asset.SlashOnRefund = true
+	suite.keeper.SetAsset(suite.ctx, asset)
+
+	amount := cs(c(BNB_DENOM, 50000))
+	createIncoming := func(ctx sdk.Context, i int) error {
+		_, err := suite.keeper.CreateAtomicSwapState(ctx, suite.deputy, suite.addrs[1], types.MsgCreateAtomicSwap{
+			From:                suite.deputy.String(),
+			To:                  suite.addrs[1].String(),
+			RecipientOtherChain: TestRecipientOtherChain,
+			SenderOtherChain:    TestSenderOtherChain,
+			RandomNumberHash:    suite.randomNumberHashes[i],
+			Timestamp:           suite.timestamps[i],
+			Amount:              amount,
+			TimeSpanMin:         types.DefaultSwapTimeSpanMinutes,
+		})
+		return err
+	}
+	createOutgoing := func(ctx sdk.Context, i int) []byte {
+		suite.Require().NoError(suite.bankKeeper.MintCoins(ctx, types.ModuleName, amount))
+		suite.Require().NoError(suite.keeper.IncrementCurrentAssetSupply(ctx, amount[0]))
+		_, err := suite.keeper.CreateAtomicSwapState(ctx, suite.addrs[1], suite.deputy, types.MsgCreateAtomicSwap{
+			From:                suite.addrs[1].String(),
+			To:                  suite.deputy.String(),
+			RecipientOtherChain: TestRecipientOtherChain,
+			SenderOtherChain:    TestSenderOtherChain,
+			RandomNumberHash:    suite.randomNumberHashes[i],
+			Timestamp:           suite.timestamps[i],
+			Amount:              amount,
+			TimeSpanMin:         types.DefaultSwapTimeSpanMinutes,
+		})
+		suite.Require().NoError(err)
+		return types.CalculateSwapID(suite.randomNumberHashes[i], suite.addrs[1], TestSenderOtherChain)
+	}
+
+	// Swaps cannot be created until the deputy has bonded
+	suite.Require().ErrorIs(createIncoming(suite.ctx, 0), types.ErrInsufficientDeputyBond)
+	suite.Require().NoError(suite.keeper.DeputyBondState(suite.ctx, suite.deputy, cs(c(bondDenom, 1000))))
+	suite.Require().NoError(createIncoming(suite.ctx, 0))
+
+	// Unbonding below the requirement blocks swaps again
+	completionTime, err := suite.keeper.DeputyUnbondState(suite.ctx, suite.deputy, cs(c(bondDenom, 300)))
+	suite.Require().NoError(err)
+	suite.Equal(suite.ctx.BlockTime().Add(10*time.Minute), completionTime)
+	suite.Require().ErrorIs(createIncoming(suite.ctx, 1), types.ErrInsufficientDeputyBond)
+	_, err = suite.keeper.DeputyUnbondState(suite.ctx, suite.deputy, cs(c(bondDenom, 800)))
+	suite.Require().ErrorIs(err, types.ErrInsufficientDeputyBond)
+	suite.Require().NoError(suite.keeper.DeputyBondState(suite.ctx, suite.deputy, cs(c(bondDenom, 300))))
+	suite.Equal(int64(8700), suite.bankKeeper.GetBalance(suite.ctx, suite.deputy, bondDenom).Amount.Int64())
+
+	// Unbonded collateral is returned after the unbonding period
+	ctx := suite.ctx.WithBlockTime(completionTime)
+	bep3.BeginBlocker(ctx, suite.keeper)
+	suite.Empty(suite.keeper.GetAllDeputyUnbondings(ctx))
+	suite.Equal(int64(9000), suite.bankKeeper.GetBalance(ctx, suite.deputy, bondDenom).Amount.Int64())
+
+	suite.Require().NoError(createIncoming(ctx, 2))
+	relayedID := types.CalculateSwapID(suite.randomNumberHashes[2], suite.deputy, TestSenderOtherChain)
+	provenID := createOutgoing(ctx, 3)
+	unclaimedID := createOutgoing(ctx, 5)
+	_, err = suite.keeper.DeputyUnbondState(ctx, suite.deputy, cs(c(bondDenom, 300)))
+	suite.Require().NoError(err)
+
+	// Addresses that are not the deputy of an asset unbond with the module's unbonding period as well
+	suite.Require().NoError(suite.bankKeeper.MintCoins(ctx, types.ModuleName, cs(c(bondDenom, 100))))
+	suite.Require().NoError(suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, suite.addrs[2], cs(c(bondDenom, 100))))
+	suite.Require().NoError(suite.keeper.DeputyBondState(ctx, suite.addrs[2], cs(c(bondDenom, 100))))
+	completionTime, err = suite.keeper.DeputyUnbondState(ctx, suite.addrs[2], cs(c(bondDenom, 100)))
+	suite.Require().NoError(err)
+	suite.Equal(ctx.BlockTime().Add(10*time.Minute), completionTime)
+
+	// Refunding an expired incoming swap does not slash, it expired because its recipient did not claim it
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(6 * time.Minute)).WithEventManager(sdk.NewEventManager())
+	bep3.BeginBlocker(ctx, suite.keeper)
+	_, err = suite.keeper.RefundAtomicSwapState(ctx, suite.addrs[1], relayedID)
+	suite.Require().NoError(err)
+	suite.NotContains(eventTypes(ctx), types.EventTypeSlashDeputy)
+	swap, _ := suite.keeper.GetAtomicSwap(ctx, relayedID)
+	suite.False(swap.DeputySlashed)
+
+	// Refunding an expired outgoing swap the deputy did not honor slashes the bond
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = suite.keeper.RefundAtomicSwapState(ctx, suite.addrs[1], unclaimedID)
+	suite.Require().NoError(err)
+	suite.Contains(eventTypes(ctx), types.EventTypeSlashDeputy)
+	bond, _ := suite.keeper.GetDeputyBond(ctx, suite.deputy.String())
+	suite.Equal(cs(c(bondDenom, 300)), bond.Amount)
+	swap, _ = suite.keeper.GetAtomicSwap(ctx, unclaimedID)
+	suite.True(swap.DeputySlashed)
+
+	// Slashing by governance takes the rest of the bond, then the unbonding collateral
+	asset.DeputySlashAmount = cs(c(bondDenom, 1000))
+	suite.keeper.SetAsset(ctx, asset)
+	proposal := types.NewDeputySlashProposal("slash", "deputy never released the funds", provenID)
+	suite.Require().NoError(keeper.HandleDeputySlashProposal(ctx, suite.keeper, proposal))
+	_, found := suite.keeper.GetDeputyBond(ctx, suite.deputy.String())
+	suite.False(found)
+	suite.Empty(suite.keeper.GetDeputyUnbondings(ctx, suite.deputy.String()))
+	suite.True(suite.bankKeeper.GetSupply(ctx).GetTotal().AmountOf(bondDenom).Equal(sdk.NewInt(9100)))
+	suite.Equal(cs(c(bondDenom, 100)), suite.keeper.GetDeputyUnbondings(ctx, suite.addrs[2].String())[0].Amount)
+
+	// A swap is slashed only once
+	suite.Require().ErrorIs(keeper.HandleDeputySlashProposal(ctx, suite.keeper, proposal), types.ErrInvalidDeputySlash)
+	_, err = suite.keeper.RefundAtomicSwapState(ctx, suite.addrs[1], provenID)
+	suite.Require().NoError(err)
+
+	unknown := types.NewDeputySlashProposal("slash", "unknown swap", suite.randomNumberHashes[4])
+	suite.Require().ErrorIs(keeper.HandleDeputySlashProposal(ctx, suite.keeper, unknown), types.ErrAtomicSwapNotFound)
+}
@@ -0,0 +1,213 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/e-money/bep3/module/types"
+)
+
+// ------------------------------------------
+//				Deputy Activity
+// ------------------------------------------
+
+// GetDeputyActivity returns the last activity of a deputy.
+func (k Keeper) GetDeputyActivity(ctx sdk.Context, deputy string) (types.DeputyActivity, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DeputyActivityPrefix)
+	bz := store.Get([]byte(deputy))
+	if bz == nil {
+		return types.DeputyActivity{}, false
+	}
+	var activity types.DeputyActivity
+	k.cdc.MustUnmarshalBinaryBare(bz, &activity)
+	return activity, true
+}
+
+// SetDeputyActivity stores the last activity of a deputy.
+func (k Keeper) SetDeputyActivity(ctx sdk.Context, activity types.DeputyActivity) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DeputyActivityPrefix)
+	store.Set([]byte(activity.DeputyAddress), k.cdc.MustMarshalBinaryBare(&activity))
+}
+
+// IterateDeputyActivity provides an iterator over the last activity of all deputies.
+func (k Keeper) IterateDeputyActivity(ctx sdk.Context, cb func(activity types.DeputyActivity) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.DeputyActivityPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var activity types.DeputyActivity
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &activity)
+
+		if cb(activity) {
+			break
+		}
+	}
+}
+
+// GetAllDeputyActivity returns the last activity of all deputies.
+func (k Keeper) GetAllDeputyActivity(ctx sdk.Context) (activities []types.DeputyActivity) {
+	k.IterateDeputyActivity(ctx, func(activity types.DeputyActivity) bool {
+		activities = append(activities, activity)
+		return false
+	})
+	return
+}
+
+// ------------------------------------------
+//				Asset Suspensions
+// ------------------------------------------
+
+// GetAssetSuspension returns the suspension of an asset's new outgoing swaps, if any.
+func (k Keeper) GetAssetSuspension(ctx sdk.Context, denom string) (types.AssetSuspension, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AssetSuspensionPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return types.AssetSuspension{}, false
+	}
+	var suspension types.AssetSuspension
+	k.cdc.MustUnmarshalBinaryBare(bz, &suspension)
+	return suspension, true
+}
+
+// SetAssetSuspension suspends new outgoing swaps of an asset.
+func (k Keeper) SetAssetSuspension(ctx sdk.Context, suspension types.AssetSuspension) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AssetSuspensionPrefix)
+	store.Set([]byte(suspension.Denom), k.cdc.MustMarshalBinaryBare(&suspension))
+}
+
+// RemoveAssetSuspension lifts the suspension of an asset's new outgoing swaps.
+func (k Keeper) RemoveAssetSuspension(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AssetSuspensionPrefix)
+	store.Delete([]byte(denom))
+}
+
+// IterateAssetSuspensions provides an iterator over all suspended assets.
+func (k Keeper) IterateAssetSuspensions(ctx sdk.Context, cb func(suspension types.AssetSuspension) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.AssetSuspensionPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var suspension types.AssetSuspension
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &suspension)
+
+		if cb(suspension) {
+			break
+		}
+	}
+}
+
+// GetAllAssetSuspensions returns all suspended assets.
+func (k Keeper) GetAllAssetSuspensions(ctx sdk.Context) (suspensions []types.AssetSuspension) {
+	k.IterateAssetSuspensions(ctx, func(suspension types.AssetSuspension) bool {
+		suspensions = append(suspensions, suspension)
+		return false
+	})
+	return
+}
+
+// ValidateDeputyActive checks that new outgoing swaps of an asset are not suspended.
+func (k Keeper) ValidateDeputyActive(ctx sdk.Context, asset types.AssetParam) error {
+	if suspension, found := k.GetAssetSuspension(ctx, asset.Denom); found {
+		return sdkerrors.Wrapf(types.ErrDeputyInactive, "%s suspended at height %d, deputy %s", asset.Denom,
+			suspension.Height, asset.DeputyAddress)
+	}
+	return nil
+}
+
+// ------------------------------------------
+//				Liveness
+// ------------------------------------------
+
+// recordDeputyActivity marks the deputy as active in the current block and resumes the assets that were
+// suspended while it was inactive.
+func (k Keeper) recordDeputyActivity(ctx sdk.Context, deputy string) {
+	k.SetDeputyActivity(ctx, types.NewDeputyActivity(deputy, ctx.BlockHeight(), ctx.BlockTime()))
+
+	assets, _ := k.GetAssets(ctx)
+	for _, asset := range assets {
+		if asset.DeputyAddress != deputy {
+			continue
+		}
+		k.resumeAsset(ctx, asset)
+	}
+}
+
+// UpdateDeputyLiveness suspends new outgoing swaps of assets whose deputy has not acted for more than the
+// asset's max deputy inactivity, and resumes assets that no longer exceed it, e.g. after a param change.
+func (k Keeper) UpdateDeputyLiveness(ctx sdk.Context) {
+	assets, _ := k.GetAssets(ctx)
+	for _, asset := range assets {
+		if asset.MaxDeputyInactivity == 0 {
+			k.resumeAsset(ctx, asset)
+			continue
+		}
+
+		activity, found := k.GetDeputyActivity(ctx, asset.DeputyAddress)
+		if !found {
+			// Deputies are given a full inactivity period from the block their liveness is first tracked in
+			activity = types.NewDeputyActivity(asset.DeputyAddress, ctx.BlockHeight(), ctx.BlockTime())
+			k.SetDeputyActivity(ctx, activity)
+		}
+
+		if ctx.BlockHeight()-activity.LastActiveHeight <= asset.MaxDeputyInactivity {
+			k.resumeAsset(ctx, asset)
+			continue
+		}
+		if _, suspended := k.GetAssetSuspension(ctx, asset.Denom); suspended {
+			continue
+		}
+
+		k.SetAssetSuspension(ctx, types.NewAssetSuspension(asset.Denom, ctx.BlockHeight()))
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDeputyInactive,
+				sdk.NewAttribute(types.AttributeKeyDenom, asset.Denom),
+				sdk.NewAttribute(types.AttributeKeyDeputy, asset.DeputyAddress),
+				sdk.NewAttribute(types.AttributeKeyLastActiveHeight, fmt.Sprintf("%d", activity.LastActiveHeight)),
+			),
+		)
+	}
+
+	// Assets removed by governance cannot be resumed by their deputy
+	for _, suspension := range k.GetAllAssetSuspensions(ctx) {
+		if _, err := k.GetAsset(ctx, suspension.Denom); err != nil {
+			k.RemoveAssetSuspension(ctx, suspension.Denom)
+		}
+	}
+}
+
+// resumeAsset lifts the suspension of an asset, if it is suspended.
+func (k Keeper) resumeAsset(ctx sdk.Context, asset types.AssetParam) {
+	if _, found := k.GetAssetSuspension(ctx, asset.Denom); !found {
+		return
+	}
+	k.RemoveAssetSuspension(ctx, asset.Denom)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeputyActive,
+			sdk.NewAttribute(types.AttributeKeyDenom, asset.Denom),
+			sdk.NewAttribute(types.AttributeKeyDeputy, asset.DeputyAddress),
+		),
+	)
+}
+
+// GetDeputyHealth returns the liveness of an asset's deputy.
+func (k Keeper) GetDeputyHealth(ctx sdk.Context, asset types.AssetParam) types.DeputyHealth {
+	health := types.DeputyHealth{
+		Denom:               asset.Denom,
+		DeputyAddress:       asset.DeputyAddress,
+		MaxDeputyInactivity: asset.MaxDeputyInactivity,
+	}
+	if activity, found := k.GetDeputyActivity(ctx, asset.DeputyAddress); found {
+		health.LastActiveHeight = activity.LastActiveHeight
+		health.LastActiveTime = activity.LastActiveTime
+		health.InactiveBlocks = ctx.BlockHeight() - activity.LastActiveHeight
+	}
+	if suspension, found := k.GetAssetSuspension(ctx, asset.Denom); found {
+		health.Suspended = true
+		health.SuspendedHeight = suspension.Height
+	}
+	return health
+}
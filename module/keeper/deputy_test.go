@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bep3 "github.com/e-money/bep3/module"
+	"github.com/e-money/bep3/module/types"
+)
+
+func (suite *AtomicSwapTestSuite) TestDeputyLiveness() {
+	asset, err := suite.keeper.GetAsset(suite.ctx, BNB_DENOM)
+	suite.Require().NoError(err)
+	asset.MaxDeputyInactivity = 10
+	suite.keeper.SetAsset(suite.ctx, asset)
+
+	// Liveness is tracked from the first block the param is set in
+	ctx := suite.ctx.WithBlockHeight(100)
+	bep3.BeginBlocker(ctx, suite.keeper)
+	health := suite.keeper.GetDeputyHealth(ctx, asset)
+	suite.Equal(int64(100), health.LastActiveHeight)
+	suite.False(health.Suspended)
+
+	ctx = suite.ctx.WithBlockHeight(110)
+	bep3.BeginBlocker(ctx, suite.keeper)
+	suite.False(suite.keeper.GetDeputyHealth(ctx, asset).Suspended)
+
+	// The deputy has not acted for more than 10 blocks
+	ctx = suite.ctx.WithBlockHeight(111).WithEventManager(sdk.NewEventManager())
+	bep3.BeginBlocker(ctx, suite.keeper)
+	health = suite.keeper.GetDeputyHealth(ctx, asset)
+	suite.True(health.Suspended)
+	suite.Equal(int64(111), health.SuspendedHeight)
+	suite.Equal(int64(11), health.InactiveBlocks)
+	suite.Contains(eventTypes(ctx), types.EventTypeDeputyInactive)
+
+	amount := cs(c(BNB_DENOM, 50000))
+	_, err = suite.keeper.CreateAtomicSwapState(ctx, suite.addrs[1], suite.deputy, types.MsgCreateAtomicSwap{
+		From:                suite.addrs[1].String(),
+		To:                  suite.deputy.String(),
+		RecipientOtherChain: TestRecipientOtherChain,
+		SenderOtherChain:    TestSenderOtherChain,
+		RandomNumberHash:    suite.randomNumberHashes[0],
+		Timestamp:           suite.timestamps[0],
+		Amount:              amount,
+		TimeSpanMin:         types.DefaultSwapTimeSpanMinutes,
+	})
+	suite.Require().ErrorIs(err, types.ErrDeputyInactive)
+
+	// An incoming swap created by the deputy resumes outgoing swaps
+	ctx = suite.ctx.WithBlockHeight(112).WithEventManager(sdk.NewEventManager())
+	_, err = suite.keeper.CreateAtomicSwapState(ctx, suite.deputy, suite.addrs[1], types.MsgCreateAtomicSwap{
+		From:                suite.deputy.String(),
+		To:                  suite.addrs[1].String(),
+		RecipientOtherChain: TestRecipientOtherChain,
+		SenderOtherChain:    TestSenderOtherChain,
+		RandomNumberHash:    suite.randomNumberHashes[1],
+		Timestamp:           suite.timestamps[1],
+		Amount:              amount,
+		TimeSpanMin:         types.DefaultSwapTimeSpanMinutes,
+	})
+	suite.Require().NoError(err)
+	health = suite.keeper.GetDeputyHealth(ctx, asset)
+	suite.False(health.Suspended)
+	suite.Equal(int64(112), health.LastActiveHeight)
+	suite.Contains(eventTypes(ctx), types.EventTypeDeputyActive)
+
+	suite.Require().NoError(suite.bankKeeper.MintCoins(ctx, types.ModuleName, amount))
+	suite.Require().NoError(suite.keeper.IncrementCurrentAssetSupply(ctx, amount[0]))
+	_, err = suite.keeper.CreateAtomicSwapState(ctx, suite.addrs[1], suite.deputy, types.MsgCreateAtomicSwap{
+		From:                suite.addrs[1].String(),
+		To:                  suite.deputy.String(),
+		RecipientOtherChain: TestRecipientOtherChain,
+		SenderOtherChain:    TestSenderOtherChain,
+		RandomNumberHash:    suite.randomNumberHashes[0],
+		Timestamp:           suite.timestamps[0],
+		Amount:              amount,
+		TimeSpanMin:         types.DefaultSwapTimeSpanMinutes,
+	})
+	suite.Require().NoError(err)
+
+	// Claiming the outgoing swap as the deputy counts as activity
+	ctx = suite.ctx.WithBlockHeight(120)
+	outgoingID := types.CalculateSwapID(suite.randomNumberHashes[0], suite.addrs[1], TestSenderOtherChain)
+	_, err = suite.keeper.ClaimAtomicSwapState(ctx, suite.deputy, outgoingID, suite.randomNumbers[0], nil)
+	suite.Require().NoError(err)
+	suite.Equal(int64(120), suite.keeper.GetDeputyHealth(ctx, asset).LastActiveHeight)
+
+	// Disabling the check lifts a suspension
+	bep3.BeginBlocker(suite.ctx.WithBlockHeight(131), suite.keeper)
+	suite.True(suite.keeper.GetDeputyHealth(ctx, asset).Suspended)
+	asset.MaxDeputyInactivity = 0
+	suite.keeper.SetAsset(ctx, asset)
+	bep3.BeginBlocker(suite.ctx.WithBlockHeight(132), suite.keeper)
+	suite.False(suite.keeper.GetDeputyHealth(ctx, asset).Suspended)
+}
+
+func eventTypes(ctx sdk.Context) []string {
+	var eventTypes []string
+	for _, event := range ctx.EventManager().Events() {
+		eventTypes = append(eventTypes, event.Type)
+	}
+	return eventTypes
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/e-money/bep3/module/types"
+)
+
+// dryRunKey marks the context of a dry run
+type dryRunKey struct{}
+
+// isDryRun returns true if ctx is the context of a dry run, whose effects are discarded.
+func isDryRun(ctx sdk.Context) bool {
+	return ctx.Context().Value(dryRunKey{}) != nil
+}
+
+// DryRunCreateAtomicSwap runs all checks of creating the swap in a cached context that is discarded, and returns
+// the swap that would be created or the error creating it fails with.
+func (k Keeper) DryRunCreateAtomicSwap(ctx sdk.Context, msg *types.MsgCreateAtomicSwap) *types.QuerySimulateCreateSwapResponse {
+	res := &types.QuerySimulateCreateSwapResponse{}
+	if from, err := sdk.AccAddressFromBech32(msg.From); err == nil {
+		res.SwapID = types.CalculateSwapID(msg.RandomNumberHash, from, msg.SenderOtherChain)
+	}
+
+	if err := k.dryRunCreateAtomicSwap(ctx, msg, res); err != nil {
+		res.Codespace, res.Code, _ = sdkerrors.ABCIInfo(err, false)
+		res.Error = err.Error()
+	}
+	return res
+}
+
+func (k Keeper) dryRunCreateAtomicSwap(ctx sdk.Context, msg *types.MsgCreateAtomicSwap, res *types.QuerySimulateCreateSwapResponse) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender")
+	}
+	to, err := sdk.AccAddressFromBech32(msg.To)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "to")
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager()).
+		WithContext(context.WithValue(ctx.Context(), dryRunKey{}, true))
+
+	if err := k.VerifyIncomingSwap(cacheCtx, msg); err != nil {
+		return err
+	}
+	_, err = k.CreateAtomicSwapState(cacheCtx, from, to, *msg)
+	if err != nil {
+		return err
+	}
+
+	swap, _ := k.GetAtomicSwap(cacheCtx, res.SwapID)
+	res.ExpireTimestamp = swap.ExpireTimestamp
+	res.Direction = swap.Direction
+	res.Fee = sdk.NewCoin(msg.Amount[0].Denom, sdk.ZeroInt())
+	// Only outgoing swaps pay the deputy's fixed fee on this chain
+	if swap.Direction == types.Outgoing {
+		asset, err := k.GetAsset(cacheCtx, msg.Amount[0].Denom)
+		if err != nil {
+			return err
+		}
+		res.Fee.Amount = asset.FixedFee
+	}
+	return nil
+}
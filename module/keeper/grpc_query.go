@@ -75,3 +75,134 @@ func (k Keeper) Swaps(c context.Context, req *types.QuerySwapsRequest) (*types.Q
 	return &types.QuerySwapsResponse{Swaps: augmentedSwaps}, nil
 }
 
+func (k Keeper) DenyList(c context.Context, req *types.QueryDenyListRequest) (*types.QueryDenyListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDenyListResponse{Addresses: k.GetDenyList(ctx)}, nil
+}
+
+func (k Keeper) SwapsByRandomNumberHash(c context.Context, req *types.QuerySwapsByRandomNumberHashRequest) (*types.QuerySwapsByRandomNumberHashResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.RandomNumberHash) != types.RandomNumberHashLength {
+		return nil, status.Errorf(codes.InvalidArgument, "the length of random number hash should be %d", types.RandomNumberHashLength)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	augmentedSwaps := types.AugmentedAtomicSwaps{}
+	for _, swap := range k.GetAtomicSwapsByRandomNumberHash(ctx, req.RandomNumberHash) {
+		augmentedSwaps.AugmentedAtomicSwaps = append(augmentedSwaps.AugmentedAtomicSwaps, types.NewAugmentedAtomicSwap(swap))
+	}
+
+	return &types.QuerySwapsByRandomNumberHashResponse{Swaps: augmentedSwaps}, nil
+}
+
+func (k Keeper) Stats(c context.Context, req *types.QueryStatsRequest) (*types.QueryStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.EndTime != 0 && req.EndTime < req.StartTime {
+		return nil, status.Error(codes.InvalidArgument, "end time cannot be before start time")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	stats := []types.SwapStats{}
+	if req.Denom != "" {
+		denomStats, found := k.GetSwapStats(ctx, req.Denom)
+		if !found {
+			denomStats = types.NewSwapStats(req.Denom)
+		}
+		stats = append(stats, denomStats)
+	} else {
+		stats = append(stats, k.GetAllSwapStats(ctx)...)
+	}
+
+	daily := []types.DailySwapStats{}
+	if req.Daily {
+		k.IterateDailySwapStats(ctx, req.Denom, func(d types.DailySwapStats) bool {
+			if d.Day >= req.StartTime && (req.EndTime == 0 || d.Day <= req.EndTime) {
+				daily = append(daily, d)
+			}
+			return false
+		})
+	}
+
+	return &types.QueryStatsResponse{Stats: stats, DailyStats: daily}, nil
+}
+
+func (k Keeper) DeputyHealth(c context.Context, req *types.QueryDeputyHealthRequest) (*types.QueryDeputyHealthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	asset, err := k.GetAsset(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDeputyHealthResponse{Health: k.GetDeputyHealth(ctx, asset)}, nil
+}
+
+func (k Keeper) DeputiesHealth(c context.Context, req *types.QueryDeputiesHealthRequest) (*types.QueryDeputiesHealthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	health := []types.DeputyHealth{}
+	assets, _ := k.GetAssets(ctx)
+	for _, asset := range assets {
+		health = append(health, k.GetDeputyHealth(ctx, asset))
+	}
+
+	return &types.QueryDeputiesHealthResponse{Health: health}, nil
+}
+
+func (k Keeper) DeputyBond(c context.Context, req *types.QueryDeputyBondRequest) (*types.QueryDeputyBondResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, err := sdk.AccAddressFromBech32(req.DeputyAddress); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	bond, found := k.GetDeputyBond(ctx, req.DeputyAddress)
+	if !found {
+		bond = types.NewDeputyBond(req.DeputyAddress, sdk.NewCoins())
+	}
+
+	return &types.QueryDeputyBondResponse{
+		Bond:       bond,
+		Unbondings: k.GetDeputyUnbondings(ctx, req.DeputyAddress),
+	}, nil
+}
+
+func (k Keeper) SimulateCreateSwap(c context.Context, req *types.QuerySimulateCreateSwapRequest) (*types.QuerySimulateCreateSwapResponse, error) {
+	if req == nil || req.Msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return k.DryRunCreateAtomicSwap(ctx, req.Msg), nil
+}
+
+func (k Keeper) AssetCapacity(c context.Context, req *types.QueryAssetCapacityRequest) (*types.QueryAssetCapacityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	capacity, err := k.GetAssetCapacity(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAssetCapacityResponse{Capacity: capacity}, nil
+}
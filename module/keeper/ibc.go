@@ -0,0 +1,375 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+	"github.com/e-money/bep3/module/types"
+)
+
+// SetIBCKeepers sets the IBC keepers used to settle interchain swaps over the bep3 port. Interchain swaps are
+// disabled unless they are set.
+func (k *Keeper) SetIBCKeepers(channelKeeper types.ChannelKeeper, portKeeper types.PortKeeper,
+	scopedKeeper types.ScopedKeeper) *Keeper {
+	if k.channelKeeper != nil {
+		panic("cannot set bep3 IBC keepers twice")
+	}
+	k.channelKeeper = channelKeeper
+	k.portKeeper = portKeeper
+	k.scopedKeeper = scopedKeeper
+	return k
+}
+
+// BindPort binds the bep3 port and claims its capability, unless it is already bound or the IBC keepers are not set.
+func (k Keeper) BindPort(ctx sdk.Context) error {
+	if k.portKeeper == nil {
+		return nil
+	}
+	if _, bound := k.scopedKeeper.GetCapability(ctx, host.PortPath(types.PortID)); bound {
+		return nil
+	}
+	capability := k.portKeeper.BindPort(ctx, types.PortID)
+	return k.ClaimCapability(ctx, capability, host.PortPath(types.PortID))
+}
+
+// AuthenticateCapability checks that a capability was issued to the bep3 module under the given name.
+func (k Keeper) AuthenticateCapability(ctx sdk.Context, capability *capabilitytypes.Capability, name string) bool {
+	return k.scopedKeeper.AuthenticateCapability(ctx, capability, name)
+}
+
+// ClaimCapability claims a capability passed to the bep3 module, such as that of a channel opened on its port.
+func (k Keeper) ClaimCapability(ctx sdk.Context, capability *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, capability, name)
+}
+
+// CreateInterchainSwapState escrows the amount of an outgoing swap and sends the packet creating the mirrored swap
+// on the counterparty chain of the channel. The swap is settled by the acknowledgement or timeout of the packet
+// rather than by a claim or refund, so it is not indexed for expiry.
+func (k Keeper) CreateInterchainSwapState(ctx sdk.Context, sender sdk.AccAddress, msg types.MsgCreateInterchainSwap) ([]byte, error) {
+	if k.channelKeeper == nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInterchainSwap, "interchain swaps are not enabled")
+	}
+	if msg.SourcePort != types.PortID {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInterchainSwap, "source port %s is not %s", msg.SourcePort, types.PortID)
+	}
+	channel, found := k.channelKeeper.GetChannel(ctx, msg.SourcePort, msg.SourceChannel)
+	if !found {
+		return nil, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", msg.SourcePort, msg.SourceChannel)
+	}
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, msg.SourcePort, msg.SourceChannel)
+	if !found {
+		return nil, sdkerrors.Wrapf(channeltypes.ErrSequenceSendNotFound, "port ID (%s) channel ID (%s)", msg.SourcePort, msg.SourceChannel)
+	}
+	chanCap, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(msg.SourcePort, msg.SourceChannel))
+	if !found {
+		return nil, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	swapID := types.CalculateSwapID(msg.RandomNumberHash, sender, "")
+	if _, found := k.GetAtomicSwap(ctx, swapID); found {
+		return nil, sdkerrors.Wrap(types.ErrAtomicSwapAlreadyExists, hex.EncodeToString(swapID))
+	}
+	if err := k.ValidateNotDenied(ctx, sender.String(), msg.To); err != nil {
+		return nil, err
+	}
+
+	amount := msg.Amount
+	if len(amount) != 1 {
+		return nil, fmt.Errorf("amount must contain exactly one coin")
+	}
+	if err := k.validateInterchainSwapAsset(ctx, amount[0], msg.SourceChannel); err != nil {
+		return nil, err
+	}
+	if err := validateSwapTimestamp(ctx, msg.Timestamp); err != nil {
+		return nil, err
+	}
+	if msg.TimeSpanMin < 1 || msg.TimeSpanMin > types.ThreeDayMinutes {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidTimeSpan, "minutes span %d outside range [%d, %d]",
+			msg.TimeSpanMin, 1, types.ThreeDayMinutes)
+	}
+
+	if err := k.IncrementOutgoingAssetSupply(ctx, amount[0]); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount); err != nil {
+		return nil, err
+	}
+
+	data := types.InterchainSwapPacketData{
+		Sender:           sender.String(),
+		Recipient:        msg.To,
+		RandomNumberHash: msg.RandomNumberHash,
+		Timestamp:        msg.Timestamp,
+		Amount:           amount,
+		TimeSpanMin:      msg.TimeSpanMin,
+	}
+	packet := channeltypes.NewPacket(data.GetBytes(), sequence, msg.SourcePort, msg.SourceChannel,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId, msg.TimeoutHeight, msg.TimeoutTimestamp)
+	if err := k.channelKeeper.SendPacket(ctx, chanCap, packet); err != nil {
+		return nil, err
+	}
+
+	// The expire timestamp is informational, the mirrored swap expires by the block time of the counterparty chain
+	expireTime := ctx.BlockTime().Add(time.Duration(msg.TimeSpanMin) * time.Minute)
+	atomicSwap := types.NewAtomicSwap(amount, msg.RandomNumberHash, expireTime.Unix(), msg.Timestamp, sender,
+		k.accountKeeper.GetModuleAddress(types.ModuleName), "", msg.To, 0, types.Open, true, types.Outgoing)
+	atomicSwap.IBCPacket = &packet
+	k.SetAtomicSwap(ctx, atomicSwap)
+	k.recordSwapCreated(ctx, atomicSwap)
+	k.emitInterchainSwapCreated(ctx, atomicSwap)
+
+	return swapID, nil
+}
+
+// OnRecvInterchainSwap creates the incoming swap mirroring the outgoing swap that sent the packet. The swap is
+// claimed and refunded like any other incoming swap, and its claim or expiry acknowledges the packet.
+func (k Keeper) OnRecvInterchainSwap(ctx sdk.Context, packet channeltypes.Packet, data types.InterchainSwapPacketData) error {
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+	recipient, err := sdk.AccAddressFromBech32(data.Recipient)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "interchain swap recipient %s: %s", data.Recipient, err)
+	}
+	if k.Maccs[recipient.String()] {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is a module account", recipient)
+	}
+
+	sender := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	swapID := types.CalculateSwapID(data.RandomNumberHash, sender, data.Sender)
+	if _, found := k.GetAtomicSwap(ctx, swapID); found {
+		return sdkerrors.Wrap(types.ErrAtomicSwapAlreadyExists, hex.EncodeToString(swapID))
+	}
+	if err := k.ValidateNotDenied(ctx, recipient.String(), data.Sender); err != nil {
+		return err
+	}
+	if err := k.validateInterchainSwapAsset(ctx, data.Amount[0], packet.DestinationChannel); err != nil {
+		return err
+	}
+
+	// Register the recipient so that it can claim the swap, as for incoming swaps relayed by a deputy
+	if k.accountKeeper.GetAccount(ctx, recipient) == nil {
+		k.accountKeeper.SetAccount(ctx, k.accountKeeper.NewAccountWithAddress(ctx, recipient))
+	}
+	if err := k.IncrementIncomingAssetSupply(ctx, data.Amount[0]); err != nil {
+		return err
+	}
+
+	expireTime := ctx.BlockTime().Add(time.Duration(data.TimeSpanMin) * time.Minute)
+	atomicSwap := types.NewAtomicSwap(data.Amount, data.RandomNumberHash, expireTime.Unix(), data.Timestamp, sender,
+		recipient, data.Sender, "", 0, types.Open, true, types.Incoming)
+	atomicSwap.IBCPacket = &packet
+	k.SetAtomicSwap(ctx, atomicSwap)
+	k.InsertIntoByTimestamp(ctx, atomicSwap)
+	k.recordSwapCreated(ctx, atomicSwap)
+	k.emitInterchainSwapCreated(ctx, atomicSwap)
+	return nil
+}
+
+// OnAcknowledgeInterchainSwap settles the outgoing swap that sent a packet. A successful acknowledgement carries the
+// random number the mirrored swap was claimed with and completes the swap, while an error acknowledgement refunds it.
+// The swap is not indexed for expiry, so an acknowledgement with an incorrect random number refunds it as well rather
+// than leaving it open.
+func (k Keeper) OnAcknowledgeInterchainSwap(ctx sdk.Context, data types.InterchainSwapPacketData,
+	ack channeltypes.Acknowledgement) error {
+	atomicSwap, err := k.getOpenInterchainSwap(ctx, data)
+	if err != nil {
+		return err
+	}
+	switch resp := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Result:
+		if !bytes.Equal(types.CalculateRandomHash(resp.Result, atomicSwap.Timestamp), atomicSwap.RandomNumberHash) {
+			return k.refundInterchainSwap(ctx, atomicSwap, "acknowledged random number is incorrect")
+		}
+		return k.completeInterchainSwap(ctx, atomicSwap, resp.Result)
+	default:
+		return k.refundInterchainSwap(ctx, atomicSwap, ack.GetError())
+	}
+}
+
+// OnTimeoutInterchainSwap refunds the outgoing swap that sent a packet that timed out before being received.
+func (k Keeper) OnTimeoutInterchainSwap(ctx sdk.Context, data types.InterchainSwapPacketData) error {
+	atomicSwap, err := k.getOpenInterchainSwap(ctx, data)
+	if err != nil {
+		return err
+	}
+	return k.refundInterchainSwap(ctx, atomicSwap, "packet timed out")
+}
+
+func (k Keeper) getOpenInterchainSwap(ctx sdk.Context, data types.InterchainSwapPacketData) (types.AtomicSwap, error) {
+	swapID, err := data.SwapID()
+	if err != nil {
+		return types.AtomicSwap{}, sdkerrors.Wrap(types.ErrInvalidInterchainSwap, err.Error())
+	}
+	atomicSwap, found := k.GetAtomicSwap(ctx, swapID)
+	if !found || atomicSwap.IBCPacket == nil {
+		return types.AtomicSwap{}, sdkerrors.Wrapf(types.ErrAtomicSwapNotFound, "%s", hex.EncodeToString(swapID))
+	}
+	if atomicSwap.Status != types.Open {
+		return types.AtomicSwap{}, sdkerrors.Wrapf(types.ErrInvalidInterchainSwap, "swap %s is %s",
+			hex.EncodeToString(swapID), atomicSwap.Status)
+	}
+	return atomicSwap, nil
+}
+
+// completeInterchainSwap burns the escrowed amount of an outgoing swap whose mirrored swap was claimed with the given
+// random number.
+func (k Keeper) completeInterchainSwap(ctx sdk.Context, atomicSwap types.AtomicSwap, randomNumber []byte) error {
+	if err := k.DecrementOutgoingAssetSupply(ctx, atomicSwap.Amount[0]); err != nil {
+		return err
+	}
+	if err := k.DecrementCurrentAssetSupply(ctx, atomicSwap.Amount[0]); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, atomicSwap.Amount); err != nil {
+		return err
+	}
+
+	k.closeInterchainSwap(ctx, atomicSwap)
+	k.recordSwapClaimed(ctx, atomicSwap, atomicSwap.Amount[0])
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClaimAtomicSwap,
+			sdk.NewAttribute(types.AttributeKeyClaimSender, atomicSwap.RecipientOtherChain),
+			sdk.NewAttribute(types.AttributeKeyRecipient, atomicSwap.Recipient),
+			sdk.NewAttribute(types.AttributeKeyAtomicSwapID, hex.EncodeToString(atomicSwap.GetSwapID())),
+			sdk.NewAttribute(types.AttributeKeyRandomNumberHash, hex.EncodeToString(atomicSwap.RandomNumberHash)),
+			sdk.NewAttribute(types.AttributeKeyRandomNumber, hex.EncodeToString(randomNumber)),
+			sdk.NewAttribute(types.AttributeKeyClaimTip, atomicSwap.ClaimTip.String()),
+			sdk.NewAttribute(types.AttributeKeyChannel, atomicSwap.IBCPacket.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeyPacketSequence, fmt.Sprint(atomicSwap.IBCPacket.Sequence)),
+		),
+	)
+	return nil
+}
+
+// refundInterchainSwap returns the escrowed amount of an outgoing swap whose mirrored swap was not created, expired or
+// was not acknowledged with the random number of the swap.
+func (k Keeper) refundInterchainSwap(ctx sdk.Context, atomicSwap types.AtomicSwap, reason string) error {
+	sender, err := sdk.AccAddressFromBech32(atomicSwap.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidSwapAccount, "RefundSwap sender:%s, error:%s", atomicSwap.Sender, err)
+	}
+	if err := k.DecrementOutgoingAssetSupply(ctx, atomicSwap.Amount[0]); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, atomicSwap.Amount); err != nil {
+		return err
+	}
+
+	k.closeInterchainSwap(ctx, atomicSwap)
+	k.recordSwapRefunded(ctx, atomicSwap)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundAtomicSwap,
+			sdk.NewAttribute(types.AttributeKeyRefundSender, atomicSwap.Recipient),
+			sdk.NewAttribute(types.AttributeKeySender, atomicSwap.Sender),
+			sdk.NewAttribute(types.AttributeKeyAtomicSwapID, hex.EncodeToString(atomicSwap.GetSwapID())),
+			sdk.NewAttribute(types.AttributeKeyRandomNumberHash, hex.EncodeToString(atomicSwap.RandomNumberHash)),
+			sdk.NewAttribute(types.AttributeKeyChannel, atomicSwap.IBCPacket.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeyPacketSequence, fmt.Sprint(atomicSwap.IBCPacket.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyError, reason),
+		),
+	)
+	return nil
+}
+
+func (k Keeper) closeInterchainSwap(ctx sdk.Context, atomicSwap types.AtomicSwap) {
+	atomicSwap.Status = types.Completed
+	atomicSwap.ClosedBlock = ctx.BlockHeight()
+	atomicSwap.ClosedTime = ctx.BlockTime().Unix()
+	k.SetAtomicSwap(ctx, atomicSwap)
+	k.InsertIntoLongtermStorage(ctx, atomicSwap)
+}
+
+// acknowledgeInterchainSwap writes the acknowledgement of the packet an incoming interchain swap mirrors, once the
+// swap has been claimed or has expired. Other swaps are ignored. A failure to write the acknowledgement is logged
+// rather than failing the claim or expiry of the swap.
+func (k Keeper) acknowledgeInterchainSwap(ctx sdk.Context, atomicSwap types.AtomicSwap, ack channeltypes.Acknowledgement) {
+	if atomicSwap.IBCPacket == nil || atomicSwap.Direction != types.Incoming {
+		return
+	}
+	packet := *atomicSwap.IBCPacket
+	err := k.writeAcknowledgement(ctx, packet, ack)
+	if err != nil {
+		k.Logger(ctx).Error("failed to acknowledge interchain swap", "swap", hex.EncodeToString(atomicSwap.GetSwapID()),
+			"channel", packet.DestinationChannel, "sequence", packet.Sequence, "error", err)
+	}
+}
+
+func (k Keeper) writeAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	if k.channelKeeper == nil {
+		return sdkerrors.Wrap(types.ErrInvalidInterchainSwap, "interchain swaps are not enabled")
+	}
+	chanCap, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(packet.DestinationPort, packet.DestinationChannel))
+	if !found {
+		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+	return k.channelKeeper.WriteAcknowledgement(ctx, chanCap, packet, ack.GetBytes())
+}
+
+// ValidateInterchainSwapChannel checks that a channel of the bep3 port is the IBC channel of an asset. Channels can only
+// be opened once governance has assigned them to an asset, so that no other counterparty chain can send swaps.
+func (k Keeper) ValidateInterchainSwapChannel(ctx sdk.Context, channelID string) error {
+	assets, _ := k.GetAssets(ctx)
+	for _, asset := range assets {
+		if asset.IBCChannel != "" && asset.IBCChannel == channelID {
+			return nil
+		}
+	}
+	return sdkerrors.Wrapf(types.ErrInvalidChannel, "channel %s is not the IBC channel of any asset", channelID)
+}
+
+// validateInterchainSwapAsset checks that the amount of an interchain swap is of a live asset swapped over the channel
+// of the swap and within its swap amount limits. Only the counterparty of the channel governance assigned to the asset
+// can mint its supply. Interchain swaps are not relayed by the deputy of the asset, so its bond and activity are not
+// checked.
+func (k Keeper) validateInterchainSwapAsset(ctx sdk.Context, amount sdk.Coin, channelID string) error {
+	asset, err := k.GetAsset(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+	if asset.IBCChannel == "" || asset.IBCChannel != channelID {
+		return sdkerrors.Wrapf(types.ErrInvalidChannel, "asset %s is not swapped over channel %s", amount.Denom, channelID)
+	}
+	if err := k.ValidateLiveAsset(ctx, amount); err != nil {
+		return err
+	}
+	if amount.Amount.LT(asset.MinSwapAmount) || amount.Amount.GT(asset.MaxSwapAmount) {
+		return sdkerrors.Wrapf(types.ErrInvalidAmount, "amount %d outside range [%s, %s]", amount.Amount, asset.MinSwapAmount, asset.MaxSwapAmount)
+	}
+	return nil
+}
+
+func (k Keeper) emitInterchainSwapCreated(ctx sdk.Context, atomicSwap types.AtomicSwap) {
+	channel := atomicSwap.IBCPacket.SourceChannel
+	if atomicSwap.Direction == types.Incoming {
+		channel = atomicSwap.IBCPacket.DestinationChannel
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateAtomicSwap,
+			sdk.NewAttribute(types.AttributeKeySender, atomicSwap.Sender),
+			sdk.NewAttribute(types.AttributeKeyRecipient, atomicSwap.Recipient),
+			sdk.NewAttribute(types.AttributeKeyAtomicSwapID, hex.EncodeToString(atomicSwap.GetSwapID())),
+			sdk.NewAttribute(types.AttributeKeyRandomNumberHash, hex.EncodeToString(atomicSwap.RandomNumberHash)),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, fmt.Sprintf("%d", atomicSwap.Timestamp)),
+			sdk.NewAttribute(types.AttributeKeySenderOtherChain, atomicSwap.SenderOtherChain),
+			sdk.NewAttribute(types.AttributeKeyRecipientOtherChain, atomicSwap.RecipientOtherChain),
+			sdk.NewAttribute(types.AttributeKeyExpireTimestamp, fmt.Sprintf("%d", atomicSwap.ExpireTimestamp)),
+			sdk.NewAttribute(types.AttributeKeyAmount, atomicSwap.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDirection, atomicSwap.Direction.String()),
+			sdk.NewAttribute(types.AttributeKeyChannel, channel),
+			sdk.NewAttribute(types.AttributeKeyPacketSequence, fmt.Sprint(atomicSwap.IBCPacket.Sequence)),
+		),
+	)
+}
@@ -73,6 +73,7 @@ func NewBep3GenState(deputyAddress sdk.AccAddress) json.RawMessage {
 					SwapTimestamp: bep3.DefaultSwapBlockTimestamp,
 				},
 			},
+			LongtermStorageRetention: types.DefaultLongtermStorageRetention,
 		},
 		Supplies: types.AssetSupplies{
 			AssetSupplies: []types.AssetSupply{
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/bep3/module/types"
+)
+
+// RegisterInvariants registers all bep3 invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "swap-supplies", SwapSuppliesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(k))
+}
+
+// AllInvariants runs all invariants of the bep3 module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := SwapSuppliesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return ModuleAccountInvariant(k)(ctx)
+	}
+}
+
+// SwapSuppliesInvariant checks that the incoming and outgoing supply of each asset equal the amounts in the
+// incoming and outgoing swaps that have not been closed.
+func SwapSuppliesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		incoming, outgoing, _ := openSwapAmounts(ctx, k)
+
+		var (
+			msg    string
+			broken bool
+		)
+		k.IterateAssetSupplies(ctx, func(supply types.AssetSupply) bool {
+			denom := supply.GetDenom()
+			if !supply.IncomingSupply.Amount.Equal(incoming.AmountOf(denom)) {
+				broken = true
+				msg += fmt.Sprintf("\tincoming supply %s does not match incoming swaps %s%s\n",
+					supply.IncomingSupply, incoming.AmountOf(denom), denom)
+			}
+			if !supply.OutgoingSupply.Amount.Equal(outgoing.AmountOf(denom)) {
+				broken = true
+				msg += fmt.Sprintf("\toutgoing supply %s does not match outgoing swaps %s%s\n",
+					supply.OutgoingSupply, outgoing.AmountOf(denom), denom)
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "swap supplies",
+			fmt.Sprintf("asset supplies out of sync with open swaps:\n%s", msg)), broken
+	}
+}
+
+// ModuleAccountInvariant checks that the module account holds at least the coins locked in open outgoing and local
+// swaps and the deputy collateral that is bonded or unbonding. Coins sent to the module account are not locked.
+func ModuleAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		_, outgoing, local := openSwapAmounts(ctx, k)
+		locked := outgoing.Add(local...)
+		k.IterateDeputyBonds(ctx, func(bond types.DeputyBond) bool {
+			locked = locked.Add(bond.Amount...)
+			return false
+		})
+		k.IterateDeputyUnbondings(ctx, func(unbonding types.DeputyUnbonding) bool {
+			locked = locked.Add(unbonding.Amount...)
+			return false
+		})
+
+		balance := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+		broken := !balance.IsAllGTE(locked)
+
+		return sdk.FormatInvariant(types.ModuleName, "module account",
+			fmt.Sprintf("\tmodule account balance: %s\n\tlocked in swaps and deputy bonds: %s\n", balance, locked)), broken
+	}
+}
+
+// openSwapAmounts sums the unclaimed amounts of the open and expired swaps by direction
+func openSwapAmounts(ctx sdk.Context, k Keeper) (incoming, outgoing, local sdk.Coins) {
+	incoming, outgoing, local = sdk.NewCoins(), sdk.NewCoins(), sdk.NewCoins()
+	k.IterateAtomicSwaps(ctx, func(swap types.AtomicSwap) bool {
+		if swap.Status == types.Completed {
+			return false
+		}
+		switch swap.Direction {
+		case types.Incoming:
+			incoming = incoming.Add(swap.UnclaimedAmount()...)
+		case types.Outgoing:
+			outgoing = outgoing.Add(swap.UnclaimedAmount()...)
+		case types.Local:
+			local = local.Add(swap.UnclaimedAmount()...)
+		}
+		return false
+	})
+	return
+}
@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/bep3/module/keeper"
+	"github.com/e-money/bep3/module/types"
+)
+
+func (suite *AtomicSwapTestSuite) TestInvariants() {
+	amount := cs(c(BNB_DENOM, 50000))
+	suite.Require().NoError(suite.keeper.IncrementCurrentAssetSupply(suite.ctx, amount[0]))
+
+	_, err := suite.keeper.CreateAtomicSwapState(suite.ctx, suite.deputy, suite.addrs[1], types.MsgCreateAtomicSwap{
+		From:                suite.deputy.String(),
+		To:                  suite.addrs[1].String(),
+		RecipientOtherChain: TestRecipientOtherChain,
+		SenderOtherChain:    TestSenderOtherChain,
+		RandomNumberHash:    suite.randomNumberHashes[0],
+		Timestamp:           suite.timestamps[0],
+		Amount:              amount,
+		TimeSpanMin:         types.DefaultSwapTimeSpanMinutes,
+	})
+	suite.Require().NoError(err)
+	_, err = suite.keeper.CreateAtomicSwapState(suite.ctx, suite.addrs[1], suite.deputy, types.MsgCreateAtomicSwap{
+		From:                suite.addrs[1].String(),
+		To:                  suite.deputy.String(),
+		RecipientOtherChain: TestRecipientOtherChain,
+		SenderOtherChain:    TestSenderOtherChain,
+		RandomNumberHash:    suite.randomNumberHashes[1],
+		Timestamp:           suite.timestamps[1],
+		Amount:              amount,
+		TimeSpanMin:         types.DefaultSwapTimeSpanMinutes,
+	})
+	suite.Require().NoError(err)
+
+	invariants := map[string]sdk.Invariant{
+		"swap supplies":  keeper.SwapSuppliesInvariant(suite.keeper),
+		"module account": keeper.ModuleAccountInvariant(suite.keeper),
+		"all":            keeper.AllInvariants(suite.keeper),
+	}
+	for name, invariant := range invariants {
+		msg, broken := invariant(suite.ctx)
+		suite.False(broken, "%s: %s", name, msg)
+	}
+
+	tests := []struct {
+		name      string
+		corrupt   func(ctx sdk.Context)
+		invariant string
+	}{
+		{
+			"incoming supply without a swap",
+			func(ctx sdk.Context) {
+				suite.Require().NoError(suite.keeper.IncrementIncomingAssetSupply(ctx, c(BNB_DENOM, 1)))
+			},
+			"swap supplies",
+		},
+		{
+			"outgoing supply without a swap",
+			func(ctx sdk.Context) {
+				suite.Require().NoError(suite.keeper.DecrementOutgoingAssetSupply(ctx, c(BNB_DENOM, 1)))
+			},
+			"swap supplies",
+		},
+		{
+			"bond without collateral",
+			func(ctx sdk.Context) {
+				suite.keeper.SetDeputyBond(ctx, types.NewDeputyBond(suite.deputy.String(), cs(c("ungm", 1))))
+			},
+			"module account",
+		},
+	}
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			tc.corrupt(ctx)
+
+			_, broken := invariants[tc.invariant](ctx)
+			suite.True(broken)
+			_, broken = invariants["all"](ctx)
+			suite.True(broken)
+		})
+	}
+
+	// Coins sent to the module account beyond the locked coins do not break it
+	ctx, _ := suite.ctx.CacheContext()
+	suite.Require().NoError(suite.bankKeeper.MintCoins(ctx, types.ModuleName, cs(c(BNB_DENOM, 1))))
+	_, broken := invariants["module account"](ctx)
+	suite.False(broken)
+}
@@ -2,11 +2,13 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/e-money/bep3/module/types"
@@ -23,6 +25,12 @@ type Keeper struct {
 	// authKeeper
 	accountKeeper types.AccountKeeper
 	Maccs         map[string]bool
+	// verifier of the proofs of incoming swaps, nil unless set with SetSwapVerifier
+	verifier types.SwapVerifier
+	// IBC keepers of interchain swaps, nil unless set with SetIBCKeepers
+	channelKeeper types.ChannelKeeper
+	portKeeper    types.PortKeeper
+	scopedKeeper  types.ScopedKeeper
 }
 
 // NewKeeper creates a bep3 keeper
@@ -70,6 +78,8 @@ func (k Keeper) SetAtomicSwap(ctx sdk.Context, atomicSwap types.AtomicSwap) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapKeyPrefix)
 	bz := k.cdc.MustMarshalBinaryLengthPrefixed(&atomicSwap)
 	store.Set(atomicSwap.GetSwapID(), bz)
+
+	k.InsertIntoByRandomNumberHash(ctx, atomicSwap)
 }
 
 // GetAtomicSwap gets an AtomicSwap from the store.
@@ -86,8 +96,12 @@ func (k Keeper) GetAtomicSwap(ctx sdk.Context, swapID []byte) (types.AtomicSwap,
 	return atomicSwap, true
 }
 
-// RemoveAtomicSwap removes an AtomicSwap from the AtomicSwapKeyPrefix.
+// RemoveAtomicSwap removes an AtomicSwap from the AtomicSwapKeyPrefix and the byRandomNumberHash index.
 func (k Keeper) RemoveAtomicSwap(ctx sdk.Context, swapID []byte) {
+	if atomicSwap, found := k.GetAtomicSwap(ctx, swapID); found {
+		k.RemoveFromByRandomNumberHash(ctx, atomicSwap)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapKeyPrefix)
 	store.Delete(swapID)
 }
@@ -127,13 +141,47 @@ func (k Keeper) InsertIntoByTimestamp(ctx sdk.Context, atomicSwap types.AtomicSw
 	swapKey := types.GetAtomicSwapByTimestampKey(
 		atomicSwap.ExpireTimestamp, atomicSwap.GetSwapID())
 
+	if store.Has(swapKey) {
+		return
+	}
+	count := k.GetOpenSwapCount(ctx)
 	store.Set(swapKey, atomicSwap.GetSwapID())
+	k.setOpenSwapCount(ctx, count+1)
 }
 
 // RemoveFromByTimestamp removes an AtomicSwap from the byTimestamp index.
 func (k Keeper) RemoveFromByTimestamp(ctx sdk.Context, atomicSwap types.AtomicSwap) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapByBlockPrefix)
-	store.Delete(types.GetAtomicSwapByTimestampKey(atomicSwap.ExpireTimestamp, atomicSwap.GetSwapID()))
+	swapKey := types.GetAtomicSwapByTimestampKey(atomicSwap.ExpireTimestamp, atomicSwap.GetSwapID())
+	if !store.Has(swapKey) {
+		return
+	}
+	count := k.GetOpenSwapCount(ctx)
+	store.Delete(swapKey)
+	k.setOpenSwapCount(ctx, count-1)
+}
+
+// GetOpenSwapCount returns the number of swaps in the byTimestamp index. State written before the count
+// was kept has no count, in which case the index is counted.
+func (k Keeper) GetOpenSwapCount(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.key).Get(types.OpenSwapCountKey)
+	if bz != nil {
+		return sdk.BigEndianToUint64(bz)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapByBlockPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var count uint64
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	return count
+}
+
+func (k Keeper) setOpenSwapCount(ctx sdk.Context, count uint64) {
+	ctx.KVStore(k.key).Set(types.OpenSwapCountKey, sdk.Uint64ToBigEndian(count))
 }
 
 // IterateAtomicSwapsByBlock provides an iterator over AtomicSwaps ordered by AtomicSwap expiration block
@@ -156,34 +204,78 @@ func (k Keeper) IterateAtomicSwapsByBlock(ctx sdk.Context, inclusiveCutoffTime i
 	}
 }
 
+// ------------------------------------------
+//		Atomic Swap Random Number Hash Index
+// ------------------------------------------
+
+// InsertIntoByRandomNumberHash adds a swap ID into the byRandomNumberHash index.
+func (k Keeper) InsertIntoByRandomNumberHash(ctx sdk.Context, atomicSwap types.AtomicSwap) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapByRandomNumberHashPrefix)
+	swapKey := types.GetAtomicSwapByRandomNumberHashKey(atomicSwap.RandomNumberHash, atomicSwap.GetSwapID())
+
+	store.Set(swapKey, atomicSwap.GetSwapID())
+}
+
+// RemoveFromByRandomNumberHash removes an AtomicSwap from the byRandomNumberHash index.
+func (k Keeper) RemoveFromByRandomNumberHash(ctx sdk.Context, atomicSwap types.AtomicSwap) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapByRandomNumberHashPrefix)
+	store.Delete(types.GetAtomicSwapByRandomNumberHashKey(atomicSwap.RandomNumberHash, atomicSwap.GetSwapID()))
+}
+
+// IterateAtomicSwapsByRandomNumberHash provides an iterator over the IDs of AtomicSwaps locked under a random number hash.
+// For each AtomicSwap cb will be called. If cb returns true the iterator will close and stop.
+func (k Keeper) IterateAtomicSwapsByRandomNumberHash(ctx sdk.Context, randomNumberHash []byte,
+	cb func(swapID []byte) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapByRandomNumberHashPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, randomNumberHash)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(iterator.Value()) {
+			break
+		}
+	}
+}
+
+// GetAtomicSwapsByRandomNumberHash returns all AtomicSwaps locked under a random number hash
+func (k Keeper) GetAtomicSwapsByRandomNumberHash(ctx sdk.Context, randomNumberHash []byte) (atomicSwaps types.AtomicSwaps) {
+	k.IterateAtomicSwapsByRandomNumberHash(ctx, randomNumberHash, func(swapID []byte) bool {
+		atomicSwap, found := k.GetAtomicSwap(ctx, swapID)
+		if found {
+			atomicSwaps = append(atomicSwaps, atomicSwap)
+		}
+		return false
+	})
+	return
+}
+
 // ------------------------------------------
 //		Atomic Swap Longterm Storage Index
 // ------------------------------------------
 
-// InsertIntoLongtermStorage adds a swap ID and deletion time into the longterm storage index.
-// Completed swaps are stored for roughly 1 week.
+// InsertIntoLongtermStorage adds a closed swap ID into the longterm storage index, keyed by its close time.
+// Swaps are deleted once the LongtermStorageRetention param has passed.
 func (k Keeper) InsertIntoLongtermStorage(ctx sdk.Context, atomicSwap types.AtomicSwap) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapLongtermStoragePrefix)
+	swapKey := types.GetAtomicSwapByTimestampKey(atomicSwap.ClosedTime, atomicSwap.GetSwapID())
 
-	deletionHeight := uint64(atomicSwap.ClosedBlock) + types.DefaultLongtermStorageDuration
-	store.Set(types.GetAtomicSwapByHeightKey(deletionHeight, atomicSwap.GetSwapID()), atomicSwap.GetSwapID())
+	store.Set(swapKey, atomicSwap.GetSwapID())
 }
 
 // RemoveFromLongtermStorage removes a swap from the into the longterm storage index
 func (k Keeper) RemoveFromLongtermStorage(ctx sdk.Context, atomicSwap types.AtomicSwap) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapLongtermStoragePrefix)
-	deletionHeight := uint64(atomicSwap.ClosedBlock) + types.DefaultLongtermStorageDuration
-	store.Delete(types.GetAtomicSwapByHeightKey(deletionHeight, atomicSwap.GetSwapID()))
+	store.Delete(types.GetAtomicSwapByTimestampKey(atomicSwap.ClosedTime, atomicSwap.GetSwapID()))
 }
 
-// IterateAtomicSwapsLongtermStorage provides an iterator over AtomicSwaps ordered by deletion height.
-// For each AtomicSwap cb will be called. If cb returns true the iterator will close and stop.
-func (k Keeper) IterateAtomicSwapsLongtermStorage(ctx sdk.Context, inclusiveCutoffTime uint64,
+// IterateAtomicSwapsLongtermStorage provides an iterator over closed AtomicSwaps ordered by close time, up to and
+// including inclusiveCutoffTime. For each AtomicSwap cb will be called. If cb returns true the iterator will close and stop.
+func (k Keeper) IterateAtomicSwapsLongtermStorage(ctx sdk.Context, inclusiveCutoffTime int64,
 	cb func(swapID []byte) (stop bool)) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapLongtermStoragePrefix)
 	iterator := store.Iterator(
 		nil, // start at the very start of the prefix store
-		sdk.PrefixEndBytes(sdk.Uint64ToBigEndian(inclusiveCutoffTime)), // end of range
+		sdk.PrefixEndBytes(types.GetTimestampSortableKey(inclusiveCutoffTime)), // end of range
 	)
 
 	defer iterator.Close()
@@ -197,6 +289,64 @@ func (k Keeper) IterateAtomicSwapsLongtermStorage(ctx sdk.Context, inclusiveCuto
 	}
 }
 
+// ------------------------------------------
+//				Deny List
+// ------------------------------------------
+
+// SetDeniedAddress adds a local or other-chain address to the deny list.
+func (k Keeper) SetDeniedAddress(ctx sdk.Context, address string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DenyListPrefix)
+	store.Set(types.GetDenyListKey(address), []byte(strings.TrimSpace(address)))
+}
+
+// RemoveDeniedAddress removes an address from the deny list.
+func (k Keeper) RemoveDeniedAddress(ctx sdk.Context, address string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DenyListPrefix)
+	store.Delete(types.GetDenyListKey(address))
+}
+
+// IsAddressDenied returns true if the address is on the deny list. Empty addresses are never denied.
+func (k Keeper) IsAddressDenied(ctx sdk.Context, address string) bool {
+	if types.NormalizeDenyListAddress(address) == "" {
+		return false
+	}
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DenyListPrefix)
+	return store.Has(types.GetDenyListKey(address))
+}
+
+// ValidateNotDenied returns an error if any of the given addresses is on the deny list.
+func (k Keeper) ValidateNotDenied(ctx sdk.Context, addresses ...string) error {
+	for _, address := range addresses {
+		if k.IsAddressDenied(ctx, address) {
+			return sdkerrors.Wrap(types.ErrAddressDenied, address)
+		}
+	}
+	return nil
+}
+
+// IterateDeniedAddresses provides an iterator over all denied addresses.
+// For each address, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateDeniedAddresses(ctx sdk.Context, cb func(address string) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.DenyListPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(string(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetDenyList returns all denied addresses from the store
+func (k Keeper) GetDenyList(ctx sdk.Context) []string {
+	denyList := []string{}
+	k.IterateDeniedAddresses(ctx, func(address string) bool {
+		denyList = append(denyList, address)
+		return false
+	})
+	return denyList
+}
+
 // ------------------------------------------
 //				Asset Supplies
 // ------------------------------------------
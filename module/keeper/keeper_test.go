@@ -1,10 +1,13 @@
 package keeper_test
 
 import (
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bep3 "github.com/e-money/bep3/module"
@@ -181,7 +184,15 @@ func (suite *KeeperTestSuite) TestRemoveFromByBlockIndex() {
 	})
 	suite.Equal(len(swapIDsPre), 1)
 
+	// The open swap count tracks the index, inserting or removing a swap twice counts once
+	suite.Equal(uint64(1), suite.keeper.GetOpenSwapCount(suite.ctx))
+	suite.keeper.InsertIntoByTimestamp(suite.ctx, atomicSwap)
+	suite.Equal(uint64(1), suite.keeper.GetOpenSwapCount(suite.ctx))
+
+	suite.keeper.RemoveFromByTimestamp(suite.ctx, atomicSwap)
+	suite.Equal(uint64(0), suite.keeper.GetOpenSwapCount(suite.ctx))
 	suite.keeper.RemoveFromByTimestamp(suite.ctx, atomicSwap)
+	suite.Equal(uint64(0), suite.keeper.GetOpenSwapCount(suite.ctx))
 
 	// Check stored data not in block index
 	var swapIDsPost [][]byte
@@ -246,13 +257,14 @@ func (suite *KeeperTestSuite) TestInsertIntoLongtermStorage() {
 	// Set atomic swap in longterm storage
 	atomicSwap := atomicSwap(suite.ctx, 1)
 	atomicSwap.ClosedBlock = suite.ctx.BlockHeight()
+	atomicSwap.ClosedTime = suite.ctx.BlockTime().Unix()
 	suite.keeper.InsertIntoLongtermStorage(suite.ctx, atomicSwap)
 
 	// Longterm storage lacks getter methods, must use iteration to get count of swaps in store
 	var swapIDs [][]byte
 	suite.keeper.IterateAtomicSwapsLongtermStorage(
 		suite.ctx,
-		uint64(atomicSwap.ClosedBlock)+types.DefaultLongtermStorageDuration,
+		atomicSwap.ClosedTime,
 		func(id []byte) bool {
 			swapIDs = append(swapIDs, id)
 			return false
@@ -273,13 +285,14 @@ func (suite *KeeperTestSuite) TestRemoveFromLongtermStorage() {
 	// Set atomic swap in longterm storage
 	atomicSwap := atomicSwap(suite.ctx, 1)
 	atomicSwap.ClosedBlock = suite.ctx.BlockHeight()
+	atomicSwap.ClosedTime = suite.ctx.BlockTime().Unix()
 	suite.keeper.InsertIntoLongtermStorage(suite.ctx, atomicSwap)
 
 	// Longterm storage lacks getter methods, must use iteration to get count of swaps in store
 	var swapIDs [][]byte
 	suite.keeper.IterateAtomicSwapsLongtermStorage(
 		suite.ctx,
-		uint64(atomicSwap.ClosedBlock)+types.DefaultLongtermStorageDuration,
+		atomicSwap.ClosedTime,
 		func(id []byte) bool {
 			swapIDs = append(swapIDs, id)
 			return false
@@ -292,7 +305,7 @@ func (suite *KeeperTestSuite) TestRemoveFromLongtermStorage() {
 	var swapIDsPost [][]byte
 	suite.keeper.IterateAtomicSwapsLongtermStorage(
 		suite.ctx,
-		uint64(atomicSwap.ClosedBlock)+types.DefaultLongtermStorageDuration,
+		atomicSwap.ClosedTime,
 		func(id []byte) bool {
 			swapIDsPost = append(swapIDsPost, id)
 			return false
@@ -303,7 +316,7 @@ func (suite *KeeperTestSuite) TestRemoveFromLongtermStorage() {
 func (suite *KeeperTestSuite) TestIterateAtomicSwapsLongtermStorage() {
 	suite.ResetChain()
 
-	// Set up atomic swaps with stagged closed blocks
+	// Set up atomic swaps with stagged close times
 	var swaps types.AtomicSwaps
 	for i := 0; i < 8; i++ {
 		timestamp := tmtime.Now().Unix()
@@ -315,26 +328,25 @@ func (suite *KeeperTestSuite) TestIterateAtomicSwapsLongtermStorage() {
 			TestSenderOtherChain, TestRecipientOtherChain, 100, types.Open,
 			true, types.Incoming)
 
-		// Set closed block staggered by 100 blocks and insert into longterm storage
-		atomicSwap.ClosedBlock = int64(i) * 100
+		// Set close time staggered by 100 seconds and insert into longterm storage
+		atomicSwap.ClosedTime = int64(i) * 100
 		suite.keeper.InsertIntoLongtermStorage(suite.ctx, atomicSwap)
 		// Add to local longterm storage
 		swaps = append(swaps, atomicSwap)
 	}
 
-	// Set up the expected swap IDs for a given cutoff block.
-	cutoffBlock := int64(types.DefaultLongtermStorageDuration + 350)
+	// Set up the expected swap IDs for a given cutoff time.
+	cutoffTime := int64(350)
 	var expectedSwapIDs [][]byte
 	for _, swap := range swaps {
-		if swap.ClosedBlock+int64(types.DefaultLongtermStorageDuration) < cutoffBlock ||
-			swap.ClosedBlock+int64(types.DefaultLongtermStorageDuration) == cutoffBlock {
+		if swap.ClosedTime <= cutoffTime {
 			expectedSwapIDs = append(expectedSwapIDs, swap.GetSwapID())
 		}
 	}
 
-	// Read the swap IDs from store for a given cutoff block
+	// Read the swap IDs from store for a given cutoff time
 	var readSwapIDs [][]byte
-	suite.keeper.IterateAtomicSwapsLongtermStorage(suite.ctx, uint64(cutoffBlock), func(id []byte) bool {
+	suite.keeper.IterateAtomicSwapsLongtermStorage(suite.ctx, cutoffTime, func(id []byte) bool {
 		readSwapIDs = append(readSwapIDs, id)
 		return false
 	})
@@ -346,6 +358,43 @@ func (suite *KeeperTestSuite) TestIterateAtomicSwapsLongtermStorage() {
 	suite.Equal(expectedSwapIDs, readSwapIDs)
 }
 
+func (suite *KeeperTestSuite) TestMigrateLongtermStorage() {
+	ctx, _, k, _, _, _, storeKey := app.CreateTestComponentsWithStoreKey(suite.T())
+
+	// A swap closed at block 10 under the height keyed index
+	swap := atomicSwap(ctx, 1)
+	swap.Status = types.Completed
+	swap.ClosedBlock = 10
+	k.SetAtomicSwap(ctx, swap)
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.AtomicSwapLongtermStoragePrefix)
+	store.Set(types.GetAtomicSwapByHeightKey(uint64(swap.ClosedBlock)+7*24*60*60, swap.GetSwapID()), swap.GetSwapID())
+
+	k.MigrateLongtermStorage(ctx)
+
+	suite.Equal(types.DefaultLongtermStorageRetention, k.GetLongtermStorageRetention(ctx))
+	migrated, found := k.GetAtomicSwap(ctx, swap.GetSwapID())
+	suite.Require().True(found)
+	suite.Equal(ctx.BlockTime().Unix(), migrated.ClosedTime)
+
+	// Only the time keyed entry is left
+	var keys [][]byte
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	suite.Equal([][]byte{types.GetAtomicSwapByTimestampKey(migrated.ClosedTime, swap.GetSwapID())}, keys)
+
+	// The swap is retained for a full retention period from the migration
+	retention := time.Duration(types.DefaultLongtermStorageRetention.Value) * time.Second
+	k.DeleteClosedAtomicSwapsFromLongtermStorage(ctx.WithBlockTime(ctx.BlockTime().Add(retention - time.Second)))
+	_, found = k.GetAtomicSwap(ctx, swap.GetSwapID())
+	suite.True(found)
+	k.DeleteClosedAtomicSwapsFromLongtermStorage(ctx.WithBlockTime(ctx.BlockTime().Add(retention)))
+	_, found = k.GetAtomicSwap(ctx, swap.GetSwapID())
+	suite.False(found)
+}
+
 func (suite *KeeperTestSuite) TestGetSetAssetSupply() {
 	denom := "bnb"
 	// Put asset supply in store
@@ -374,6 +423,66 @@ func (suite *KeeperTestSuite) TestGetAllAssetSupplies() {
 	suite.Equal(2, len(supplies.AssetSupplies))
 }
 
+func (suite *KeeperTestSuite) TestGetAtomicSwapsByRandomNumberHash() {
+	suite.ResetChain()
+
+	// Two swaps locked under the same random number hash by different senders
+	swapA := atomicSwap(suite.ctx, 1)
+	swapB := swapA
+	swapB.Sender = TestUser2.String()
+	swapB.Recipient = TestUser1.String()
+	other := atomicSwap(suite.ctx, 2)
+	suite.keeper.SetAtomicSwap(suite.ctx, swapA)
+	suite.keeper.SetAtomicSwap(suite.ctx, swapB)
+	suite.keeper.SetAtomicSwap(suite.ctx, other)
+
+	swaps := suite.keeper.GetAtomicSwapsByRandomNumberHash(suite.ctx, swapA.RandomNumberHash)
+	suite.ElementsMatch(types.AtomicSwaps{swapA, swapB}, swaps)
+
+	// Updating a swap does not duplicate its index entry
+	swapA.Status = types.Expired
+	suite.keeper.SetAtomicSwap(suite.ctx, swapA)
+	suite.Len(suite.keeper.GetAtomicSwapsByRandomNumberHash(suite.ctx, swapA.RandomNumberHash), 2)
+
+	// Removing a swap removes it from the index
+	suite.keeper.RemoveAtomicSwap(suite.ctx, swapB.GetSwapID())
+	swaps = suite.keeper.GetAtomicSwapsByRandomNumberHash(suite.ctx, swapA.RandomNumberHash)
+	suite.Equal(types.AtomicSwaps{swapA}, swaps)
+
+	fakeRandomNumberHash := types.CalculateRandomHash([]byte{1}, ts(3))
+	suite.Empty(suite.keeper.GetAtomicSwapsByRandomNumberHash(suite.ctx, fakeRandomNumberHash))
+}
+
+func (suite *KeeperTestSuite) TestGetSetDeniedAddress() {
+	suite.ResetChain()
+
+	local := authtypes.NewModuleAddress("denied").String()
+	otherChain := "bnb1uky3me9ggqypmrsvxk7ur6hqkzq7zmv4ed4ng7"
+	suite.keeper.SetDeniedAddress(suite.ctx, local)
+	suite.keeper.SetDeniedAddress(suite.ctx, otherChain)
+
+	suite.True(suite.keeper.IsAddressDenied(suite.ctx, local))
+	// Lookups ignore case and surrounding whitespace
+	suite.True(suite.keeper.IsAddressDenied(suite.ctx, " BNB1UKY3ME9GGQYPMRSVXK7UR6HQKZQ7ZMV4ED4NG7"))
+	suite.False(suite.keeper.IsAddressDenied(suite.ctx, "bnb1ud3q90r98l3mhd87kswv3h8cgrymzeljct8qn7"))
+	suite.False(suite.keeper.IsAddressDenied(suite.ctx, ""))
+	suite.Len(suite.keeper.GetDenyList(suite.ctx), 2)
+
+	// Addresses that are not bech32 may be case-sensitive and only match exactly
+	checksummed := "0x52908400098527886E0F7030069857D2E4169EE7"
+	suite.keeper.SetDeniedAddress(suite.ctx, checksummed)
+	suite.True(suite.keeper.IsAddressDenied(suite.ctx, checksummed+" "))
+	suite.False(suite.keeper.IsAddressDenied(suite.ctx, strings.ToLower(checksummed)))
+	suite.keeper.RemoveDeniedAddress(suite.ctx, checksummed)
+
+	err := suite.keeper.ValidateNotDenied(suite.ctx, "", otherChain)
+	suite.True(errors.Is(err, types.ErrAddressDenied))
+
+	suite.keeper.RemoveDeniedAddress(suite.ctx, strings.ToUpper(local))
+	suite.False(suite.keeper.IsAddressDenied(suite.ctx, local))
+	suite.Equal([]string{otherChain}, suite.keeper.GetDenyList(suite.ctx))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
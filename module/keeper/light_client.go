@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/e-money/bep3/module/types"
+)
+
+// SetSwapVerifier sets the verifier of the proofs carried by incoming swaps of assets that verify them.
+func (k *Keeper) SetSwapVerifier(verifier types.SwapVerifier) *Keeper {
+	if k.verifier != nil {
+		panic("cannot set bep3 swap verifier twice")
+	}
+	k.verifier = verifier
+	return k
+}
+
+// GetLightClientState returns the trusted state of the counterparty chain of an asset.
+func (k Keeper) GetLightClientState(ctx sdk.Context, denom string) (types.LightClientState, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LightClientStatePrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return types.LightClientState{}, false
+	}
+	var state types.LightClientState
+	k.cdc.MustUnmarshalBinaryBare(bz, &state)
+	return state, true
+}
+
+// SetLightClientState stores the trusted state of the counterparty chain of an asset.
+func (k Keeper) SetLightClientState(ctx sdk.Context, state types.LightClientState) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LightClientStatePrefix)
+	store.Set([]byte(state.Denom), k.cdc.MustMarshalBinaryBare(&state))
+}
+
+// IterateLightClientStates provides an iterator over the light client states of all assets.
+func (k Keeper) IterateLightClientStates(ctx sdk.Context, cb func(state types.LightClientState) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.LightClientStatePrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var state types.LightClientState
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &state)
+
+		if cb(state) {
+			break
+		}
+	}
+}
+
+// GetAllLightClientStates returns the light client states of all assets.
+func (k Keeper) GetAllLightClientStates(ctx sdk.Context) (states []types.LightClientState) {
+	k.IterateLightClientStates(ctx, func(state types.LightClientState) bool {
+		states = append(states, state)
+		return false
+	})
+	return
+}
+
+// VerifyIncomingSwap checks the proof carried by a msg creating an incoming swap of an asset that verifies them
+// against the light client state of the asset, which is advanced to the header of the proof. Outgoing swaps and
+// swaps of other assets are not checked.
+func (k Keeper) VerifyIncomingSwap(ctx sdk.Context, msg *types.MsgCreateAtomicSwap) error {
+	// Malformed amounts and unsupported assets are rejected when the swap is created
+	if len(msg.Amount) != 1 {
+		return nil
+	}
+	asset, err := k.GetAsset(ctx, msg.Amount[0].Denom)
+	if err != nil || !asset.VerifyIncoming || msg.From != asset.DeputyAddress {
+		return nil
+	}
+
+	if msg.Proof == nil {
+		return sdkerrors.Wrapf(types.ErrInvalidSwapProof, "incoming swaps of %s require a proof", asset.Denom)
+	}
+	if k.verifier == nil {
+		return sdkerrors.Wrap(types.ErrInvalidSwapProof, "no swap verifier is set")
+	}
+	trusted, found := k.GetLightClientState(ctx, asset.Denom)
+	if !found {
+		return sdkerrors.Wrap(types.ErrLightClientNotFound, asset.Denom)
+	}
+
+	state, err := k.verifier.VerifyHeader(trusted, msg.Proof.Header)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidSwapProof, "header: %s", err)
+	}
+	if state.ChainID != trusted.ChainID {
+		return sdkerrors.Wrapf(types.ErrInvalidSwapProof, "header of chain %s, expected %s", state.ChainID, trusted.ChainID)
+	}
+	if err := k.verifier.VerifyHTLC(state, *msg, msg.Proof.Proof); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidSwapProof, "htlc: %s", err)
+	}
+
+	// Proofs against older headers leave the light client at the latest header
+	if state.Height > trusted.Height {
+		state.Denom = trusted.Denom
+		k.SetLightClientState(ctx, state)
+	}
+	return nil
+}
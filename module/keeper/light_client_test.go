@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/bep3/module/keeper"
+	"github.com/e-money/bep3/module/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
+func (suite *AtomicSwapTestSuite) TestVerifyIncomingSwap() {
+	const chainID = "Binance-Chain-Tigris"
+	asset, err := suite.keeper.GetAsset(suite.ctx, BNB_DENOM)
+	suite.Require().NoError(err)
+	asset.VerifyIncoming = true
+	suite.keeper.SetAsset(suite.ctx, asset)
+	suite.keeper.SetLightClientState(suite.ctx, types.NewLightClientState(BNB_DENOM, chainID, 10, []byte{1}, nil))
+
+	newMsg := func(i int) *types.MsgCreateAtomicSwap {
+		return types.NewMsgCreateAtomicSwap(suite.deputy.String(), suite.addrs[1].String(), TestRecipientOtherChain,
+			TestSenderOtherChain, suite.randomNumberHashes[i], suite.timestamps[i], cs(c(BNB_DENOM, 50000)),
+			types.DefaultSwapTimeSpanMinutes)
+	}
+	// prove returns a proof of the HTLCs of the msgs at a header of the given chain and height
+	prove := func(chain string, height int64, msgs ...*types.MsgCreateAtomicSwap) []*types.SwapProof {
+		leaves := [][]byte{[]byte("other htlc")}
+		for _, msg := range msgs {
+			leaves = append(leaves, types.MockHTLCLeaf(*msg))
+		}
+		root, proofs := merkle.ProofsFromByteSlices(leaves)
+		state := types.NewLightClientState(BNB_DENOM, chain, height, root, nil)
+		header, err := state.Marshal()
+		suite.Require().NoError(err)
+
+		swapProofs := make([]*types.SwapProof, len(msgs))
+		for i := range msgs {
+			proof, err := proofs[i+1].ToProto().Marshal()
+			suite.Require().NoError(err)
+			swapProofs[i] = &types.SwapProof{Header: header, Proof: proof}
+		}
+		return swapProofs
+	}
+
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	create := func(msg *types.MsgCreateAtomicSwap) error {
+		_, err := msgServer.CreateAtomicSwap(sdk.WrapSDKContext(suite.ctx), msg)
+		return err
+	}
+
+	// Incoming swaps cannot be created without a proof, or without a verifier to check it
+	first, second := newMsg(0), newMsg(1)
+	suite.Require().ErrorIs(create(first), types.ErrInvalidSwapProof)
+	first.Proof = prove(chainID, 20, first)[0]
+	suite.Require().ErrorIs(create(first), types.ErrInvalidSwapProof)
+
+	k := suite.keeper
+	k.SetSwapVerifier(types.MockSwapVerifier{})
+	msgServer = keeper.NewMsgServerImpl(k)
+
+	// Proofs must come from the counterparty chain and include the HTLC of the swap
+	first.Proof = prove("other-chain", 20, first)[0]
+	suite.Require().ErrorIs(create(first), types.ErrInvalidSwapProof)
+	first.Proof = prove(chainID, 20, second)[0]
+	suite.Require().ErrorIs(create(first), types.ErrInvalidSwapProof)
+
+	// A proof of the HTLC does not pay another recipient
+	redirected := newMsg(0)
+	redirected.Proof = prove(chainID, 20, first)[0]
+	redirected.To = suite.addrs[2].String()
+	suite.Require().ErrorIs(create(redirected), types.ErrInvalidSwapProof)
+
+	first.Proof = prove(chainID, 20, first)[0]
+	suite.Require().NoError(create(first))
+	state, found := suite.keeper.GetLightClientState(suite.ctx, BNB_DENOM)
+	suite.Require().True(found)
+	suite.Equal(int64(20), state.Height)
+
+	// A proof against an older header is accepted without moving the light client back
+	second.Proof = prove(chainID, 15, second)[0]
+	suite.Require().NoError(create(second))
+	state, _ = suite.keeper.GetLightClientState(suite.ctx, BNB_DENOM)
+	suite.Equal(int64(20), state.Height)
+
+	// Outgoing swaps are not verified
+	amount := cs(c(BNB_DENOM, 50000))
+	suite.Require().NoError(suite.bankKeeper.MintCoins(suite.ctx, types.ModuleName, amount))
+	suite.Require().NoError(suite.bankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, suite.addrs[2], amount))
+	suite.Require().NoError(suite.keeper.IncrementCurrentAssetSupply(suite.ctx, amount[0]))
+	outgoing := types.NewMsgCreateAtomicSwap(suite.addrs[2].String(), suite.deputy.String(), TestRecipientOtherChain,
+		TestSenderOtherChain, suite.randomNumberHashes[2], suite.timestamps[2], amount, types.DefaultSwapTimeSpanMinutes)
+	suite.Require().NoError(create(outgoing))
+}
+
+func (suite *AtomicSwapTestSuite) TestSetLightClientStateProposal() {
+	state := types.NewLightClientState(BNB_DENOM, "Binance-Chain-Tigris", 10, []byte{1}, nil)
+	suite.Require().NoError(keeper.HandleSetLightClientStateProposal(suite.ctx, suite.keeper,
+		types.NewSetLightClientStateProposal("trust", "initial state", state)))
+	stored, found := suite.keeper.GetLightClientState(suite.ctx, BNB_DENOM)
+	suite.Require().True(found)
+	suite.Equal(state, stored)
+
+	// A passed proposal replaces the state, also with an older header
+	replaced := types.NewLightClientState(BNB_DENOM, "Binance-Chain-Tigris", 5, []byte{2}, []byte{3})
+	suite.Require().NoError(keeper.HandleSetLightClientStateProposal(suite.ctx, suite.keeper,
+		types.NewSetLightClientStateProposal("recover", "replaced state", replaced)))
+	stored, _ = suite.keeper.GetLightClientState(suite.ctx, BNB_DENOM)
+	suite.Equal(replaced, stored)
+
+	unsupported := types.NewLightClientState("xrp", "Ripple", 10, []byte{1}, nil)
+	err := keeper.HandleSetLightClientStateProposal(suite.ctx, suite.keeper,
+		types.NewSetLightClientStateProposal("trust", "unsupported asset", unsupported))
+	suite.Require().ErrorIs(err, types.ErrInvalidLightClientState)
+}
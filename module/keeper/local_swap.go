@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/e-money/bep3/module/types"
+)
+
+// CreateLocalSwapState escrows the amount of a swap between two accounts of this chain. Local swaps have no deputy
+// and may lock any denom, so they are not checked against the asset params and do not change the asset supplies.
+// They are claimed and refunded like any other swap.
+func (k Keeper) CreateLocalSwapState(ctx sdk.Context, sender, recipient sdk.AccAddress, msg types.MsgCreateLocalSwap) ([]byte, error) {
+	swapID := types.CalculateLocalSwapID(msg.RandomNumberHash, sender)
+	if _, found := k.GetAtomicSwap(ctx, swapID); found {
+		return nil, sdkerrors.Wrap(types.ErrAtomicSwapAlreadyExists, hex.EncodeToString(swapID))
+	}
+	if err := k.ValidateNotDenied(ctx, sender.String(), recipient.String()); err != nil {
+		return nil, err
+	}
+	if k.Maccs[recipient.String()] {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is a module account", recipient)
+	}
+	if err := validateSwapTimestamp(ctx, msg.Timestamp); err != nil {
+		return nil, err
+	}
+	if msg.TimeSpanMin < 1 || msg.TimeSpanMin > types.ThreeDayMinutes {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidTimeSpan, "minutes span %d outside range [%d, %d]", msg.TimeSpanMin, 1, types.ThreeDayMinutes)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	expireTime := ctx.BlockTime().Add(time.Duration(msg.TimeSpanMin) * time.Minute)
+	atomicSwap := types.NewAtomicSwap(msg.Amount, msg.RandomNumberHash, expireTime.Unix(), msg.Timestamp, sender,
+		recipient, "", "", 0, types.Open, false, types.Local)
+	atomicSwap.Memo = msg.Memo
+
+	k.SetAtomicSwap(ctx, atomicSwap)
+	k.InsertIntoByTimestamp(ctx, atomicSwap)
+	k.recordSwapCreated(ctx, atomicSwap)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateAtomicSwap,
+			sdk.NewAttribute(types.AttributeKeySender, atomicSwap.Sender),
+			sdk.NewAttribute(types.AttributeKeyRecipient, atomicSwap.Recipient),
+			sdk.NewAttribute(types.AttributeKeyAtomicSwapID, hex.EncodeToString(swapID)),
+			sdk.NewAttribute(types.AttributeKeyRandomNumberHash, hex.EncodeToString(atomicSwap.RandomNumberHash)),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, fmt.Sprintf("%d", atomicSwap.Timestamp)),
+			sdk.NewAttribute(types.AttributeKeyExpireTimestamp, fmt.Sprintf("%d", atomicSwap.ExpireTimestamp)),
+			sdk.NewAttribute(types.AttributeKeyAmount, atomicSwap.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDirection, atomicSwap.Direction.String()),
+			sdk.NewAttribute(types.AttributeKeyMemo, atomicSwap.Memo),
+		),
+	)
+
+	return swapID, nil
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	bep3 "github.com/e-money/bep3/module"
+	"github.com/e-money/bep3/module/keeper"
+	"github.com/e-money/bep3/module/types"
+)
+
+func (suite *AtomicSwapTestSuite) TestLocalSwapPair() {
+	const otherDenom = "ungm"
+	alice, bob := suite.addrs[1], suite.addrs[2]
+	suite.Require().NoError(suite.bankKeeper.MintCoins(suite.ctx, types.ModuleName, cs(c(otherDenom, 1000))))
+	suite.Require().NoError(suite.bankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, bob, cs(c(otherDenom, 1000))))
+	supplyBefore, _ := suite.keeper.GetAssetSupply(suite.ctx, BNB_DENOM)
+
+	// Alice locks bnb for Bob, and Bob locks a denom without asset params for Alice under the same hash
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	hash, timestamp := suite.randomNumberHashes[0], suite.timestamps[0]
+	_, err := msgServer.CreateLocalSwap(sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgCreateLocalSwap(alice.String(), bob.String(), hash, timestamp, cs(c(BNB_DENOM, 50000)), 120))
+	suite.Require().NoError(err)
+	_, err = msgServer.CreateLocalSwap(sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgCreateLocalSwap(bob.String(), alice.String(), hash, timestamp, cs(c(otherDenom, 400)), 60))
+	suite.Require().NoError(err)
+	_, err = msgServer.CreateLocalSwap(sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgCreateLocalSwap(bob.String(), alice.String(), hash, timestamp, cs(c(otherDenom, 400)), 60))
+	suite.Require().ErrorIs(err, types.ErrAtomicSwapAlreadyExists)
+
+	aliceSwapID := types.CalculateLocalSwapID(hash, alice)
+	bobSwapID := types.CalculateLocalSwapID(hash, bob)
+	aliceSwap, found := suite.keeper.GetAtomicSwap(suite.ctx, aliceSwapID)
+	suite.Require().True(found)
+	suite.Equal(types.Local, aliceSwap.Direction)
+	suite.Require().NoError(aliceSwap.Validate())
+
+	// Alice claims Bob's swap, revealing the random number that Bob claims her swap with
+	_, err = suite.keeper.ClaimAtomicSwapState(suite.ctx, alice, bobSwapID, suite.randomNumbers[0], nil)
+	suite.Require().NoError(err)
+	_, err = suite.keeper.ClaimAtomicSwapState(suite.ctx, bob, aliceSwapID, suite.randomNumbers[0], nil)
+	suite.Require().NoError(err)
+
+	suite.Equal(sdk.NewInt(STARING_BNB_BALANCE-50000), suite.bankKeeper.GetBalance(suite.ctx, alice, BNB_DENOM).Amount)
+	suite.Equal(sdk.NewInt(400), suite.bankKeeper.GetBalance(suite.ctx, alice, otherDenom).Amount)
+	suite.Equal(sdk.NewInt(STARING_BNB_BALANCE+50000), suite.bankKeeper.GetBalance(suite.ctx, bob, BNB_DENOM).Amount)
+	suite.Equal(sdk.NewInt(600), suite.bankKeeper.GetBalance(suite.ctx, bob, otherDenom).Amount)
+
+	supplyAfter, _ := suite.keeper.GetAssetSupply(suite.ctx, BNB_DENOM)
+	suite.Equal(supplyBefore, supplyAfter)
+	_, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.False(broken)
+}
+
+func (suite *AtomicSwapTestSuite) TestLocalSwapRefund() {
+	alice, bob := suite.addrs[1], suite.addrs[2]
+	supplyBefore, _ := suite.keeper.GetAssetSupply(suite.ctx, BNB_DENOM)
+
+	msg := types.NewMsgCreateLocalSwap(alice.String(), bob.String(), suite.randomNumberHashes[0], suite.timestamps[0],
+		cs(c(BNB_DENOM, 50000)), 60)
+	swapID, err := suite.keeper.CreateLocalSwapState(suite.ctx, alice, bob, *msg)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewInt(STARING_BNB_BALANCE-50000), suite.bankKeeper.GetBalance(suite.ctx, alice, BNB_DENOM).Amount)
+	_, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.False(broken)
+
+	// Expired swaps are refunded to the sender
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(61 * time.Minute))
+	bep3.BeginBlocker(ctx, suite.keeper)
+	_, err = suite.keeper.ClaimAtomicSwapState(ctx, bob, swapID, suite.randomNumbers[0], nil)
+	suite.Require().ErrorIs(err, types.ErrSwapNotClaimable)
+	_, err = suite.keeper.RefundAtomicSwapState(ctx, bob, swapID)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewInt(STARING_BNB_BALANCE), suite.bankKeeper.GetBalance(ctx, alice, BNB_DENOM).Amount)
+
+	supplyAfter, _ := suite.keeper.GetAssetSupply(ctx, BNB_DENOM)
+	suite.Equal(supplyBefore, supplyAfter)
+	_, broken = keeper.AllInvariants(suite.keeper)(ctx)
+	suite.False(broken)
+
+	// Module accounts cannot receive local swaps
+	msg = types.NewMsgCreateLocalSwap(alice.String(), suite.randMacc.String(), suite.randomNumberHashes[1],
+		suite.timestamps[1], cs(c(BNB_DENOM, 50000)), 60)
+	_, err = suite.keeper.CreateLocalSwapState(suite.ctx, alice, suite.randMacc, *msg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
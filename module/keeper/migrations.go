@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/bep3/module/types"
+)
+
+// MigrateLongtermStorage upgrades state written by module versions that keyed the longterm storage index by
+// deletion height. It sets the default LongtermStorageRetention param if it is missing and re-keys the index by
+// close time. Swaps closed before the migration did not record their close time and are given the migration's
+// block time, so they are retained for at least one full retention period from the upgrade.
+// It is run by the upgrade handler returned by bep3.NewLongtermStorageUpgradeHandler.
+func (k Keeper) MigrateLongtermStorage(ctx sdk.Context) {
+	if !k.paramSubspace.Has(ctx, types.KeyLongtermStorageRetention) {
+		k.paramSubspace.Set(ctx, types.KeyLongtermStorageRetention, types.DefaultLongtermStorageRetention)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapLongtermStoragePrefix)
+
+	var legacyKeys [][]byte
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		if len(iterator.Key()) == types.LegacyLongtermStorageKeyLength {
+			legacyKeys = append(legacyKeys, iterator.Key())
+		}
+	}
+	iterator.Close()
+
+	for _, key := range legacyKeys {
+		store.Delete(key)
+
+		swap, found := k.GetAtomicSwap(ctx, key[types.LegacyLongtermStorageKeyLength-types.SwapIDLength:])
+		if !found {
+			continue
+		}
+		if swap.ClosedTime == 0 {
+			swap.ClosedTime = ctx.BlockTime().Unix()
+			k.SetAtomicSwap(ctx, swap)
+		}
+		k.InsertIntoLongtermStorage(ctx, swap)
+	}
+}
+
+// MigrateRandomNumberHashIndex indexes the swaps written by module versions without the byRandomNumberHash index,
+// which is otherwise only filled as swaps are saved. Swaps that are already indexed are indexed again under the same
+// key. It is run by the upgrade handler returned by bep3.NewLongtermStorageUpgradeHandler.
+func (k Keeper) MigrateRandomNumberHashIndex(ctx sdk.Context) {
+	var swaps []types.AtomicSwap
+	k.IterateAtomicSwaps(ctx, func(atomicSwap types.AtomicSwap) bool {
+		swaps = append(swaps, atomicSwap)
+		return false
+	})
+
+	for _, swap := range swaps {
+		k.InsertIntoByRandomNumberHash(ctx, swap)
+	}
+}
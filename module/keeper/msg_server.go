@@ -7,16 +7,23 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/e-money/bep3/module/types"
 	"strconv"
+	"time"
 )
 
 var _ types.MsgServer = msgServer{}
 
 type bep3Keeper interface {
-	CreateAtomicSwapState(ctx sdk.Context, randomNumberHash []byte, timestamp, swapTimeSpanMin int64,
-		sender, recipient sdk.AccAddress, senderOtherChain, recipientOtherChain string, amount sdk.Coins,
-		crossChain bool) (*sdk.Result, error)
-	ClaimAtomicSwapState(ctx sdk.Context, from sdk.AccAddress, swapID []byte, randomNumber []byte) (*sdk.Result, error)
+	CreateAtomicSwapState(ctx sdk.Context, sender, recipient sdk.AccAddress, msg types.MsgCreateAtomicSwap) (*sdk.Result, error)
+	VerifyIncomingSwap(ctx sdk.Context, msg *types.MsgCreateAtomicSwap) error
+	ClaimAtomicSwapState(ctx sdk.Context, from sdk.AccAddress, swapID []byte, randomNumber []byte, amount sdk.Coins) (*sdk.Result, error)
 	RefundAtomicSwapState(ctx sdk.Context, from sdk.AccAddress, swapID []byte) (*sdk.Result, error)
+	BatchClaimAtomicSwapsState(ctx sdk.Context, from sdk.AccAddress, claims []types.BatchClaimItem, atomic bool) ([]types.BatchItemResult, error)
+	BatchRefundAtomicSwapsState(ctx sdk.Context, from sdk.AccAddress, swapIDs [][]byte, atomic bool) ([]types.BatchItemResult, error)
+	AnnotateSwapState(ctx sdk.Context, from sdk.AccAddress, swapID []byte, otherChainTxHash string) error
+	DeputyBondState(ctx sdk.Context, from sdk.AccAddress, amount sdk.Coins) error
+	DeputyUnbondState(ctx sdk.Context, from sdk.AccAddress, amount sdk.Coins) (time.Time, error)
+	CreateInterchainSwapState(ctx sdk.Context, sender sdk.AccAddress, msg types.MsgCreateInterchainSwap) ([]byte, error)
+	CreateLocalSwapState(ctx sdk.Context, sender, recipient sdk.AccAddress, msg types.MsgCreateLocalSwap) ([]byte, error)
 }
 
 type msgServer struct {
@@ -27,7 +34,7 @@ func NewMsgServerImpl(keeper bep3Keeper) types.MsgServer {
 	return &msgServer{k: keeper}
 }
 
-func (m msgServer)CreateAtomicSwap(goCtx context.Context, msg *types.MsgCreateAtomicSwap)(*types.MsgCreateAtomicSwapResponse, error) {
+func (m msgServer) CreateAtomicSwap(goCtx context.Context, msg *types.MsgCreateAtomicSwap) (*types.MsgCreateAtomicSwapResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	fromAcc, err := sdk.AccAddressFromBech32(msg.From)
 	if err != nil {
@@ -37,8 +44,10 @@ func (m msgServer)CreateAtomicSwap(goCtx context.Context, msg *types.MsgCreateAt
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "to")
 	}
-	res, err := m.k.CreateAtomicSwapState(ctx, msg.RandomNumberHash, msg.Timestamp,
-		msg.TimeSpanMin, fromAcc, toAcc, msg.SenderOtherChain, msg.RecipientOtherChain, msg.Amount, true)
+	if err := m.k.VerifyIncomingSwap(ctx, msg); err != nil {
+		return nil, err
+	}
+	res, err := m.k.CreateAtomicSwapState(ctx, fromAcc, toAcc, *msg)
 	if err != nil {
 		return nil, err
 	}
@@ -53,14 +62,14 @@ func (m msgServer)CreateAtomicSwap(goCtx context.Context, msg *types.MsgCreateAt
 	}, nil
 }
 
-func (m msgServer)ClaimAtomicSwap(goCtx context.Context, msg *types.MsgClaimAtomicSwap)(*types.MsgClaimAtomicSwapResponse, error) {
+func (m msgServer) ClaimAtomicSwap(goCtx context.Context, msg *types.MsgClaimAtomicSwap) (*types.MsgClaimAtomicSwapResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	fromAcc, err := sdk.AccAddressFromBech32(msg.From)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender")
 	}
 
-	res, err := m.k.ClaimAtomicSwapState(ctx, fromAcc, msg.SwapID, msg.RandomNumber)
+	res, err := m.k.ClaimAtomicSwapState(ctx, fromAcc, msg.SwapID, msg.RandomNumber, msg.Amount)
 	if err != nil {
 		return nil, err
 	}
@@ -77,7 +86,7 @@ func (m msgServer)ClaimAtomicSwap(goCtx context.Context, msg *types.MsgClaimAtom
 	}, nil
 }
 
-func (m msgServer)RefundAtomicSwap(goCtx context.Context, msg *types.MsgRefundAtomicSwap)(*types.MsgRefundAtomicSwapResponse, error) {
+func (m msgServer) RefundAtomicSwap(goCtx context.Context, msg *types.MsgRefundAtomicSwap) (*types.MsgRefundAtomicSwapResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	fromAcc, err := sdk.AccAddressFromBech32(msg.From)
@@ -100,4 +109,123 @@ func (m msgServer)RefundAtomicSwap(goCtx context.Context, msg *types.MsgRefundAt
 		RandomNumberHash: hex.EncodeToString(res.Data),
 		Timestamp:        int64(timestamp),
 	}, nil
-}
\ No newline at end of file
+}
+
+func (m msgServer) BatchClaimAtomicSwaps(goCtx context.Context, msg *types.MsgBatchClaimAtomicSwaps) (*types.MsgBatchClaimAtomicSwapsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	fromAcc, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender")
+	}
+
+	results, err := m.k.BatchClaimAtomicSwapsState(ctx, fromAcc, msg.Claims, msg.Atomic)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBatchClaimAtomicSwapsResponse{Results: results}, nil
+}
+
+func (m msgServer) BatchRefundAtomicSwaps(goCtx context.Context, msg *types.MsgBatchRefundAtomicSwaps) (*types.MsgBatchRefundAtomicSwapsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	fromAcc, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender")
+	}
+
+	swapIDs := make([][]byte, len(msg.SwapIDs))
+	for i, swapID := range msg.SwapIDs {
+		swapIDs[i] = swapID
+	}
+
+	results, err := m.k.BatchRefundAtomicSwapsState(ctx, fromAcc, swapIDs, msg.Atomic)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBatchRefundAtomicSwapsResponse{Results: results}, nil
+}
+
+func (m msgServer) AnnotateSwap(goCtx context.Context, msg *types.MsgAnnotateSwap) (*types.MsgAnnotateSwapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	fromAcc, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender")
+	}
+
+	if err := m.k.AnnotateSwapState(ctx, fromAcc, msg.SwapID, msg.OtherChainTxHash); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAnnotateSwapResponse{}, nil
+}
+
+func (m msgServer) DeputyBond(goCtx context.Context, msg *types.MsgDeputyBond) (*types.MsgDeputyBondResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	fromAcc, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender")
+	}
+
+	if err := m.k.DeputyBondState(ctx, fromAcc, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeputyBondResponse{}, nil
+}
+
+func (m msgServer) DeputyUnbond(goCtx context.Context, msg *types.MsgDeputyUnbond) (*types.MsgDeputyUnbondResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	fromAcc, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender")
+	}
+
+	completionTime, err := m.k.DeputyUnbondState(ctx, fromAcc, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeputyUnbondResponse{CompletionTime: completionTime}, nil
+}
+
+func (m msgServer) CreateInterchainSwap(goCtx context.Context, msg *types.MsgCreateInterchainSwap) (*types.MsgCreateInterchainSwapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	fromAcc, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender")
+	}
+
+	swapID, err := m.k.CreateInterchainSwapState(ctx, fromAcc, *msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateInterchainSwapResponse{SwapID: hex.EncodeToString(swapID)}, nil
+}
+
+func (m msgServer) CreateLocalSwap(goCtx context.Context, msg *types.MsgCreateLocalSwap) (*types.MsgCreateLocalSwapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	fromAcc, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender")
+	}
+	toAcc, err := sdk.AccAddressFromBech32(msg.To)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "recipient")
+	}
+
+	swapID, err := m.k.CreateLocalSwapState(ctx, fromAcc, toAcc, *msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateLocalSwapResponse{SwapID: hex.EncodeToString(swapID)}, nil
+}
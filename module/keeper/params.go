@@ -6,9 +6,11 @@ import (
 	"github.com/e-money/bep3/module/types"
 )
 
-// GetParams returns the total set of bep3 parameters.
+// GetParams returns the total set of bep3 parameters. The params are read one by one rather than as a param set, so
+// that chains whose params predate the retention param get the default retention until it is migrated.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSubspace.GetParamSet(ctx, &params)
+	k.paramSubspace.Get(ctx, types.KeyAssetParams, &params.AssetParams)
+	params.LongtermStorageRetention = k.GetLongtermStorageRetention(ctx)
 	return params
 }
 
@@ -17,6 +19,14 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSubspace.SetParamSet(ctx, &params)
 }
 
+// GetLongtermStorageRetention returns how long closed swaps are kept before they are deleted. Chains whose params
+// predate the retention param keep closed swaps for the default retention until it is migrated.
+func (k Keeper) GetLongtermStorageRetention(ctx sdk.Context) types.LongtermStorageRetention {
+	retention := types.DefaultLongtermStorageRetention
+	k.paramSubspace.GetIfExists(ctx, types.KeyLongtermStorageRetention, &retention)
+	return retention
+}
+
 // ------------------------------------------
 //				Asset
 // ------------------------------------------
@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bep3 "github.com/e-money/bep3/module"
+	"github.com/e-money/bep3/module/keeper"
+	"github.com/e-money/bep3/module/types"
+)
+
+func (suite *AtomicSwapTestSuite) TestPartialClaimsIncoming() {
+	recipient := suite.addrs[1]
+	_, err := suite.keeper.CreateAtomicSwapState(suite.ctx, suite.deputy, recipient, types.MsgCreateAtomicSwap{
+		From:                suite.deputy.String(),
+		To:                  recipient.String(),
+		RecipientOtherChain: TestRecipientOtherChain,
+		SenderOtherChain:    TestSenderOtherChain,
+		RandomNumberHash:    suite.randomNumberHashes[0],
+		Timestamp:           suite.timestamps[0],
+		Amount:              cs(c(BNB_DENOM, 50000)),
+		TimeSpanMin:         types.DefaultSwapTimeSpanMinutes,
+		PartialClaims:       true,
+	})
+	suite.Require().NoError(err)
+	swapID := types.CalculateSwapID(suite.randomNumberHashes[0], suite.deputy, TestSenderOtherChain)
+	supplyBefore, _ := suite.keeper.GetAssetSupply(suite.ctx, BNB_DENOM)
+
+	// Claims cannot exceed the remaining amount or be made in another denom
+	_, err = suite.keeper.ClaimAtomicSwapState(suite.ctx, recipient, swapID, suite.randomNumbers[0], cs(c(BNB_DENOM, 50001)))
+	suite.Require().ErrorIs(err, types.ErrInvalidPartialClaim)
+	_, err = suite.keeper.ClaimAtomicSwapState(suite.ctx, recipient, swapID, suite.randomNumbers[0], cs(c(OTHER_DENOM, 100)))
+	suite.Require().ErrorIs(err, types.ErrInvalidPartialClaim)
+
+	_, err = suite.keeper.ClaimAtomicSwapState(suite.ctx, recipient, swapID, suite.randomNumbers[0], cs(c(BNB_DENOM, 20000)))
+	suite.Require().NoError(err)
+
+	swap, found := suite.keeper.GetAtomicSwap(suite.ctx, swapID)
+	suite.Require().True(found)
+	suite.Equal(types.Open, swap.Status)
+	suite.Equal(cs(c(BNB_DENOM, 30000)), swap.RemainingAmount)
+	suite.Require().NoError(swap.Validate())
+	suite.Equal(sdk.NewInt(STARING_BNB_BALANCE+20000), suite.bankKeeper.GetBalance(suite.ctx, recipient, BNB_DENOM).Amount)
+
+	// The supplies move by the claimed amount only
+	supply, _ := suite.keeper.GetAssetSupply(suite.ctx, BNB_DENOM)
+	suite.Equal(supplyBefore.IncomingSupply.Sub(c(BNB_DENOM, 20000)), supply.IncomingSupply)
+	suite.Equal(supplyBefore.CurrentSupply.Add(c(BNB_DENOM, 20000)), supply.CurrentSupply)
+	_, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.False(broken)
+
+	// Claiming without an amount claims the remaining amount and completes the swap
+	_, err = suite.keeper.ClaimAtomicSwapState(suite.ctx, recipient, swapID, suite.randomNumbers[0], nil)
+	suite.Require().NoError(err)
+
+	swap, _ = suite.keeper.GetAtomicSwap(suite.ctx, swapID)
+	suite.Equal(types.Completed, swap.Status)
+	suite.Empty(swap.RemainingAmount)
+	suite.Equal(sdk.NewInt(STARING_BNB_BALANCE+50000), suite.bankKeeper.GetBalance(suite.ctx, recipient, BNB_DENOM).Amount)
+
+	supply, _ = suite.keeper.GetAssetSupply(suite.ctx, BNB_DENOM)
+	suite.True(supply.IncomingSupply.IsZero())
+	suite.Equal(supplyBefore.CurrentSupply.Add(c(BNB_DENOM, 50000)), supply.CurrentSupply)
+	_, broken = keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.False(broken)
+}
+
+func (suite *AtomicSwapTestSuite) TestPartialClaimsRefundRemainder() {
+	// The sender's coins are minted, so that the bank's total supply covers the burn of the claimed part
+	sender := suite.addrs[6]
+	suite.Require().NoError(suite.bankKeeper.MintCoins(suite.ctx, types.ModuleName, cs(c(BNB_DENOM, 50000))))
+	suite.Require().NoError(suite.bankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, cs(c(BNB_DENOM, 50000))))
+	suite.Require().NoError(suite.keeper.IncrementCurrentAssetSupply(suite.ctx, c(BNB_DENOM, 50000)))
+	_, err := suite.keeper.CreateAtomicSwapState(suite.ctx, sender, suite.deputy, types.MsgCreateAtomicSwap{
+		From:                sender.String(),
+		To:                  suite.deputy.String(),
+		RecipientOtherChain: TestRecipientOtherChain,
+		SenderOtherChain:    TestSenderOtherChain,
+		RandomNumberHash:    suite.randomNumberHashes[0],
+		Timestamp:           suite.timestamps[0],
+		Amount:              cs(c(BNB_DENOM, 50000)),
+		TimeSpanMin:         types.DefaultSwapTimeSpanMinutes,
+		PartialClaims:       true,
+	})
+	suite.Require().NoError(err)
+	swapID := types.CalculateSwapID(suite.randomNumberHashes[0], sender, TestSenderOtherChain)
+
+	_, err = suite.keeper.ClaimAtomicSwapState(suite.ctx, suite.deputy, swapID, suite.randomNumbers[0], cs(c(BNB_DENOM, 10000)))
+	suite.Require().NoError(err)
+	supply, _ := suite.keeper.GetAssetSupply(suite.ctx, BNB_DENOM)
+	suite.Equal(c(BNB_DENOM, 40000), supply.OutgoingSupply)
+	suite.Equal(c(BNB_DENOM, 40000), supply.CurrentSupply)
+
+	// Once expired, only the remaining amount is refunded to the sender
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Duration(types.DefaultSwapTimeSpanMinutes+1) * time.Minute))
+	bep3.BeginBlocker(ctx, suite.keeper)
+	_, err = suite.keeper.ClaimAtomicSwapState(ctx, suite.deputy, swapID, suite.randomNumbers[0], cs(c(BNB_DENOM, 10000)))
+	suite.Require().ErrorIs(err, types.ErrSwapNotClaimable)
+	_, err = suite.keeper.RefundAtomicSwapState(ctx, sender, swapID)
+	suite.Require().NoError(err)
+
+	suite.Equal(sdk.NewInt(STARING_BNB_BALANCE+40000), suite.bankKeeper.GetBalance(ctx, sender, BNB_DENOM).Amount)
+	supply, _ = suite.keeper.GetAssetSupply(ctx, BNB_DENOM)
+	suite.True(supply.OutgoingSupply.IsZero())
+	suite.Equal(c(BNB_DENOM, 40000), supply.CurrentSupply)
+	_, broken := keeper.AllInvariants(suite.keeper)(ctx)
+	suite.False(broken)
+}
+
+func (suite *AtomicSwapTestSuite) TestPartialClaimsNotAllowed() {
+	recipient := suite.addrs[1]
+	_, err := suite.keeper.CreateAtomicSwapState(suite.ctx, suite.deputy, recipient, types.MsgCreateAtomicSwap{
+		From:                suite.deputy.String(),
+		To:                  recipient.String(),
+		RecipientOtherChain: TestRecipientOtherChain,
+		SenderOtherChain:    TestSenderOtherChain,
+		RandomNumberHash:    suite.randomNumberHashes[0],
+		Timestamp:           suite.timestamps[0],
+		Amount:              cs(c(BNB_DENOM, 50000)),
+		TimeSpanMin:         types.DefaultSwapTimeSpanMinutes,
+	})
+	suite.Require().NoError(err)
+	swapID := types.CalculateSwapID(suite.randomNumberHashes[0], suite.deputy, TestSenderOtherChain)
+
+	// Swaps created without partial claims are claimed in full
+	_, err = suite.keeper.ClaimAtomicSwapState(suite.ctx, recipient, swapID, suite.randomNumbers[0], cs(c(BNB_DENOM, 20000)))
+	suite.Require().ErrorIs(err, types.ErrInvalidPartialClaim)
+	swap, _ := suite.keeper.GetAtomicSwap(suite.ctx, swapID)
+	suite.Equal(types.Open, swap.Status)
+	suite.Empty(swap.RemainingAmount)
+
+	_, err = suite.keeper.CreateAtomicSwapState(suite.ctx, suite.deputy, recipient, types.MsgCreateAtomicSwap{
+		From:                suite.deputy.String(),
+		To:                  recipient.String(),
+		RecipientOtherChain: TestRecipientOtherChain,
+		SenderOtherChain:    TestSenderOtherChain,
+		RandomNumberHash:    suite.randomNumberHashes[1],
+		Timestamp:           suite.timestamps[1],
+		Amount:              cs(c(BNB_DENOM, 50000)),
+		TimeSpanMin:         types.DefaultSwapTimeSpanMinutes,
+		ClaimTip:            cs(c(BNB_DENOM, 100)),
+		PartialClaims:       true,
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidPartialClaim)
+}
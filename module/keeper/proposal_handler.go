@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/e-money/bep3/module/types"
+)
+
+// HandleUpdateDenyListProposal is a handler for executing a passed deny list update proposal
+func HandleUpdateDenyListProposal(ctx sdk.Context, k Keeper, p *types.UpdateDenyListProposal) error {
+	for _, address := range p.Add {
+		k.SetDeniedAddress(ctx, address)
+	}
+	for _, address := range p.Remove {
+		k.RemoveDeniedAddress(ctx, address)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateDenyList,
+			sdk.NewAttribute(types.AttributeKeyDenied, strings.Join(p.Add, ",")),
+			sdk.NewAttribute(types.AttributeKeyAllowed, strings.Join(p.Remove, ",")),
+		),
+	)
+	return nil
+}
+
+// HandleDeputySlashProposal is a handler for executing a passed deputy slash proposal
+func HandleDeputySlashProposal(ctx sdk.Context, k Keeper, p *types.DeputySlashProposal) error {
+	swap, found := k.GetAtomicSwap(ctx, p.SwapID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrAtomicSwapNotFound, "%s", p.SwapID)
+	}
+	_, err := k.SlashDeputy(ctx, swap, types.AttributeValueSlashReasonEvidence)
+	return err
+}
+
+// HandleSetLightClientStateProposal is a handler for executing a passed light client state proposal. It replaces
+// the light client state of the asset, e.g. to recover a light client that can no longer follow the counterparty chain.
+func HandleSetLightClientStateProposal(ctx sdk.Context, k Keeper, p *types.SetLightClientStateProposal) error {
+	if _, err := k.GetAsset(ctx, p.State.Denom); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidLightClientState, err.Error())
+	}
+	k.SetLightClientState(ctx, p.State)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetLightClient,
+			sdk.NewAttribute(types.AttributeKeyDenom, p.State.Denom),
+			sdk.NewAttribute(types.AttributeKeyChainID, p.State.ChainID),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(p.State.Height, 10)),
+		),
+	)
+	return nil
+}
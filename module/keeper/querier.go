@@ -23,6 +23,8 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryAtomicSwaps(ctx, req, keeper)
 		case types.QueryGetParams:
 			return queryGetParams(ctx, req, keeper)
+		case types.QueryGetDenyList:
+			return queryGetDenyList(ctx, req, keeper)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint", types.ModuleName)
 		}
@@ -127,6 +129,17 @@ func queryGetParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]by
 	return bz, nil
 }
 
+// query the addresses on the bep3 deny list
+func queryGetDenyList(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	denyList := keeper.GetDenyList(ctx)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc.LegacyAmino, denyList)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
 // filterAtomicSwaps retrieves atomic swaps filtered by a given set of params.
 // If no filters are provided, all atomic swaps will be returned in paginated form.
 func filterAtomicSwaps(ctx sdk.Context, swaps types.AtomicSwaps, params types.QueryAtomicSwaps) types.AtomicSwaps {
@@ -12,6 +12,7 @@ import (
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	"strings"
 	"testing"
+	"time"
 )
 
 const (
@@ -63,8 +64,16 @@ func (suite *QuerierTestSuite) SetupTest() {
 		randomNumberHash := types.CalculateRandomHash(randomNumber[:], timestamp)
 
 		// Create atomic swap and check err
-		_, err := suite.keeper.CreateAtomicSwapState(suite.ctx, randomNumberHash, timestamp, expireTimestamp,
-			addrs[10], suite.addrs[i], TestSenderOtherChain, TestRecipientOtherChain, amount, true)
+		_, err := suite.keeper.CreateAtomicSwapState(suite.ctx, addrs[10], suite.addrs[i], types.MsgCreateAtomicSwap{
+			From:                addrs[10].String(),
+			To:                  suite.addrs[i].String(),
+			RecipientOtherChain: TestRecipientOtherChain,
+			SenderOtherChain:    TestSenderOtherChain,
+			RandomNumberHash:    randomNumberHash,
+			Timestamp:           timestamp,
+			Amount:              amount,
+			TimeSpanMin:         expireTimestamp,
+		})
 		suite.Nil(err)
 
 		// Calculate swap ID and save
@@ -187,6 +196,140 @@ func (suite *QuerierTestSuite) TestQueryParams() {
 	suite.Equal(gs.Params, p)
 }
 
+func (suite *QuerierTestSuite) TestStats() {
+	ctx := sdk.WrapSDKContext(suite.ctx.WithIsCheckTx(false))
+
+	res, err := suite.keeper.Stats(ctx, &types.QueryStatsRequest{Denom: "bnb", Daily: true})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Stats, 1)
+	suite.Equal(uint64(len(suite.swapIDs)), res.Stats[0].Created)
+	suite.Require().Len(res.DailyStats, 1)
+	suite.Equal(types.GetDayStart(suite.ctx.BlockTime()), res.DailyStats[0].Day)
+	suite.Equal(res.Stats[0], res.DailyStats[0].Stats)
+
+	// Buckets outside the requested range and all buckets without daily are left out
+	res, err = suite.keeper.Stats(ctx, &types.QueryStatsRequest{Daily: true, StartTime: res.DailyStats[0].Day + 1})
+	suite.Require().NoError(err)
+	suite.Len(res.Stats, 1)
+	suite.Empty(res.DailyStats)
+	res, err = suite.keeper.Stats(ctx, &types.QueryStatsRequest{})
+	suite.Require().NoError(err)
+	suite.Empty(res.DailyStats)
+
+	// Assets without swaps have empty stats
+	res, err = suite.keeper.Stats(ctx, &types.QueryStatsRequest{Denom: "inc"})
+	suite.Require().NoError(err)
+	suite.Equal(types.NewSwapStats("inc"), res.Stats[0])
+
+	_, err = suite.keeper.Stats(ctx, &types.QueryStatsRequest{StartTime: 10, EndTime: 5})
+	suite.Error(err)
+}
+
+func (suite *QuerierTestSuite) TestDeputyHealth() {
+	ctx := sdk.WrapSDKContext(suite.ctx.WithIsCheckTx(false))
+
+	// The incoming swaps created in setup were created by the deputy
+	res, err := suite.keeper.DeputyHealth(ctx, &types.QueryDeputyHealthRequest{Denom: "bnb"})
+	suite.Require().NoError(err)
+	suite.Equal("bnb", res.Health.Denom)
+	suite.Equal(suite.ctx.BlockHeight(), res.Health.LastActiveHeight)
+	suite.Equal(int64(0), res.Health.InactiveBlocks)
+	suite.False(res.Health.Suspended)
+
+	all, err := suite.keeper.DeputiesHealth(ctx, &types.QueryDeputiesHealthRequest{})
+	suite.Require().NoError(err)
+	assets, _ := suite.keeper.GetAssets(suite.ctx)
+	suite.Len(all.Health, len(assets))
+
+	_, err = suite.keeper.DeputyHealth(ctx, &types.QueryDeputyHealthRequest{Denom: "unknown"})
+	suite.Error(err)
+}
+
+func (suite *QuerierTestSuite) TestDeputyBond() {
+	ctx := sdk.WrapSDKContext(suite.ctx.WithIsCheckTx(false))
+	deputy := suite.addrs[10].String()
+
+	res, err := suite.keeper.DeputyBond(ctx, &types.QueryDeputyBondRequest{DeputyAddress: deputy})
+	suite.Require().NoError(err)
+	suite.True(res.Bond.Amount.Empty())
+	suite.Empty(res.Unbondings)
+
+	completionTime := suite.ctx.BlockTime().Add(time.Hour)
+	suite.keeper.SetDeputyBond(suite.ctx, types.NewDeputyBond(deputy, cs(c("ungm", 1000))))
+	suite.keeper.SetDeputyUnbonding(suite.ctx, types.NewDeputyUnbonding(deputy, cs(c("ungm", 500)), completionTime))
+
+	res, err = suite.keeper.DeputyBond(ctx, &types.QueryDeputyBondRequest{DeputyAddress: deputy})
+	suite.Require().NoError(err)
+	suite.Equal(cs(c("ungm", 1000)), res.Bond.Amount)
+	suite.Require().Len(res.Unbondings, 1)
+	suite.True(completionTime.Equal(res.Unbondings[0].CompletionTime))
+
+	_, err = suite.keeper.DeputyBond(ctx, &types.QueryDeputyBondRequest{DeputyAddress: "invalid"})
+	suite.Error(err)
+}
+
+func (suite *QuerierTestSuite) TestSimulateCreateSwap() {
+	ctx := sdk.WrapSDKContext(suite.ctx.WithIsCheckTx(false))
+	deputy := suite.addrs[10]
+	timestamp := ts(0)
+	randomNumber, _ := types.GenerateSecureRandomNumber()
+	randomNumberHash := types.CalculateRandomHash(randomNumber, timestamp)
+	supplyBefore, _ := suite.keeper.GetAssetSupply(suite.ctx, "bnb")
+
+	msg := types.NewMsgCreateAtomicSwap(deputy.String(), suite.addrs[0].String(), TestRecipientOtherChain,
+		TestSenderOtherChain, randomNumberHash, timestamp, cs(c("bnb", 100)), types.DefaultSwapTimeSpanMinutes)
+	res, err := suite.keeper.SimulateCreateSwap(ctx, &types.QuerySimulateCreateSwapRequest{Msg: msg})
+	suite.Require().NoError(err)
+	suite.Empty(res.Error)
+	suite.Equal(types.CalculateSwapID(randomNumberHash, deputy, TestSenderOtherChain), []byte(res.SwapID))
+	suite.Equal(suite.ctx.BlockTime().Add(time.Duration(types.DefaultSwapTimeSpanMinutes)*time.Minute).Unix(), res.ExpireTimestamp)
+	suite.Equal(types.Incoming, res.Direction)
+	suite.True(res.Fee.IsZero())
+
+	// Nothing is written by the dry run
+	_, found := suite.keeper.GetAtomicSwap(suite.ctx, res.SwapID)
+	suite.False(found)
+	supplyAfter, _ := suite.keeper.GetAssetSupply(suite.ctx, "bnb")
+	suite.Equal(supplyBefore, supplyAfter)
+
+	// The specific error is reported
+	msg.Amount = cs(c("bnb", 100000000000000))
+	res, err = suite.keeper.SimulateCreateSwap(ctx, &types.QuerySimulateCreateSwapRequest{Msg: msg})
+	suite.Require().NoError(err)
+	suite.Equal(types.ModuleName, res.Codespace)
+	suite.Equal(types.ErrInvalidAmount.ABCICode(), res.Code)
+	suite.Contains(res.Error, "outside range")
+
+	outgoing := types.NewMsgCreateAtomicSwap(suite.addrs[0].String(), deputy.String(), TestRecipientOtherChain,
+		TestSenderOtherChain, randomNumberHash, timestamp, cs(c("bnb", 100)), types.DefaultSwapTimeSpanMinutes)
+	res, err = suite.keeper.SimulateCreateSwap(ctx, &types.QuerySimulateCreateSwapRequest{Msg: outgoing})
+	suite.Require().NoError(err)
+	suite.NotEmpty(res.Error)
+	suite.NotZero(res.Code)
+
+	_, err = suite.keeper.SimulateCreateSwap(ctx, &types.QuerySimulateCreateSwapRequest{})
+	suite.Error(err)
+}
+
+func (suite *QuerierTestSuite) TestSwapsByRandomNumberHash() {
+	ctx := sdk.WrapSDKContext(suite.ctx.WithIsCheckTx(false))
+
+	swap, found := suite.keeper.GetAtomicSwap(suite.ctx, suite.swapIDs[0])
+	suite.Require().True(found)
+
+	res, err := suite.keeper.SwapsByRandomNumberHash(ctx, &types.QuerySwapsByRandomNumberHashRequest{
+		RandomNumberHash: swap.RandomNumberHash,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Swaps.AugmentedAtomicSwaps, 1)
+	suite.Equal(hex.EncodeToString(suite.swapIDs[0]), res.Swaps.AugmentedAtomicSwaps[0].ID)
+
+	_, err = suite.keeper.SwapsByRandomNumberHash(ctx, &types.QuerySwapsByRandomNumberHashRequest{
+		RandomNumberHash: []byte{1, 2, 3},
+	})
+	suite.Error(err)
+}
+
 func TestQuerierTestSuite(t *testing.T) {
 	suite.Run(t, new(QuerierTestSuite))
 }
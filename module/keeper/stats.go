@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/bep3/module/types"
+)
+
+// GetSwapStats returns the cumulative swap stats of an asset.
+func (k Keeper) GetSwapStats(ctx sdk.Context, denom string) (types.SwapStats, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SwapStatsPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return types.SwapStats{}, false
+	}
+	var stats types.SwapStats
+	k.cdc.MustUnmarshalBinaryBare(bz, &stats)
+	return stats, true
+}
+
+// SetSwapStats stores the cumulative swap stats of an asset.
+func (k Keeper) SetSwapStats(ctx sdk.Context, stats types.SwapStats) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SwapStatsPrefix)
+	store.Set([]byte(stats.Denom), k.cdc.MustMarshalBinaryBare(&stats))
+}
+
+// IterateSwapStats provides an iterator over the cumulative swap stats of all assets.
+func (k Keeper) IterateSwapStats(ctx sdk.Context, cb func(stats types.SwapStats) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.SwapStatsPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.SwapStats
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &stats)
+
+		if cb(stats) {
+			break
+		}
+	}
+}
+
+// GetAllSwapStats returns the cumulative swap stats of all assets.
+func (k Keeper) GetAllSwapStats(ctx sdk.Context) (allStats []types.SwapStats) {
+	k.IterateSwapStats(ctx, func(stats types.SwapStats) bool {
+		allStats = append(allStats, stats)
+		return false
+	})
+	return
+}
+
+// GetDailySwapStats returns the swap stats of an asset for the UTC day starting at day.
+func (k Keeper) GetDailySwapStats(ctx sdk.Context, denom string, day int64) (types.DailySwapStats, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DailySwapStatsPrefix)
+	bz := store.Get(types.GetDailySwapStatsKey(denom, day))
+	if bz == nil {
+		return types.DailySwapStats{}, false
+	}
+	var daily types.DailySwapStats
+	k.cdc.MustUnmarshalBinaryBare(bz, &daily)
+	return daily, true
+}
+
+// SetDailySwapStats stores the swap stats of an asset for a single day.
+func (k Keeper) SetDailySwapStats(ctx sdk.Context, daily types.DailySwapStats) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DailySwapStatsPrefix)
+	store.Set(types.GetDailySwapStatsKey(daily.Stats.Denom, daily.Day), k.cdc.MustMarshalBinaryBare(&daily))
+}
+
+// IterateDailySwapStats provides an iterator over the daily swap stats of an asset in ascending order of day.
+// An empty denom iterates over the daily stats of all assets.
+func (k Keeper) IterateDailySwapStats(ctx sdk.Context, denom string, cb func(daily types.DailySwapStats) (stop bool)) {
+	keyPrefix := types.DailySwapStatsPrefix
+	if denom != "" {
+		keyPrefix = append(append([]byte{}, keyPrefix...), types.GetDailySwapStatsDenomPrefix(denom)...)
+	}
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), keyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var daily types.DailySwapStats
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &daily)
+
+		if cb(daily) {
+			break
+		}
+	}
+}
+
+// GetAllDailySwapStats returns the daily swap stats of all assets.
+func (k Keeper) GetAllDailySwapStats(ctx sdk.Context) (allDaily []types.DailySwapStats) {
+	k.IterateDailySwapStats(ctx, "", func(daily types.DailySwapStats) bool {
+		allDaily = append(allDaily, daily)
+		return false
+	})
+	return
+}
+
+// DeleteExpiredDailySwapStats removes the daily swap stats of all assets for the days more than
+// DailySwapStatsRetentionDays before the current day.
+func (k Keeper) DeleteExpiredDailySwapStats(ctx sdk.Context) {
+	cutoffDay := types.GetDayStart(ctx.BlockTime()) - types.DailySwapStatsRetentionDays*types.SecondsPerDay
+	if cutoffDay <= 0 {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DailySwapStatsPrefix)
+	for _, stats := range k.GetAllSwapStats(ctx) {
+		var expired [][]byte
+		iterator := store.Iterator(types.GetDailySwapStatsDenomPrefix(stats.Denom), types.GetDailySwapStatsKey(stats.Denom, cutoffDay))
+		for ; iterator.Valid(); iterator.Next() {
+			expired = append(expired, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range expired {
+			store.Delete(key)
+		}
+	}
+}
+
+// updateSwapStats applies update to both the cumulative stats of an asset and its stats for the current day.
+func (k Keeper) updateSwapStats(ctx sdk.Context, denom string, update func(stats *types.SwapStats)) {
+	stats, found := k.GetSwapStats(ctx, denom)
+	if !found {
+		stats = types.NewSwapStats(denom)
+	}
+	update(&stats)
+	k.SetSwapStats(ctx, stats)
+
+	day := types.GetDayStart(ctx.BlockTime())
+	daily, found := k.GetDailySwapStats(ctx, denom, day)
+	if !found {
+		daily = types.DailySwapStats{Day: day, Stats: types.NewSwapStats(denom)}
+	}
+	update(&daily.Stats)
+	k.SetDailySwapStats(ctx, daily)
+}
+
+// recordSwapCreated counts a newly created swap in the stats and telemetry.
+func (k Keeper) recordSwapCreated(ctx sdk.Context, swap types.AtomicSwap) {
+	incrSwapCounter(ctx, types.MetricKeySwapsCreated, swap)
+	k.updateSwapStats(ctx, swap.Amount[0].Denom, func(stats *types.SwapStats) {
+		stats.Created++
+	})
+}
+
+// recordSwapClaimed adds the claimed amount to the asset's volumes. Once the swap is claimed in full it is counted as
+// claimed, and its tip and, for outgoing swaps, the deputy's fixed fee are added as well.
+func (k Keeper) recordSwapClaimed(ctx sdk.Context, swap types.AtomicSwap, amount sdk.Coin) {
+	completed := swap.Status == types.Completed
+	if completed {
+		incrSwapCounter(ctx, types.MetricKeySwapsClaimed, swap)
+	}
+	fee := sdk.NewCoin(amount.Denom, sdk.ZeroInt())
+	// Interchain swaps are not relayed by the deputy, which charges no fee
+	if completed && swap.Direction == types.Outgoing && swap.IBCPacket == nil {
+		// The asset may have been removed by governance since the swap was created
+		if asset, err := k.GetAsset(ctx, amount.Denom); err == nil {
+			fee = sdk.NewCoin(amount.Denom, asset.FixedFee)
+		}
+	}
+
+	k.updateSwapStats(ctx, amount.Denom, func(stats *types.SwapStats) {
+		if completed {
+			stats.Claimed++
+		}
+		switch swap.Direction {
+		case types.Incoming:
+			stats.IncomingVolume = stats.IncomingVolume.Add(amount)
+		case types.Outgoing:
+			stats.OutgoingVolume = stats.OutgoingVolume.Add(amount)
+		}
+		stats.Fees = stats.Fees.Add(fee)
+		if completed && !swap.ClaimTip.Empty() {
+			stats.ClaimTips = stats.ClaimTips.Add(swap.ClaimTip[0])
+		}
+	})
+}
+
+// recordSwapRefunded counts a refunded swap in the stats and telemetry.
+func (k Keeper) recordSwapRefunded(ctx sdk.Context, swap types.AtomicSwap) {
+	incrSwapCounter(ctx, types.MetricKeySwapsRefunded, swap)
+	k.updateSwapStats(ctx, swap.Amount[0].Denom, func(stats *types.SwapStats) {
+		stats.Refunded++
+	})
+}
@@ -9,49 +9,66 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
 	"github.com/e-money/bep3/module/types"
 )
 
-// createAtomicSwap creates a new atomic swap.
-func (k Keeper) CreateAtomicSwapState(ctx sdk.Context, randomNumberHash []byte, timestamp, swapTimeSpanMin int64,
-	sender, recipient sdk.AccAddress, senderOtherChain, recipientOtherChain string, amount sdk.Coins,
-	crossChain bool) (*sdk.Result, error) {
+// CreateAtomicSwapState creates a new atomic swap from a msg sent by the sender to the recipient. The swap is incoming
+// if the sender is the deputy of the swapped asset, and outgoing if the recipient is.
+func (k Keeper) CreateAtomicSwapState(ctx sdk.Context, sender, recipient sdk.AccAddress, msg types.MsgCreateAtomicSwap) (*sdk.Result, error) {
 	// Confirm that this is not a duplicate swap
-	swapID := types.CalculateSwapID(randomNumberHash, sender, senderOtherChain)
+	swapID := types.CalculateSwapID(msg.RandomNumberHash, sender, msg.SenderOtherChain)
 
 	_, found := k.GetAtomicSwap(ctx, swapID)
 	if found {
 		return nil, sdkerrors.Wrap(types.ErrAtomicSwapAlreadyExists, hex.EncodeToString(swapID))
 	}
 
+	// Denied addresses cannot take part in a swap on either chain
+	err := k.ValidateNotDenied(ctx, sender.String(), recipient.String(), msg.SenderOtherChain, msg.RecipientOtherChain)
+	if err != nil {
+		return nil, err
+	}
+
 	// Cannot send coins to a module account
 	if k.Maccs[recipient.String()] {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is a module account", recipient)
 	}
 
-	if len(amount) != 1 {
+	if len(msg.Amount) != 1 {
 		return nil, fmt.Errorf("amount must contain exactly one coin")
 	}
-	asset, err := k.GetAsset(ctx, amount[0].Denom)
+	if err := types.ValidateClaimTip(msg.Amount, msg.ClaimTip); err != nil {
+		return nil, err
+	}
+	if msg.PartialClaims && !msg.ClaimTip.Empty() {
+		return nil, sdkerrors.Wrap(types.ErrInvalidPartialClaim, "swap with partial claims cannot have a claim tip")
+	}
+	if err := types.ValidateSwapMetadata(msg.Memo, msg.OtherChainTxHash); err != nil {
+		return nil, err
+	}
+	asset, err := k.GetAsset(ctx, msg.Amount[0].Denom)
 	if err != nil {
 		return nil, err
 	}
 
-	err = k.ValidateLiveAsset(ctx, amount[0])
+	err = k.ValidateLiveAsset(ctx, msg.Amount[0])
 	if err != nil {
 		return nil, err
 	}
 
+	// Assets requiring a deputy bond cannot be swapped in either direction until it is bonded
+	if err := k.ValidateDeputyBond(ctx, asset); err != nil {
+		return nil, err
+	}
+
 	// Swap amount must be within the specified swap amount limits
-	if amount[0].Amount.LT(asset.MinSwapAmount) || amount[0].Amount.GT(asset.MaxSwapAmount) {
-		return nil, sdkerrors.Wrapf(types.ErrInvalidAmount, "amount %d outside range [%s, %s]", amount[0].Amount, asset.MinSwapAmount, asset.MaxSwapAmount)
+	if msg.Amount[0].Amount.LT(asset.MinSwapAmount) || msg.Amount[0].Amount.GT(asset.MaxSwapAmount) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAmount, "amount %d outside range [%s, %s]", msg.Amount[0].Amount, asset.MinSwapAmount, asset.MaxSwapAmount)
 	}
 
-	// Unix timestamp must be in range [-15 mins, 30 mins] of the current time
-	pastTimestampLimit := ctx.BlockTime().Add(time.Duration(-15) * time.Minute).Unix()
-	futureTimestampLimit := ctx.BlockTime().Add(time.Duration(30) * time.Minute).Unix()
-	if timestamp < pastTimestampLimit || timestamp >= futureTimestampLimit {
-		return nil, sdkerrors.Wrap(types.ErrInvalidTimestamp, fmt.Sprintf("block time: %s, timestamp: %s", ctx.BlockTime().String(), time.Unix(timestamp, 0).UTC().String()))
+	if err := validateSwapTimestamp(ctx, msg.Timestamp); err != nil {
+		return nil, err
 	}
 
 	var direction types.SwapDirection
@@ -78,27 +95,31 @@ func (k Keeper) CreateAtomicSwapState(ctx sdk.Context, randomNumberHash []byte,
 			k.accountKeeper.SetAccount(ctx, newAcc)
 		}
 		// Incoming swaps have already had their fees collected by the deputy during the relay process.
-		err = k.IncrementIncomingAssetSupply(ctx, amount[0])
+		err = k.IncrementIncomingAssetSupply(ctx, msg.Amount[0])
 	case types.Outgoing:
+		// Outgoing swaps cannot be relayed while the deputy is inactive
+		if err := k.ValidateDeputyActive(ctx, asset); err != nil {
+			return nil, err
+		}
 
 		// Outgoing swaps must have a seconds time span within [60, 3 days]
-		if swapTimeSpanMin < 1 || swapTimeSpanMin > types.ThreeDayMinutes {
+		if msg.TimeSpanMin < 1 || msg.TimeSpanMin > types.ThreeDayMinutes {
 			return nil, sdkerrors.Wrapf(types.ErrInvalidTimeSpan,
 				"minutes span %d outside range of 1 min...1 day[%d, %d]",
-				swapTimeSpanMin, 1, types.ThreeDayMinutes,
+				msg.TimeSpanMin, 1, types.ThreeDayMinutes,
 			)
 		}
-		// Amount in outgoing swaps must be able to pay the deputy's fixed fee.
-		if amount[0].Amount.LTE(asset.FixedFee.Add(asset.MinSwapAmount)) {
-			return nil, sdkerrors.Wrap(types.ErrInsufficientAmount, amount[0].String())
+		// Amount in outgoing swaps, less the claim tip, must be able to pay the deputy's fixed fee.
+		if msg.Amount.Sub(msg.ClaimTip)[0].Amount.LTE(asset.FixedFee.Add(asset.MinSwapAmount)) {
+			return nil, sdkerrors.Wrap(types.ErrInsufficientAmount, msg.Amount.Sub(msg.ClaimTip).String())
 		}
-		err = k.IncrementOutgoingAssetSupply(ctx, amount[0])
+		err = k.IncrementOutgoingAssetSupply(ctx, msg.Amount[0])
 		if err != nil {
 			return nil, err
 		}
 
 		// Transfer coins to module - only needed for outgoing swaps
-		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount)
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, msg.Amount)
 	default:
 		err = fmt.Errorf("invalid swap direction: %s", direction.String())
 	}
@@ -107,13 +128,24 @@ func (k Keeper) CreateAtomicSwapState(ctx sdk.Context, randomNumberHash []byte,
 	}
 
 	// Store the details of the swap
-	expireTime := ctx.BlockTime().Add(time.Duration(swapTimeSpanMin) * time.Minute)
-	atomicSwap := types.NewAtomicSwap(amount, randomNumberHash, expireTime.Unix(), timestamp, sender, recipient,
-		senderOtherChain, recipientOtherChain, 0, types.Open, crossChain, direction)
+	expireTime := ctx.BlockTime().Add(time.Duration(msg.TimeSpanMin) * time.Minute)
+	atomicSwap := types.NewAtomicSwap(msg.Amount, msg.RandomNumberHash, expireTime.Unix(), msg.Timestamp, sender, recipient,
+		msg.SenderOtherChain, msg.RecipientOtherChain, 0, types.Open, true, direction)
+	atomicSwap.ClaimTip = msg.ClaimTip
+	atomicSwap.Memo = msg.Memo
+	atomicSwap.OtherChainTxHash = msg.OtherChainTxHash
+	if msg.PartialClaims {
+		atomicSwap.PartialClaims = true
+		atomicSwap.RemainingAmount = msg.Amount
+	}
 
 	// Insert the atomic swap under both keys
 	k.SetAtomicSwap(ctx, atomicSwap)
 	k.InsertIntoByTimestamp(ctx, atomicSwap)
+	k.recordSwapCreated(ctx, atomicSwap)
+	if direction == types.Incoming {
+		k.recordDeputyActivity(ctx, asset.DeputyAddress)
+	}
 
 	// Emit 'create_atomic_swap' event
 	ctx.EventManager().EmitEvent(
@@ -125,20 +157,37 @@ func (k Keeper) CreateAtomicSwapState(ctx sdk.Context, randomNumberHash []byte,
 			sdk.NewAttribute(types.AttributeKeyRandomNumberHash, hex.EncodeToString(atomicSwap.RandomNumberHash)),
 			sdk.NewAttribute(types.AttributeKeyTimestamp, fmt.Sprintf("%d", atomicSwap.Timestamp)),
 			sdk.NewAttribute(types.AttributeKeySenderOtherChain, atomicSwap.SenderOtherChain),
+			sdk.NewAttribute(types.AttributeKeyRecipientOtherChain, atomicSwap.RecipientOtherChain),
 			sdk.NewAttribute(types.AttributeKeyExpireTimestamp, fmt.Sprintf("%d", atomicSwap.ExpireTimestamp)),
 			sdk.NewAttribute(types.AttributeKeyAmount, atomicSwap.Amount.String()),
 			sdk.NewAttribute(types.AttributeKeyDirection, atomicSwap.Direction.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimTip, atomicSwap.ClaimTip.String()),
+			sdk.NewAttribute(types.AttributeKeyMemo, atomicSwap.Memo),
+			sdk.NewAttribute(types.AttributeKeyOtherChainTxHash, atomicSwap.OtherChainTxHash),
+			sdk.NewAttribute(types.AttributeKeyPartialClaims, strconv.FormatBool(atomicSwap.PartialClaims)),
 		),
 	)
 
 	return &sdk.Result{
-		Log:  hex.EncodeToString(atomicSwap.RandomNumberHash),
-		Data: swapID,
+		Log:    hex.EncodeToString(atomicSwap.RandomNumberHash),
+		Data:   swapID,
 		Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
+// validateSwapTimestamp checks that a swap's unix timestamp is in range [-15 mins, 30 mins] of the current time
+func validateSwapTimestamp(ctx sdk.Context, timestamp int64) error {
+	pastTimestampLimit := ctx.BlockTime().Add(time.Duration(-15) * time.Minute).Unix()
+	futureTimestampLimit := ctx.BlockTime().Add(time.Duration(30) * time.Minute).Unix()
+	if timestamp < pastTimestampLimit || timestamp >= futureTimestampLimit {
+		return sdkerrors.Wrap(types.ErrInvalidTimestamp, fmt.Sprintf("block time: %s, timestamp: %s", ctx.BlockTime().String(), time.Unix(timestamp, 0).UTC().String()))
+	}
+	return nil
+}
+
 // claimAtomicSwap validates a claim attempt, and if successful, sends the escrowed amount and closes the AtomicSwap.
-func (k Keeper) ClaimAtomicSwapState(ctx sdk.Context, from sdk.AccAddress, swapID []byte, randomNumber []byte) (*sdk.Result, error) {
+// Swaps with partial claims may be claimed in parts of their remaining amount, and are closed once it is all claimed.
+// An empty amount claims the remaining amount.
+func (k Keeper) ClaimAtomicSwapState(ctx sdk.Context, from sdk.AccAddress, swapID []byte, randomNumber []byte, amount sdk.Coins) (*sdk.Result, error) {
 	atomicSwap, found := k.GetAtomicSwap(ctx, swapID)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrAtomicSwapNotFound, "%s", hex.EncodeToString(swapID))
@@ -148,6 +197,16 @@ func (k Keeper) ClaimAtomicSwapState(ctx sdk.Context, from sdk.AccAddress, swapI
 	if atomicSwap.Status != types.Open {
 		return nil, sdkerrors.Wrapf(types.ErrSwapNotClaimable, "status %s", atomicSwap.Status.String())
 	}
+	// Outgoing interchain swaps are settled by the acknowledgement of their packet
+	if atomicSwap.IBCPacket != nil && atomicSwap.Direction == types.Outgoing {
+		return nil, sdkerrors.Wrap(types.ErrSwapNotClaimable, "interchain swap is settled over IBC")
+	}
+
+	// Neither the claimant nor the recipient may be denied
+	err := k.ValidateNotDenied(ctx, from.String(), atomicSwap.Recipient, atomicSwap.RecipientOtherChain)
+	if err != nil {
+		return nil, err
+	}
 
 	//  Calculate hashed secret using submitted number
 	randomNumberHash := types.CalculateRandomHash(randomNumber, atomicSwap.Timestamp)
@@ -159,50 +218,67 @@ func (k Keeper) ClaimAtomicSwapState(ctx sdk.Context, from sdk.AccAddress, swapI
 	}
 
 	recreatedSwapID := types.CalculateSwapID(randomNumberHash, swapSender, atomicSwap.SenderOtherChain)
+	if atomicSwap.Direction == types.Local {
+		recreatedSwapID = types.CalculateLocalSwapID(randomNumberHash, swapSender)
+	}
 
 	// Confirm that secret unlocks the atomic swap
 	if !bytes.Equal(recreatedSwapID, atomicSwap.GetSwapID()) {
 		return nil, sdkerrors.Wrapf(types.ErrInvalidClaimSecret, "the submitted random number is incorrect")
 	}
 
-	var err error
+	claimed, err := claimedAmount(atomicSwap, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// The claim tip, if any, is paid out of the swap amount to the claim submitter
+	claimTip := atomicSwap.ClaimTip
+	swapRecipient, errBech := sdk.AccAddressFromBech32(atomicSwap.Recipient)
+	if errBech != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSwapAccount, "ClaimSwap recipient:%s, error:%s", atomicSwap.Recipient, errBech)
+	}
+
 	switch atomicSwap.Direction {
 	case types.Incoming:
-		err = k.DecrementIncomingAssetSupply(ctx, atomicSwap.Amount[0])
+		err = k.DecrementIncomingAssetSupply(ctx, claimed[0])
 		if err != nil {
 			return nil, err
 		}
-		err = k.IncrementCurrentAssetSupply(ctx, atomicSwap.Amount[0])
+		err = k.IncrementCurrentAssetSupply(ctx, claimed[0])
 		if err != nil {
 			return nil, err
 		}
 		// incoming case - coins should be MINTED, then sent to user
-		err = k.bankKeeper.MintCoins(ctx, types.ModuleName, atomicSwap.Amount)
+		err = k.bankKeeper.MintCoins(ctx, types.ModuleName, claimed)
 		if err != nil {
 			return nil, err
 		}
 
 		// Send intended recipient coins
-		swapRecipient, errBech := sdk.AccAddressFromBech32(atomicSwap.Recipient)
-		if errBech != nil {
-			return nil, sdkerrors.Wrapf(types.ErrInvalidSwapAccount, "ClaimSwap sender:%s, error:%s", atomicSwap.Recipient, errBech)
-		}
-
-		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, swapRecipient, atomicSwap.Amount)
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, swapRecipient, claimed.Sub(claimTip))
 		if err != nil {
 			return nil, err
 		}
 	case types.Outgoing:
-		err = k.DecrementOutgoingAssetSupply(ctx, atomicSwap.Amount[0])
+		err = k.DecrementOutgoingAssetSupply(ctx, claimed[0])
 		if err != nil {
 			return nil, err
 		}
-		err = k.DecrementCurrentAssetSupply(ctx, atomicSwap.Amount[0])
+		// The claim tip stays on this chain, so only the remainder leaves the current supply
+		burned := claimed.Sub(claimTip)
+		err = k.DecrementCurrentAssetSupply(ctx, burned[0])
 		if err != nil {
 			return nil, err
 		}
 		// outgoing case  - coins should be burned
-		err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, atomicSwap.Amount)
+		err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, burned)
+		if err != nil {
+			return nil, err
+		}
+	case types.Local:
+		// local case - the escrowed coins are released to the recipient, without changing the asset supply
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, swapRecipient, claimed.Sub(claimTip))
 		if err != nil {
 			return nil, err
 		}
@@ -210,14 +286,34 @@ func (k Keeper) ClaimAtomicSwapState(ctx sdk.Context, from sdk.AccAddress, swapI
 		return nil, fmt.Errorf("invalid swap direction: %s", atomicSwap.Direction.String())
 	}
 
-	// Complete swap
-	atomicSwap.Status = types.Completed
-	atomicSwap.ClosedBlock = ctx.BlockHeight()
-	k.SetAtomicSwap(ctx, atomicSwap)
+	if !claimTip.Empty() {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, from, claimTip)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	// Remove from byTimestamp key and transition to long term storage
-	k.RemoveFromByTimestamp(ctx, atomicSwap)
-	k.InsertIntoLongtermStorage(ctx, atomicSwap)
+	// Complete swap, unless a part of a swap with partial claims remains to be claimed
+	if atomicSwap.PartialClaims {
+		atomicSwap.RemainingAmount = atomicSwap.RemainingAmount.Sub(claimed)
+	}
+	if atomicSwap.RemainingAmount.Empty() {
+		atomicSwap.Status = types.Completed
+		atomicSwap.ClosedBlock = ctx.BlockHeight()
+		atomicSwap.ClosedTime = ctx.BlockTime().Unix()
+		k.SetAtomicSwap(ctx, atomicSwap)
+
+		// Remove from byTimestamp key and transition to long term storage
+		k.RemoveFromByTimestamp(ctx, atomicSwap)
+		k.InsertIntoLongtermStorage(ctx, atomicSwap)
+		k.acknowledgeInterchainSwap(ctx, atomicSwap, channeltypes.NewResultAcknowledgement(randomNumber))
+	} else {
+		k.SetAtomicSwap(ctx, atomicSwap)
+	}
+	k.recordSwapClaimed(ctx, atomicSwap, claimed[0])
+	if atomicSwap.Direction == types.Outgoing && from.String() == atomicSwap.Recipient {
+		k.recordDeputyActivity(ctx, atomicSwap.Recipient)
+	}
 
 	// Emit 'claim_atomic_swap' event
 	ctx.EventManager().EmitEvent(
@@ -228,6 +324,9 @@ func (k Keeper) ClaimAtomicSwapState(ctx sdk.Context, from sdk.AccAddress, swapI
 			sdk.NewAttribute(types.AttributeKeyAtomicSwapID, hex.EncodeToString(atomicSwap.GetSwapID())),
 			sdk.NewAttribute(types.AttributeKeyRandomNumberHash, hex.EncodeToString(atomicSwap.RandomNumberHash)),
 			sdk.NewAttribute(types.AttributeKeyRandomNumber, hex.EncodeToString(randomNumber)),
+			sdk.NewAttribute(types.AttributeKeyClaimTip, claimTip.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, claimed.String()),
+			sdk.NewAttribute(types.AttributeKeyRemainingAmount, atomicSwap.RemainingAmount.String()),
 		),
 	)
 
@@ -251,12 +350,15 @@ func (k Keeper) RefundAtomicSwapState(ctx sdk.Context, from sdk.AccAddress, swap
 		)
 	}
 
+	// Only the unclaimed part of a swap with partial claims is refunded
+	unclaimed := atomicSwap.UnclaimedAmount()
+
 	var err error
 	switch atomicSwap.Direction {
 	case types.Incoming:
-		err = k.DecrementIncomingAssetSupply(ctx, atomicSwap.Amount[0])
+		err = k.DecrementIncomingAssetSupply(ctx, unclaimed[0])
 	case types.Outgoing:
-		err = k.DecrementOutgoingAssetSupply(ctx, atomicSwap.Amount[0])
+		err = k.DecrementOutgoingAssetSupply(ctx, unclaimed[0])
 		if err != nil {
 			return nil, err
 		}
@@ -271,7 +373,20 @@ func (k Keeper) RefundAtomicSwapState(ctx sdk.Context, from sdk.AccAddress, swap
 		}
 
 		err = k.bankKeeper.SendCoinsFromModuleToAccount(
-			ctx, types.ModuleName, swapSender, atomicSwap.Amount,
+			ctx, types.ModuleName, swapSender, unclaimed,
+		)
+	case types.Local:
+		// Refund coins to original swap sender for local swaps, which are not part of the asset supply
+		swapSender, errBech := sdk.AccAddressFromBech32(atomicSwap.Sender)
+		if errBech != nil {
+			return nil, sdkerrors.Wrapf(
+				types.ErrInvalidSwapAccount, "RefundSwap sender:%s, error:%s",
+				atomicSwap.Sender, errBech,
+			)
+		}
+
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(
+			ctx, types.ModuleName, swapSender, unclaimed,
 		)
 	default:
 		err = fmt.Errorf(
@@ -286,10 +401,24 @@ func (k Keeper) RefundAtomicSwapState(ctx sdk.Context, from sdk.AccAddress, swap
 	// Complete swap
 	atomicSwap.Status = types.Completed
 	atomicSwap.ClosedBlock = ctx.BlockHeight()
+	atomicSwap.ClosedTime = ctx.BlockTime().Unix()
 	k.SetAtomicSwap(ctx, atomicSwap)
 
+	// A refunded outgoing swap was not honored by the deputy, unless it has been slashed for already. Refunded incoming
+	// swaps are not slashed, as they expire when their recipient does not claim them rather than through a deputy fault.
+	if atomicSwap.Direction == types.Outgoing && !atomicSwap.DeputySlashed {
+		asset, err := k.GetAsset(ctx, atomicSwap.Amount[0].Denom)
+		if err == nil && asset.SlashOnRefund {
+			if _, err := k.SlashDeputy(ctx, atomicSwap, types.AttributeValueSlashReasonRefund); err != nil {
+				return nil, err
+			}
+			atomicSwap.DeputySlashed = true
+		}
+	}
+
 	// Transition to longterm storage
 	k.InsertIntoLongtermStorage(ctx, atomicSwap)
+	k.recordSwapRefunded(ctx, atomicSwap)
 
 	// Emit 'refund_atomic_swap' event
 	ctx.EventManager().EmitEvent(
@@ -315,6 +444,94 @@ func (k Keeper) RefundAtomicSwapState(ctx sdk.Context, from sdk.AccAddress, swap
 	}, nil
 }
 
+// AnnotateSwapState attaches the hash of the counterparty transaction on the other chain to a swap. Only the
+// deputy of the swap's asset may annotate it, and a hash that has been set cannot be changed.
+func (k Keeper) AnnotateSwapState(ctx sdk.Context, from sdk.AccAddress, swapID []byte, otherChainTxHash string) error {
+	atomicSwap, found := k.GetAtomicSwap(ctx, swapID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrAtomicSwapNotFound, "%s", hex.EncodeToString(swapID))
+	}
+	if err := types.ValidateSwapMetadata("", otherChainTxHash); err != nil {
+		return err
+	}
+
+	asset, err := k.GetAsset(ctx, atomicSwap.Amount[0].Denom)
+	if err != nil {
+		return err
+	}
+	if from.String() != asset.DeputyAddress {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the deputy of asset %s", from, asset.Denom)
+	}
+	if atomicSwap.OtherChainTxHash != "" {
+		return sdkerrors.Wrapf(types.ErrSwapAlreadyAnnotated, "%s has other chain tx hash %s", hex.EncodeToString(swapID), atomicSwap.OtherChainTxHash)
+	}
+
+	atomicSwap.OtherChainTxHash = otherChainTxHash
+	k.SetAtomicSwap(ctx, atomicSwap)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAnnotateSwap,
+			sdk.NewAttribute(types.AttributeKeyAnnotateSender, from.String()),
+			sdk.NewAttribute(types.AttributeKeyAtomicSwapID, hex.EncodeToString(swapID)),
+			sdk.NewAttribute(types.AttributeKeyRandomNumberHash, hex.EncodeToString(atomicSwap.RandomNumberHash)),
+			sdk.NewAttribute(types.AttributeKeyOtherChainTxHash, otherChainTxHash),
+		),
+	)
+	return nil
+}
+
+// BatchClaimAtomicSwapsState claims several AtomicSwaps. In atomic mode the first failing claim aborts the
+// whole batch, otherwise failing claims are reported in the results and the remaining claims still apply.
+func (k Keeper) BatchClaimAtomicSwapsState(ctx sdk.Context, from sdk.AccAddress, claims []types.BatchClaimItem, atomic bool) ([]types.BatchItemResult, error) {
+	swapIDs := make([][]byte, len(claims))
+	for i, claim := range claims {
+		swapIDs[i] = claim.SwapID
+	}
+	return applyBatch(ctx, swapIDs, atomic, func(ctx sdk.Context, i int) error {
+		_, err := k.ClaimAtomicSwapState(ctx, from, claims[i].SwapID, claims[i].RandomNumber, nil)
+		return err
+	})
+}
+
+// BatchRefundAtomicSwapsState refunds several AtomicSwaps. In atomic mode the first failing refund aborts the
+// whole batch, otherwise failing refunds are reported in the results and the remaining refunds still apply.
+func (k Keeper) BatchRefundAtomicSwapsState(ctx sdk.Context, from sdk.AccAddress, swapIDs [][]byte, atomic bool) ([]types.BatchItemResult, error) {
+	return applyBatch(ctx, swapIDs, atomic, func(ctx sdk.Context, i int) error {
+		_, err := k.RefundAtomicSwapState(ctx, from, swapIDs[i])
+		return err
+	})
+}
+
+// applyBatch runs apply for every swap in its own cached context so that a failing item leaves no partial
+// state behind. State and events are only committed to ctx once the batch as a whole has succeeded.
+func applyBatch(ctx sdk.Context, swapIDs [][]byte, atomic bool, apply func(ctx sdk.Context, i int) error) ([]types.BatchItemResult, error) {
+	batchCtx, writeBatch := ctx.CacheContext()
+
+	var events sdk.Events
+	results := make([]types.BatchItemResult, len(swapIDs))
+	for i, swapID := range swapIDs {
+		results[i] = types.BatchItemResult{SwapID: hex.EncodeToString(swapID)}
+
+		itemCtx, writeItem := batchCtx.CacheContext()
+		if err := apply(itemCtx, i); err != nil {
+			if atomic {
+				return nil, sdkerrors.Wrapf(err, "swap %d (%s)", i, results[i].SwapID)
+			}
+			results[i].Error = err.Error()
+			continue
+		}
+
+		writeItem()
+		events = append(events, itemCtx.EventManager().Events()...)
+		results[i].Success = true
+	}
+
+	writeBatch()
+	ctx.EventManager().EmitEvents(events)
+	return results, nil
+}
+
 // UpdateExpiredAtomicSwaps finds all AtomicSwaps that are past (or at) their ending times and expires them.
 func (k Keeper) UpdateExpiredAtomicSwaps(ctx sdk.Context) {
 	var expiredSwapIDs []string
@@ -329,6 +546,8 @@ func (k Keeper) UpdateExpiredAtomicSwaps(ctx sdk.Context) {
 		// Note: claimed swaps have already been removed from byBlock index.
 		k.RemoveFromByTimestamp(ctx, atomicSwap)
 		k.SetAtomicSwap(ctx, atomicSwap)
+		incrSwapCounter(ctx, types.MetricKeySwapsExpired, atomicSwap)
+		k.acknowledgeInterchainSwap(ctx, atomicSwap, channeltypes.NewErrorAcknowledgement("swap expired"))
 		expiredSwapIDs = append(expiredSwapIDs, hex.EncodeToString(atomicSwap.GetSwapID()))
 		return false
 	})
@@ -350,16 +569,45 @@ func (k Keeper) UpdateExpiredAtomicSwaps(ctx sdk.Context) {
 	}
 }
 
-// DeleteClosedAtomicSwapsFromLongtermStorage removes swaps one week after completion.
+// DeleteClosedAtomicSwapsFromLongtermStorage removes closed swaps once the longterm storage retention has passed.
 func (k Keeper) DeleteClosedAtomicSwapsFromLongtermStorage(ctx sdk.Context) {
-	k.IterateAtomicSwapsLongtermStorage(ctx, uint64(ctx.BlockHeight()), func(id []byte) bool {
+	retention := k.GetLongtermStorageRetention(ctx)
+
+	cutoffTime := ctx.BlockTime().Unix()
+	if retention.Unit == types.RetentionUnitSeconds {
+		cutoffTime -= retention.Value
+	}
+
+	k.IterateAtomicSwapsLongtermStorage(ctx, cutoffTime, func(id []byte) bool {
 		swap, found := k.GetAtomicSwap(ctx, id)
 		if !found {
 			// NOTE: shouldn't happen. Continue to next item.
 			return false
 		}
+		// Swaps are ordered by close time, so no later swap has been retained for long enough either
+		if retention.Unit == types.RetentionUnitBlocks && swap.ClosedBlock+retention.Value > ctx.BlockHeight() {
+			return true
+		}
 		k.RemoveAtomicSwap(ctx, swap.GetSwapID())
 		k.RemoveFromLongtermStorage(ctx, swap)
 		return false
 	})
 }
+
+// claimedAmount returns the amount released by a claim of the swap. Swaps with partial claims release the requested
+// part of their remaining amount, or all of it if none is requested, while other swaps are always claimed in full.
+func claimedAmount(atomicSwap types.AtomicSwap, amount sdk.Coins) (sdk.Coins, error) {
+	if !atomicSwap.PartialClaims {
+		if !amount.Empty() {
+			return nil, sdkerrors.Wrap(types.ErrInvalidPartialClaim, "swap does not allow partial claims")
+		}
+		return atomicSwap.Amount, nil
+	}
+	if amount.Empty() {
+		return atomicSwap.RemainingAmount, nil
+	}
+	if len(amount) != 1 || !amount.IsValid() || !atomicSwap.RemainingAmount.IsAllGTE(amount) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPartialClaim, "cannot claim %s of remaining amount %s", amount, atomicSwap.RemainingAmount)
+	}
+	return amount, nil
+}
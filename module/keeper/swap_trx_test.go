@@ -1,10 +1,14 @@
 package keeper_test
 
 import (
+	"encoding/hex"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	bep3 "github.com/e-money/bep3/module"
 	"github.com/e-money/bep3/module/keeper"
 	"github.com/e-money/bep3/module/types"
@@ -106,7 +110,6 @@ func (suite *AtomicSwapTestSuite) TestCreateAtomicSwap() {
 		senderOtherChain    string
 		recipientOtherChain string
 		coins               sdk.Coins
-		crossChain          bool
 		direction           types.SwapDirection
 	}
 	testCases := []struct {
@@ -128,7 +131,6 @@ func (suite *AtomicSwapTestSuite) TestCreateAtomicSwap() {
 				senderOtherChain:    TestSenderOtherChain,
 				recipientOtherChain: TestRecipientOtherChain,
 				coins:               cs(c(BNB_DENOM, 50000)),
-				crossChain:          true,
 				direction:           types.Incoming,
 			},
 			true,
@@ -146,7 +148,6 @@ func (suite *AtomicSwapTestSuite) TestCreateAtomicSwap() {
 				senderOtherChain:    TestSenderOtherChain,
 				recipientOtherChain: TestRecipientOtherChain,
 				coins:               cs(c("inc", 50000000000)),
-				crossChain:          true,
 				direction:           types.Incoming,
 			},
 			true,
@@ -164,7 +165,6 @@ func (suite *AtomicSwapTestSuite) TestCreateAtomicSwap() {
 				senderOtherChain:    TestSenderOtherChain,
 				recipientOtherChain: TestRecipientOtherChain,
 				coins:               cs(c("inc", 50000000001)),
-				crossChain:          true,
 				direction:           types.Incoming,
 			},
 			false,
@@ -182,7 +182,6 @@ func (suite *AtomicSwapTestSuite) TestCreateAtomicSwap() {
 				senderOtherChain:    TestSenderOtherChain,
 				recipientOtherChain: TestRecipientOtherChain,
 				coins:               cs(c(BNB_DENOM, 50000)),
-				crossChain:          true,
 				direction:           types.Outgoing,
 			},
 			true,
@@ -201,7 +200,6 @@ func (suite *AtomicSwapTestSuite) TestCreateAtomicSwap() {
 				senderOtherChain:    TestSenderOtherChain,
 				recipientOtherChain: TestRecipientOtherChain,
 				coins:               cs(c(BNB_DENOM, 1000)),
-				crossChain:          true,
 				direction:           types.Outgoing,
 			},
 			false,
@@ -219,7 +217,6 @@ func (suite *AtomicSwapTestSuite) TestCreateAtomicSwap() {
 				senderOtherChain:    TestSenderOtherChain,
 				recipientOtherChain: TestRecipientOtherChain,
 				coins:               cs(c("xyz", 50000)),
-				crossChain:          true,
 				direction:           types.Incoming,
 			},
 			false,
@@ -237,7 +234,6 @@ func (suite *AtomicSwapTestSuite) TestCreateAtomicSwap() {
 				senderOtherChain:    TestSenderOtherChain,
 				recipientOtherChain: TestRecipientOtherChain,
 				coins:               cs(c(BNB_DENOM, 50000)),
-				crossChain:          true,
 				direction:           types.Incoming,
 			},
 			false,
@@ -255,7 +251,6 @@ func (suite *AtomicSwapTestSuite) TestCreateAtomicSwap() {
 				senderOtherChain:    TestSenderOtherChain,
 				recipientOtherChain: TestRecipientOtherChain,
 				coins:               cs(c(BNB_DENOM, 50000)),
-				crossChain:          true,
 				direction:           types.Incoming,
 			},
 			false,
@@ -273,7 +268,6 @@ func (suite *AtomicSwapTestSuite) TestCreateAtomicSwap() {
 				senderOtherChain:    TestSenderOtherChain,
 				recipientOtherChain: TestRecipientOtherChain,
 				coins:               cs(c(BNB_DENOM, 50000)),
-				crossChain:          true,
 				direction:           types.Outgoing,
 			},
 			false,
@@ -291,7 +285,6 @@ func (suite *AtomicSwapTestSuite) TestCreateAtomicSwap() {
 				senderOtherChain:    TestSenderOtherChain,
 				recipientOtherChain: TestRecipientOtherChain,
 				coins:               cs(c(BNB_DENOM, 50000)),
-				crossChain:          true,
 				direction:           types.Outgoing,
 			},
 			false,
@@ -309,7 +302,6 @@ func (suite *AtomicSwapTestSuite) TestCreateAtomicSwap() {
 				senderOtherChain:    TestSenderOtherChain,
 				recipientOtherChain: TestRecipientOtherChain,
 				coins:               cs(c(BNB_DENOM, 0)),
-				crossChain:          true,
 				direction:           types.Incoming,
 			},
 			false,
@@ -327,7 +319,6 @@ func (suite *AtomicSwapTestSuite) TestCreateAtomicSwap() {
 				senderOtherChain:    TestSenderOtherChain,
 				recipientOtherChain: TestRecipientOtherChain,
 				coins:               cs(c(BNB_DENOM, 50000)),
-				crossChain:          true,
 				direction:           types.Incoming,
 			},
 			false,
@@ -345,7 +336,6 @@ func (suite *AtomicSwapTestSuite) TestCreateAtomicSwap() {
 				senderOtherChain:    TestSenderOtherChain,
 				recipientOtherChain: TestRecipientOtherChain,
 				coins:               cs(c(BNB_DENOM, 5000)),
-				crossChain:          true,
 				direction:           types.Incoming,
 			},
 			false,
@@ -363,7 +353,6 @@ func (suite *AtomicSwapTestSuite) TestCreateAtomicSwap() {
 				senderOtherChain:    TestSenderOtherChain,
 				recipientOtherChain: TestRecipientOtherChain,
 				coins:               cs(c(BNB_DENOM, 1000000000000)), // 10,000 BNB
-				crossChain:          true,
 				direction:           types.Incoming,
 			},
 			true,
@@ -381,7 +370,6 @@ func (suite *AtomicSwapTestSuite) TestCreateAtomicSwap() {
 				senderOtherChain:    TestSenderOtherChain,
 				recipientOtherChain: TestRecipientOtherChain,
 				coins:               cs(c(BNB_DENOM, 1000000000001)), // 10,001 BNB
-				crossChain:          true,
 				direction:           types.Incoming,
 			},
 			false,
@@ -412,9 +400,16 @@ func (suite *AtomicSwapTestSuite) TestCreateAtomicSwap() {
 			assetSupplyPre, _ := suite.keeper.GetAssetSupply(suite.ctx, swapAssetDenom)
 
 			// Create atomic swap
-			_, err := suite.keeper.CreateAtomicSwapState(suite.ctx, tc.args.randomNumberHash, tc.args.timestamp,
-				tc.args.timeSpan, tc.args.sender, tc.args.recipient, tc.args.senderOtherChain,
-				tc.args.recipientOtherChain, tc.args.coins, tc.args.crossChain)
+			_, err := suite.keeper.CreateAtomicSwapState(suite.ctx, tc.args.sender, tc.args.recipient, types.MsgCreateAtomicSwap{
+				From:                tc.args.sender.String(),
+				To:                  tc.args.recipient.String(),
+				RecipientOtherChain: tc.args.recipientOtherChain,
+				SenderOtherChain:    tc.args.senderOtherChain,
+				RandomNumberHash:    tc.args.randomNumberHash,
+				Timestamp:           tc.args.timestamp,
+				Amount:              tc.args.coins,
+				TimeSpanMin:         tc.args.timeSpan,
+			})
 
 			// Load sender's account after swap creation
 			senderBalancePost := bk.GetBalance(suite.ctx, tc.args.sender, swapAssetDenom)
@@ -457,7 +452,7 @@ func (suite *AtomicSwapTestSuite) TestCreateAtomicSwap() {
 						RecipientOtherChain: tc.args.recipientOtherChain,
 						ClosedBlock:         0,
 						Status:              types.Open,
-						CrossChain:          tc.args.crossChain,
+						CrossChain:          true,
 						Direction:           tc.args.direction,
 					}
 				suite.Equal(expectedSwap, actualSwap)
@@ -587,9 +582,16 @@ func (suite *AtomicSwapTestSuite) TestClaimAtomicSwap() {
 			}
 
 			// Create atomic swap
-			_, err := suite.keeper.CreateAtomicSwapState(suite.ctx, suite.randomNumberHashes[i], suite.timestamps[i],
-				types.DefaultSwapTimeSpanMinutes, sender, expectedRecipient, TestSenderOtherChain, TestRecipientOtherChain,
-				tc.args.coins, true)
+			_, err := suite.keeper.CreateAtomicSwapState(suite.ctx, sender, expectedRecipient, types.MsgCreateAtomicSwap{
+				From:                sender.String(),
+				To:                  expectedRecipient.String(),
+				RecipientOtherChain: TestRecipientOtherChain,
+				SenderOtherChain:    TestSenderOtherChain,
+				RandomNumberHash:    suite.randomNumberHashes[i],
+				Timestamp:           suite.timestamps[i],
+				Amount:              tc.args.coins,
+				TimeSpanMin:         types.DefaultSwapTimeSpanMinutes,
+			})
 			suite.NoError(err)
 
 			realSwapID := types.CalculateSwapID(suite.randomNumberHashes[i], sender, TestSenderOtherChain)
@@ -620,7 +622,7 @@ func (suite *AtomicSwapTestSuite) TestClaimAtomicSwap() {
 			assetSupplyPre, _ := suite.keeper.GetAssetSupply(tc.claimCtx, tc.args.coins[0].Denom)
 
 			// Attempt to claim atomic swap
-			_, err = suite.keeper.ClaimAtomicSwapState(tc.claimCtx, expectedRecipient, claimSwapID, claimRandomNumber)
+			_, err = suite.keeper.ClaimAtomicSwapState(tc.claimCtx, expectedRecipient, claimSwapID, claimRandomNumber, nil)
 
 			// Load expected recipient's account after the claim attempt
 			expectedRecipientBalancePost := bk.GetBalance(tc.claimCtx, expectedRecipient, tc.args.coins[0].Denom)
@@ -674,6 +676,479 @@ func (suite *AtomicSwapTestSuite) TestClaimAtomicSwap() {
 	}
 }
 
+func (suite *AtomicSwapTestSuite) TestCreateAtomicSwapDenied() {
+	testCases := []struct {
+		name   string
+		denied func() string
+	}{
+		{"denied sender", func() string { return suite.deputy.String() }},
+		{"denied recipient", func() string { return suite.addrs[1].String() }},
+		{"denied sender other chain", func() string { return TestSenderOtherChain }},
+		{"denied recipient other chain", func() string { return TestRecipientOtherChain }},
+	}
+
+	for i, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.keeper.SetDeniedAddress(suite.ctx, tc.denied())
+
+			_, err := suite.keeper.CreateAtomicSwapState(suite.ctx, suite.deputy, suite.addrs[1], types.MsgCreateAtomicSwap{
+				From:                suite.deputy.String(),
+				To:                  suite.addrs[1].String(),
+				RecipientOtherChain: TestRecipientOtherChain,
+				SenderOtherChain:    TestSenderOtherChain,
+				RandomNumberHash:    suite.randomNumberHashes[i],
+				Timestamp:           suite.timestamps[i],
+				Amount:              cs(c(BNB_DENOM, 50000)),
+				TimeSpanMin:         types.DefaultSwapTimeSpanMinutes,
+			})
+			suite.Require().True(errors.Is(err, types.ErrAddressDenied))
+
+			_, found := suite.keeper.GetAtomicSwap(suite.ctx,
+				types.CalculateSwapID(suite.randomNumberHashes[i], suite.deputy, TestSenderOtherChain))
+			suite.False(found)
+		})
+	}
+}
+
+func (suite *AtomicSwapTestSuite) TestClaimAtomicSwapDenied() {
+	testCases := []struct {
+		name   string
+		denied func() string
+	}{
+		{"denied claimant", func() string { return suite.addrs[2].String() }},
+		{"denied recipient", func() string { return suite.addrs[1].String() }},
+	}
+
+	for i, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			_, err := suite.keeper.CreateAtomicSwapState(suite.ctx, suite.deputy, suite.addrs[1], types.MsgCreateAtomicSwap{
+				From:                suite.deputy.String(),
+				To:                  suite.addrs[1].String(),
+				RecipientOtherChain: TestRecipientOtherChain,
+				SenderOtherChain:    TestSenderOtherChain,
+				RandomNumberHash:    suite.randomNumberHashes[i],
+				Timestamp:           suite.timestamps[i],
+				Amount:              cs(c(BNB_DENOM, 50000)),
+				TimeSpanMin:         types.DefaultSwapTimeSpanMinutes,
+			})
+			suite.Require().NoError(err)
+			swapID := types.CalculateSwapID(suite.randomNumberHashes[i], suite.deputy, TestSenderOtherChain)
+
+			suite.keeper.SetDeniedAddress(suite.ctx, tc.denied())
+			_, err = suite.keeper.ClaimAtomicSwapState(suite.ctx, suite.addrs[2], swapID, suite.randomNumbers[i], nil)
+			suite.Require().True(errors.Is(err, types.ErrAddressDenied))
+
+			swap, _ := suite.keeper.GetAtomicSwap(suite.ctx, swapID)
+			suite.Equal(types.Open, swap.Status)
+
+			// The swap can be claimed once the address is removed from the deny list
+			suite.keeper.RemoveDeniedAddress(suite.ctx, tc.denied())
+			_, err = suite.keeper.ClaimAtomicSwapState(suite.ctx, suite.addrs[2], swapID, suite.randomNumbers[i], nil)
+			suite.NoError(err)
+		})
+	}
+}
+
+func (suite *AtomicSwapTestSuite) TestClaimAtomicSwapWithTip() {
+	amount := cs(c(BNB_DENOM, 50000))
+	tip := cs(c(BNB_DENOM, 100))
+	relayer := suite.addrs[7]
+
+	testCases := []struct {
+		name      string
+		direction types.SwapDirection
+	}{
+		{"incoming swap", types.Incoming},
+		{"outgoing swap", types.Outgoing},
+	}
+
+	for i, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			sender, recipient := suite.deputy, suite.addrs[5]
+			if tc.direction == types.Outgoing {
+				sender, recipient = suite.addrs[6], suite.deputy
+				suite.Require().NoError(suite.keeper.IncrementCurrentAssetSupply(suite.ctx, amount[0]))
+				// Balances are set directly in setup, so account for the burned coins in the bank's total supply
+				suite.Require().NoError(suite.bankKeeper.MintCoins(suite.ctx, types.ModuleName, amount))
+				suite.Require().NoError(suite.bankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, suite.addrs[19], amount))
+			}
+
+			_, err := suite.keeper.CreateAtomicSwapState(suite.ctx, sender, recipient, types.MsgCreateAtomicSwap{
+				From:                sender.String(),
+				To:                  recipient.String(),
+				RecipientOtherChain: TestRecipientOtherChain,
+				SenderOtherChain:    TestSenderOtherChain,
+				RandomNumberHash:    suite.randomNumberHashes[i],
+				Timestamp:           suite.timestamps[i],
+				Amount:              amount,
+				TimeSpanMin:         types.DefaultSwapTimeSpanMinutes,
+				ClaimTip:            tip,
+			})
+			suite.Require().NoError(err)
+			swapID := types.CalculateSwapID(suite.randomNumberHashes[i], sender, TestSenderOtherChain)
+
+			swap, found := suite.keeper.GetAtomicSwap(suite.ctx, swapID)
+			suite.Require().True(found)
+			suite.Equal(tip, swap.ClaimTip)
+
+			bk := suite.bankKeeper
+			recipientPre := bk.GetBalance(suite.ctx, recipient, BNB_DENOM)
+			relayerPre := bk.GetBalance(suite.ctx, relayer, BNB_DENOM)
+			supplyPre, _ := suite.keeper.GetAssetSupply(suite.ctx, BNB_DENOM)
+
+			res, err := suite.keeper.ClaimAtomicSwapState(suite.ctx, relayer, swapID, suite.randomNumbers[i], nil)
+			suite.Require().NoError(err)
+
+			// The relayer always receives the tip
+			suite.Equal(relayerPre.Add(tip[0]), bk.GetBalance(suite.ctx, relayer, BNB_DENOM))
+			supplyPost, _ := suite.keeper.GetAssetSupply(suite.ctx, BNB_DENOM)
+			switch tc.direction {
+			case types.Incoming:
+				// The recipient receives the amount less the tip, the whole amount is minted
+				suite.Equal(recipientPre.Add(amount[0]).Sub(tip[0]), bk.GetBalance(suite.ctx, recipient, BNB_DENOM))
+				suite.Equal(supplyPre.CurrentSupply.Add(amount[0]), supplyPost.CurrentSupply)
+				suite.True(supplyPre.IncomingSupply.Sub(amount[0]).IsEqual(supplyPost.IncomingSupply))
+			case types.Outgoing:
+				// Only the amount less the tip leaves the chain
+				suite.Equal(recipientPre, bk.GetBalance(suite.ctx, recipient, BNB_DENOM))
+				suite.Equal(supplyPre.CurrentSupply.Sub(amount[0]).Add(tip[0]), supplyPost.CurrentSupply)
+				suite.True(supplyPre.OutgoingSupply.Sub(amount[0]).IsEqual(supplyPost.OutgoingSupply))
+			}
+
+			var tipAttribute bool
+			for _, ev := range res.Events {
+				if ev.Type != types.EventTypeClaimAtomicSwap {
+					continue
+				}
+				for _, attr := range ev.Attributes {
+					if string(attr.Key) == types.AttributeKeyClaimTip {
+						suite.Equal(tip.String(), string(attr.Value))
+						tipAttribute = true
+					}
+				}
+			}
+			suite.True(tipAttribute)
+		})
+	}
+}
+
+func (suite *AtomicSwapTestSuite) TestCreateAtomicSwapInvalidTip() {
+	testCases := []struct {
+		name string
+		tip  sdk.Coins
+	}{
+		{"tip equal to amount", cs(c(BNB_DENOM, 50000))},
+		{"tip in another denom", cs(c(OTHER_DENOM, 100))},
+	}
+
+	for i, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			_, err := suite.keeper.CreateAtomicSwapState(suite.ctx, suite.deputy, suite.addrs[1], types.MsgCreateAtomicSwap{
+				From:                suite.deputy.String(),
+				To:                  suite.addrs[1].String(),
+				RecipientOtherChain: TestRecipientOtherChain,
+				SenderOtherChain:    TestSenderOtherChain,
+				RandomNumberHash:    suite.randomNumberHashes[i],
+				Timestamp:           suite.timestamps[i],
+				Amount:              cs(c(BNB_DENOM, 50000)),
+				TimeSpanMin:         types.DefaultSwapTimeSpanMinutes,
+				ClaimTip:            tc.tip,
+			})
+			suite.Require().True(errors.Is(err, types.ErrInvalidClaimTip))
+		})
+	}
+}
+
+func (suite *AtomicSwapTestSuite) TestCreateAtomicSwapWithMetadata() {
+	_, err := suite.keeper.CreateAtomicSwapState(suite.ctx, suite.deputy, suite.addrs[1], types.MsgCreateAtomicSwap{
+		From:                suite.deputy.String(),
+		To:                  suite.addrs[1].String(),
+		RecipientOtherChain: TestRecipientOtherChain,
+		SenderOtherChain:    TestSenderOtherChain,
+		RandomNumberHash:    suite.randomNumberHashes[0],
+		Timestamp:           suite.timestamps[0],
+		Amount:              cs(c(BNB_DENOM, 50000)),
+		TimeSpanMin:         types.DefaultSwapTimeSpanMinutes,
+		Memo:                "invoice 42",
+		OtherChainTxHash:    "0xabc123",
+	})
+	suite.Require().NoError(err)
+
+	swapID := types.CalculateSwapID(suite.randomNumberHashes[0], suite.deputy, TestSenderOtherChain)
+	swap, found := suite.keeper.GetAtomicSwap(suite.ctx, swapID)
+	suite.Require().True(found)
+	suite.Equal("invoice 42", swap.Memo)
+	suite.Equal("0xabc123", swap.OtherChainTxHash)
+
+	attrs := map[string]string{}
+	for _, ev := range suite.ctx.EventManager().Events() {
+		if ev.Type != types.EventTypeCreateAtomicSwap {
+			continue
+		}
+		for _, attr := range ev.Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+	}
+	suite.Equal("invoice 42", attrs[types.AttributeKeyMemo])
+	suite.Equal("0xabc123", attrs[types.AttributeKeyOtherChainTxHash])
+
+	// Oversized memos are rejected
+	_, err = suite.keeper.CreateAtomicSwapState(suite.ctx, suite.deputy, suite.addrs[1], types.MsgCreateAtomicSwap{
+		From:                suite.deputy.String(),
+		To:                  suite.addrs[1].String(),
+		RecipientOtherChain: TestRecipientOtherChain,
+		SenderOtherChain:    TestSenderOtherChain,
+		RandomNumberHash:    suite.randomNumberHashes[1],
+		Timestamp:           suite.timestamps[1],
+		Amount:              cs(c(BNB_DENOM, 50000)),
+		TimeSpanMin:         types.DefaultSwapTimeSpanMinutes,
+		Memo:                strings.Repeat("m", types.MaxMemoLength+1),
+	})
+	suite.True(errors.Is(err, types.ErrInvalidSwapMetadata))
+}
+
+func (suite *AtomicSwapTestSuite) TestAnnotateSwap() {
+	_, err := suite.keeper.CreateAtomicSwapState(suite.ctx, suite.deputy, suite.addrs[1], types.MsgCreateAtomicSwap{
+		From:                suite.deputy.String(),
+		To:                  suite.addrs[1].String(),
+		RecipientOtherChain: TestRecipientOtherChain,
+		SenderOtherChain:    TestSenderOtherChain,
+		RandomNumberHash:    suite.randomNumberHashes[0],
+		Timestamp:           suite.timestamps[0],
+		Amount:              cs(c(BNB_DENOM, 50000)),
+		TimeSpanMin:         types.DefaultSwapTimeSpanMinutes,
+	})
+	suite.Require().NoError(err)
+	swapID := types.CalculateSwapID(suite.randomNumberHashes[0], suite.deputy, TestSenderOtherChain)
+
+	// Only the asset's deputy may annotate a swap
+	err = suite.keeper.AnnotateSwapState(suite.ctx, suite.addrs[1], swapID, "0xabc123")
+	suite.True(errors.Is(err, sdkerrors.ErrUnauthorized))
+
+	err = suite.keeper.AnnotateSwapState(suite.ctx, suite.deputy, suite.randomNumberHashes[1], "0xabc123")
+	suite.True(errors.Is(err, types.ErrAtomicSwapNotFound))
+
+	err = suite.keeper.AnnotateSwapState(suite.ctx, suite.deputy, swapID, "0xabc123")
+	suite.Require().NoError(err)
+	swap, found := suite.keeper.GetAtomicSwap(suite.ctx, swapID)
+	suite.Require().True(found)
+	suite.Equal("0xabc123", swap.OtherChainTxHash)
+
+	var annotated bool
+	for _, ev := range suite.ctx.EventManager().Events() {
+		if ev.Type == types.EventTypeAnnotateSwap {
+			annotated = true
+		}
+	}
+	suite.True(annotated)
+
+	// The hash cannot be changed once set
+	err = suite.keeper.AnnotateSwapState(suite.ctx, suite.deputy, swapID, "0xdef456")
+	suite.True(errors.Is(err, types.ErrSwapAlreadyAnnotated))
+}
+
+func (suite *AtomicSwapTestSuite) TestSwapStats() {
+	amount := cs(c(BNB_DENOM, 50000))
+	tip := cs(c(BNB_DENOM, 100))
+
+	// An incoming swap that is claimed
+	_, err := suite.keeper.CreateAtomicSwapState(suite.ctx, suite.deputy, suite.addrs[1], types.MsgCreateAtomicSwap{
+		From:                suite.deputy.String(),
+		To:                  suite.addrs[1].String(),
+		RecipientOtherChain: TestRecipientOtherChain,
+		SenderOtherChain:    TestSenderOtherChain,
+		RandomNumberHash:    suite.randomNumberHashes[0],
+		Timestamp:           suite.timestamps[0],
+		Amount:              amount,
+		TimeSpanMin:         types.DefaultSwapTimeSpanMinutes,
+	})
+	suite.Require().NoError(err)
+	incomingID := types.CalculateSwapID(suite.randomNumberHashes[0], suite.deputy, TestSenderOtherChain)
+	_, err = suite.keeper.ClaimAtomicSwapState(suite.ctx, suite.addrs[1], incomingID, suite.randomNumbers[0], nil)
+	suite.Require().NoError(err)
+
+	// An outgoing swap with a claim tip that is claimed
+	_, err = suite.keeper.CreateAtomicSwapState(suite.ctx, suite.addrs[2], suite.deputy, types.MsgCreateAtomicSwap{
+		From:                suite.addrs[2].String(),
+		To:                  suite.deputy.String(),
+		RecipientOtherChain: TestRecipientOtherChain,
+		SenderOtherChain:    TestSenderOtherChain,
+		RandomNumberHash:    suite.randomNumberHashes[1],
+		Timestamp:           suite.timestamps[1],
+		Amount:              amount,
+		TimeSpanMin:         types.DefaultSwapTimeSpanMinutes,
+		ClaimTip:            tip,
+	})
+	suite.Require().NoError(err)
+	outgoingID := types.CalculateSwapID(suite.randomNumberHashes[1], suite.addrs[2], TestSenderOtherChain)
+	_, err = suite.keeper.ClaimAtomicSwapState(suite.ctx, suite.addrs[3], outgoingID, suite.randomNumbers[1], nil)
+	suite.Require().NoError(err)
+
+	// An incoming swap that expires and is refunded the next day
+	_, err = suite.keeper.CreateAtomicSwapState(suite.ctx, suite.deputy, suite.addrs[1], types.MsgCreateAtomicSwap{
+		From:                suite.deputy.String(),
+		To:                  suite.addrs[1].String(),
+		RecipientOtherChain: TestRecipientOtherChain,
+		SenderOtherChain:    TestSenderOtherChain,
+		RandomNumberHash:    suite.randomNumberHashes[2],
+		Timestamp:           suite.timestamps[2],
+		Amount:              amount,
+		TimeSpanMin:         types.DefaultSwapTimeSpanMinutes,
+	})
+	suite.Require().NoError(err)
+	refundID := types.CalculateSwapID(suite.randomNumberHashes[2], suite.deputy, TestSenderOtherChain)
+	nextDay := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(24 * time.Hour))
+	bep3.BeginBlocker(nextDay, suite.keeper)
+	_, err = suite.keeper.RefundAtomicSwapState(nextDay, suite.deputy, refundID)
+	suite.Require().NoError(err)
+
+	stats, found := suite.keeper.GetSwapStats(nextDay, BNB_DENOM)
+	suite.Require().True(found)
+	suite.Equal(uint64(3), stats.Created)
+	suite.Equal(uint64(2), stats.Claimed)
+	suite.Equal(uint64(1), stats.Refunded)
+	suite.Equal(amount[0], stats.IncomingVolume)
+	suite.Equal(amount[0], stats.OutgoingVolume)
+	suite.Equal(c(BNB_DENOM, 1000), stats.Fees)
+	suite.Equal(tip[0], stats.ClaimTips)
+
+	today, found := suite.keeper.GetDailySwapStats(nextDay, BNB_DENOM, types.GetDayStart(suite.ctx.BlockTime()))
+	suite.Require().True(found)
+	suite.Equal(uint64(3), today.Stats.Created)
+	suite.Equal(uint64(0), today.Stats.Refunded)
+	tomorrow, found := suite.keeper.GetDailySwapStats(nextDay, BNB_DENOM, types.GetDayStart(nextDay.BlockTime()))
+	suite.Require().True(found)
+	suite.Equal(uint64(0), tomorrow.Stats.Created)
+	suite.Equal(uint64(1), tomorrow.Stats.Refunded)
+
+	// Daily stats are deleted once they are older than the retention, while the cumulative stats are kept
+	retentionEnd := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(types.DailySwapStatsRetentionDays * 24 * time.Hour))
+	suite.keeper.DeleteExpiredDailySwapStats(retentionEnd)
+	suite.Len(suite.keeper.GetAllDailySwapStats(retentionEnd), 2)
+	suite.keeper.DeleteExpiredDailySwapStats(retentionEnd.WithBlockTime(retentionEnd.BlockTime().Add(24 * time.Hour)))
+	suite.Equal([]types.DailySwapStats{tomorrow}, suite.keeper.GetAllDailySwapStats(retentionEnd))
+	_, found = suite.keeper.GetSwapStats(retentionEnd, BNB_DENOM)
+	suite.True(found)
+}
+
+func (suite *AtomicSwapTestSuite) TestBatchClaimAtomicSwaps() {
+	testCases := []struct {
+		name   string
+		atomic bool
+	}{
+		{"atomic", true},
+		{"best effort", false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			var claims []types.BatchClaimItem
+			for i := 0; i < 3; i++ {
+				_, err := suite.keeper.CreateAtomicSwapState(suite.ctx, suite.deputy, suite.addrs[5], types.MsgCreateAtomicSwap{
+					From:                suite.deputy.String(),
+					To:                  suite.addrs[5].String(),
+					RecipientOtherChain: TestRecipientOtherChain,
+					SenderOtherChain:    TestSenderOtherChain,
+					RandomNumberHash:    suite.randomNumberHashes[i],
+					Timestamp:           suite.timestamps[i],
+					Amount:              cs(c(BNB_DENOM, 50000)),
+					TimeSpanMin:         types.DefaultSwapTimeSpanMinutes,
+				})
+				suite.Require().NoError(err)
+				swapID := types.CalculateSwapID(suite.randomNumberHashes[i], suite.deputy, TestSenderOtherChain)
+				claims = append(claims, types.NewBatchClaimItem(swapID, suite.randomNumbers[i]))
+			}
+			// The second claim uses the wrong random number
+			claims[1].RandomNumber = suite.randomNumbers[3]
+
+			results, err := suite.keeper.BatchClaimAtomicSwapsState(suite.ctx, suite.addrs[5], claims, tc.atomic)
+
+			expectedStatus := []types.SwapStatus{types.Completed, types.Open, types.Completed}
+			if tc.atomic {
+				suite.Require().True(errors.Is(err, types.ErrInvalidClaimSecret))
+				suite.Nil(results)
+				expectedStatus = []types.SwapStatus{types.Open, types.Open, types.Open}
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Len(results, 3)
+				suite.True(results[0].Success)
+				suite.False(results[1].Success)
+				suite.NotEmpty(results[1].Error)
+				suite.True(results[2].Success)
+				suite.Equal(hex.EncodeToString(claims[1].SwapID), results[1].SwapID)
+			}
+
+			for i, claim := range claims {
+				swap, found := suite.keeper.GetAtomicSwap(suite.ctx, claim.SwapID)
+				suite.Require().True(found)
+				suite.Equal(expectedStatus[i], swap.Status)
+			}
+		})
+	}
+}
+
+func (suite *AtomicSwapTestSuite) TestBatchRefundAtomicSwaps() {
+	testCases := []struct {
+		name   string
+		atomic bool
+	}{
+		{"atomic", true},
+		{"best effort", false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			var swapIDs [][]byte
+			for i := 0; i < 2; i++ {
+				_, err := suite.keeper.CreateAtomicSwapState(suite.ctx, suite.deputy, suite.addrs[5], types.MsgCreateAtomicSwap{
+					From:                suite.deputy.String(),
+					To:                  suite.addrs[5].String(),
+					RecipientOtherChain: TestRecipientOtherChain,
+					SenderOtherChain:    TestSenderOtherChain,
+					RandomNumberHash:    suite.randomNumberHashes[i],
+					Timestamp:           suite.timestamps[i],
+					Amount:              cs(c(BNB_DENOM, 50000)),
+					TimeSpanMin:         types.DefaultSwapTimeSpanMinutes,
+				})
+				suite.Require().NoError(err)
+				swapIDs = append(swapIDs, types.CalculateSwapID(suite.randomNumberHashes[i], suite.deputy, TestSenderOtherChain))
+			}
+			// An unknown swap in the middle of the batch
+			unknown := types.CalculateSwapID(suite.randomNumberHashes[5], suite.deputy, TestSenderOtherChain)
+			swapIDs = [][]byte{swapIDs[0], unknown, swapIDs[1]}
+
+			refundCtx := suite.getContextPlusMinutes(bep3.DefaultSwapTimeSpanMinutes + 1)
+			bep3.BeginBlocker(refundCtx, suite.keeper)
+
+			results, err := suite.keeper.BatchRefundAtomicSwapsState(refundCtx, suite.deputy, swapIDs, tc.atomic)
+
+			expectedStatus := types.Completed
+			if tc.atomic {
+				suite.Require().True(errors.Is(err, types.ErrAtomicSwapNotFound))
+				expectedStatus = types.Expired
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Len(results, 3)
+				suite.True(results[0].Success)
+				suite.False(results[1].Success)
+				suite.True(results[2].Success)
+			}
+
+			for _, swapID := range [][]byte{swapIDs[0], swapIDs[2]} {
+				swap, found := suite.keeper.GetAtomicSwap(refundCtx, swapID)
+				suite.Require().True(found)
+				suite.Equal(expectedStatus, swap.Status)
+			}
+		})
+	}
+}
+
 // getContextPlusMinutes returns a context forward or backward in time and block
 // index. Assuming 1 second finality.
 func (suite *AtomicSwapTestSuite) getContextPlusMinutes(plusMinutes int64) sdk.Context {
@@ -751,9 +1226,16 @@ func (suite *AtomicSwapTestSuite) TestRefundAtomicSwap() {
 				suite.Nil(err)
 			}
 
-			_, err := suite.keeper.CreateAtomicSwapState(suite.ctx, suite.randomNumberHashes[i], suite.timestamps[i],
-				types.DefaultSwapTimeSpanMinutes, sender, expectedRecipient, TestSenderOtherChain, TestRecipientOtherChain,
-				expectedRefundAmount, true)
+			_, err := suite.keeper.CreateAtomicSwapState(suite.ctx, sender, expectedRecipient, types.MsgCreateAtomicSwap{
+				From:                sender.String(),
+				To:                  expectedRecipient.String(),
+				RecipientOtherChain: TestRecipientOtherChain,
+				SenderOtherChain:    TestSenderOtherChain,
+				RandomNumberHash:    suite.randomNumberHashes[i],
+				Timestamp:           suite.timestamps[i],
+				Amount:              expectedRefundAmount,
+				TimeSpanMin:         types.DefaultSwapTimeSpanMinutes,
+			})
 			suite.NoError(err)
 
 			realSwapID := types.CalculateSwapID(suite.randomNumberHashes[i], sender, TestSenderOtherChain)
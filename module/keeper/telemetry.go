@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/bep3/module/types"
+)
+
+// incrSwapCounter counts a swap event, e.g. types.MetricKeySwapsCreated, labelled by the swap's denom and
+// direction. Swaps created by a dry run are not counted.
+func incrSwapCounter(ctx sdk.Context, event string, swap types.AtomicSwap) {
+	if swap.Amount.Empty() || isDryRun(ctx) {
+		return
+	}
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, types.MetricKeySwaps, event},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel(types.MetricLabelDenom, swap.Amount[0].Denom),
+			telemetry.NewLabel(types.MetricLabelDirection, swap.Direction.String()),
+		},
+	)
+}
+
+// EmitSupplyMetrics sets the supply gauges of every asset and the time-limited supply utilization of
+// time limited assets.
+func (k Keeper) EmitSupplyMetrics(ctx sdk.Context) {
+	assets, found := k.GetAssets(ctx)
+	if !found {
+		return
+	}
+	for _, asset := range assets {
+		supply, found := k.GetAssetSupply(ctx, asset.Denom)
+		if !found {
+			continue
+		}
+		labels := []metrics.Label{telemetry.NewLabel(types.MetricLabelDenom, asset.Denom)}
+		setSupplyGauge(types.MetricKeyCurrentSupply, supply.CurrentSupply.Amount, labels)
+		setSupplyGauge(types.MetricKeyIncomingSupply, supply.IncomingSupply.Amount, labels)
+		setSupplyGauge(types.MetricKeyOutgoingSupply, supply.OutgoingSupply.Amount, labels)
+
+		if asset.SupplyLimit.TimeLimited && asset.SupplyLimit.TimeBasedLimit.IsPositive() {
+			utilization := supply.TimeLimitedCurrentSupply.Amount.ToDec().Quo(asset.SupplyLimit.TimeBasedLimit.ToDec())
+			telemetry.SetGaugeWithLabels(
+				[]string{types.ModuleName, types.MetricKeyTimeLimitedUtilization},
+				float32(decToFloat64(utilization)),
+				labels,
+			)
+		}
+	}
+}
+
+// EmitBacklogMetrics sets the number of open swaps that have not been claimed or expired yet.
+func (k Keeper) EmitBacklogMetrics(ctx sdk.Context) {
+	telemetry.ModuleSetGauge(types.ModuleName, float32(k.GetOpenSwapCount(ctx)), types.MetricKeyOpenSwaps)
+}
+
+func setSupplyGauge(key string, amount sdk.Int, labels []metrics.Label) {
+	telemetry.SetGaugeWithLabels([]string{types.ModuleName, key}, float32(decToFloat64(amount.ToDec())), labels)
+}
+
+// decToFloat64 converts d for reporting. Precision beyond a float64 is irrelevant for metrics.
+func decToFloat64(d sdk.Dec) float64 {
+	f, err := strconv.ParseFloat(d.String(), 64)
+	if err != nil {
+		return math.MaxFloat64
+	}
+	return f
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/armon/go-metrics"
+	bep3 "github.com/e-money/bep3/module"
+	"github.com/e-money/bep3/module/types"
+)
+
+func (suite *AtomicSwapTestSuite) TestTelemetry() {
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	config := metrics.DefaultConfig("")
+	config.EnableHostname = false
+	config.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(config, sink)
+	suite.Require().NoError(err)
+	defer metrics.NewGlobal(config, &metrics.BlackholeSink{}) //nolint:errcheck
+
+	amount := cs(c(BNB_DENOM, 50000))
+
+	// An incoming swap that is claimed and one that expires
+	_, err = suite.keeper.CreateAtomicSwapState(suite.ctx, suite.deputy, suite.addrs[1], types.MsgCreateAtomicSwap{
+		From:                suite.deputy.String(),
+		To:                  suite.addrs[1].String(),
+		RecipientOtherChain: TestRecipientOtherChain,
+		SenderOtherChain:    TestSenderOtherChain,
+		RandomNumberHash:    suite.randomNumberHashes[0],
+		Timestamp:           suite.timestamps[0],
+		Amount:              amount,
+		TimeSpanMin:         types.DefaultSwapTimeSpanMinutes,
+	})
+	suite.Require().NoError(err)
+	claimID := types.CalculateSwapID(suite.randomNumberHashes[0], suite.deputy, TestSenderOtherChain)
+	_, err = suite.keeper.ClaimAtomicSwapState(suite.ctx, suite.addrs[1], claimID, suite.randomNumbers[0], nil)
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.CreateAtomicSwapState(suite.ctx, suite.deputy, suite.addrs[1], types.MsgCreateAtomicSwap{
+		From:                suite.deputy.String(),
+		To:                  suite.addrs[1].String(),
+		RecipientOtherChain: TestRecipientOtherChain,
+		SenderOtherChain:    TestSenderOtherChain,
+		RandomNumberHash:    suite.randomNumberHashes[1],
+		Timestamp:           suite.timestamps[1],
+		Amount:              amount,
+		TimeSpanMin:         types.DefaultSwapTimeSpanMinutes,
+	})
+	suite.Require().NoError(err)
+
+	bep3.BeginBlocker(suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(24*time.Hour)), suite.keeper)
+
+	data := sink.Data()
+	suite.Require().NotEmpty(data)
+	interval := data[len(data)-1]
+
+	suite.Equal(2, interval.Counters["bep3.swaps.created;denom=bnb;direction=Incoming"].Count)
+	suite.Equal(1, interval.Counters["bep3.swaps.claimed;denom=bnb;direction=Incoming"].Count)
+	suite.Equal(1, interval.Counters["bep3.swaps.expired;denom=bnb;direction=Incoming"].Count)
+
+	suite.Equal(float32(50000), interval.Gauges["bep3.current_supply;denom=bnb"].Value)
+	suite.Equal(float32(50000), interval.Gauges["bep3.incoming_supply;denom=bnb"].Value)
+	suite.Equal(float32(0), interval.Gauges["bep3.outgoing_supply;denom=bnb"].Value)
+	suite.Equal(float32(0), interval.Gauges["bep3.open_swaps"].Value)
+	suite.Contains(interval.Samples, "begin_blocker;module=bep3")
+}
@@ -0,0 +1,7 @@
+// +build !legacy
+
+package bep3
+
+// legacyEndpoints enables the amino querier route and the REST routes of the module. Legacy endpoints are
+// opt-in and compiled with `-tags legacy`.
+const legacyEndpoints = false
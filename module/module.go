@@ -15,6 +15,7 @@ import (
 	"github.com/e-money/bep3/module/client/cli"
 	"github.com/e-money/bep3/module/client/rest"
 	"github.com/e-money/bep3/module/keeper"
+	"github.com/e-money/bep3/module/simulation"
 	bep3types "github.com/e-money/bep3/module/types"
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -29,9 +30,7 @@ var (
 )
 
 // AppModuleBasic defines the basic application module used by the bep3 module.
-type AppModuleBasic struct {
-	cdc *codec.LegacyAmino
-}
+type AppModuleBasic struct{}
 
 // Name returns the bep3 module's name.
 func (AppModuleBasic) Name() string {
@@ -39,9 +38,8 @@ func (AppModuleBasic) Name() string {
 }
 
 // RegisterLegacyAminoCodec registers the bep3 module's types for Amino.
-func (ab AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	bep3types.RegisterLegacyAminoCodec(cdc)
-	ab.cdc = cdc
 }
 
 func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -64,8 +62,11 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONMarshaler, config client.TxE
 	return gs.Validate()
 }
 
-// RegisterRESTRoutes registers the REST routes for the bep3 module.
+// RegisterRESTRoutes registers the REST routes for the bep3 module when built with legacy endpoints.
 func (AppModuleBasic) RegisterRESTRoutes(ctx client.Context, rtr *mux.Router) {
+	if !legacyEndpoints {
+		return
+	}
 	rest.RegisterRoutes(ctx, rtr)
 }
 
@@ -113,7 +114,9 @@ func (AppModule) Name() string {
 }
 
 // RegisterInvariants registers the bep3 module invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // Route returns the message routing key for the bep3 module.
 func (am AppModule) Route() sdk.Route {
@@ -125,8 +128,12 @@ func (am AppModule) NewHandler() sdk.Handler {
 	return NewHandler(am.keeper)
 }
 
-// QuerierRoute returns the bep3 module's querier route name.
+// QuerierRoute returns the bep3 module's querier route name. It is empty, and the legacy querier not
+// registered, unless the module is built with legacy endpoints.
 func (AppModule) QuerierRoute() string {
+	if !legacyEndpoints {
+		return ""
+	}
 	return QuerierRoute
 }
 
@@ -172,27 +179,28 @@ func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.Valid
 
 // WeightedOperations returns the all the bep3 module operations with their respective weights.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []sdksim.WeightedOperation {
-	return WeightedOperations(simState.AppParams, simState.Cdc, am.accountKeeper, am.bankKeeper, am.keeper)
+	return simulation.WeightedOperations(simState.AppParams, simState.Cdc, am.accountKeeper, am.bankKeeper, am.keeper)
 }
 
 //____________________________________________________________________________
 
 // GenerateGenesisState creates a randomized GenState of the bep3 module
 func (AppModuleBasic) GenerateGenesisState(simState *module.SimulationState) {
-	RandomizedGenState(simState)
+	simulation.RandomizedGenState(simState)
 }
 
-// ProposalContents doesn't return any content functions for governance proposals.
-func (AppModuleBasic) ProposalContents(_ module.SimulationState) []sdksim.WeightedProposalContent {
-	return nil
+// ProposalContents returns the param change proposals that add and deactivate assets during simulation.
+func (am AppModule) ProposalContents(_ module.SimulationState) []sdksim.WeightedProposalContent {
+	return simulation.ProposalContents(am.keeper)
 }
 
 // RegisterStoreDecoder registers a decoder for bep3 module's types
-func (ab AppModuleBasic) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
-	sdr[StoreKey] = NewDecodeStore(ab.cdc)
+// The stored values contain no interfaces, so a codec without registered interfaces decodes all of them.
+func (AppModuleBasic) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
+	sdr[StoreKey] = simulation.NewDecodeStore(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()))
 }
 
-// RandomizedParams returns nil because bep3 has no params.
+// RandomizedParams creates randomized bep3 param changes for the simulator.
 func (AppModuleBasic) RandomizedParams(r *rand.Rand) []sdksim.ParamChange {
-	return ParamChanges(r)
+	return simulation.ParamChanges(r)
 }
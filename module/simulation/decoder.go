@@ -0,0 +1,95 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/e-money/bep3/module/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding type.
+func NewDecodeStore(cdc codec.BinaryMarshaler) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.AtomicSwapKeyPrefix):
+			var swapA, swapB types.AtomicSwap
+			cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &swapA)
+			cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &swapB)
+			return fmt.Sprintf("%v\n%v", swapA, swapB)
+
+		// The swap indexes store the ID of the indexed swap
+		case bytes.Equal(kvA.Key[:1], types.AtomicSwapByBlockPrefix),
+			bytes.Equal(kvA.Key[:1], types.AtomicSwapLongtermStoragePrefix),
+			bytes.Equal(kvA.Key[:1], types.AtomicSwapByRandomNumberHashPrefix):
+			return fmt.Sprintf("%s\n%s", tmbytes.HexBytes(kvA.Value), tmbytes.HexBytes(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.AssetSupplyPrefix):
+			var supplyA, supplyB types.AssetSupply
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &supplyA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &supplyB)
+			return fmt.Sprintf("%s\n%s", supplyA, supplyB)
+
+		case bytes.Equal(kvA.Key[:1], types.PreviousBlockTimeKey):
+			var timeA, timeB types.PrevBlockTime
+			cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &timeA)
+			cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &timeB)
+			return fmt.Sprintf("%s\n%s", timeA.Val, timeB.Val)
+
+		case bytes.Equal(kvA.Key[:1], types.DenyListPrefix):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.SwapStatsPrefix):
+			var statsA, statsB types.SwapStats
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &statsA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &statsB)
+			return fmt.Sprintf("%s\n%s", statsA, statsB)
+
+		case bytes.Equal(kvA.Key[:1], types.DailySwapStatsPrefix):
+			var dailyA, dailyB types.DailySwapStats
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &dailyA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &dailyB)
+			return fmt.Sprintf("%d %s\n%d %s", dailyA.Day, dailyA.Stats, dailyB.Day, dailyB.Stats)
+
+		case bytes.Equal(kvA.Key[:1], types.DeputyActivityPrefix):
+			var activityA, activityB types.DeputyActivity
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &activityA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &activityB)
+			return fmt.Sprintf("%v\n%v", activityA, activityB)
+
+		case bytes.Equal(kvA.Key[:1], types.AssetSuspensionPrefix):
+			var suspensionA, suspensionB types.AssetSuspension
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &suspensionA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &suspensionB)
+			return fmt.Sprintf("%v\n%v", suspensionA, suspensionB)
+
+		case bytes.Equal(kvA.Key[:1], types.DeputyBondPrefix):
+			var bondA, bondB types.DeputyBond
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &bondA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &bondB)
+			return fmt.Sprintf("%v\n%v", bondA, bondB)
+
+		case bytes.Equal(kvA.Key[:1], types.DeputyUnbondingQueuePrefix):
+			var unbondingA, unbondingB types.DeputyUnbonding
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &unbondingA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &unbondingB)
+			return fmt.Sprintf("%v\n%v", unbondingA, unbondingB)
+
+		case bytes.Equal(kvA.Key[:1], types.LightClientStatePrefix):
+			var stateA, stateB types.LightClientState
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &stateA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &stateB)
+			return fmt.Sprintf("%v\n%v", stateA, stateB)
+
+		case bytes.Equal(kvA.Key[:1], types.OpenSwapCountKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		default:
+			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
+		}
+	}
+}
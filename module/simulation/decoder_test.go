@@ -1,4 +1,4 @@
-package simulation
+package simulation_test
 
 import (
 	"fmt"
@@ -6,42 +6,50 @@ import (
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
-	bep3 "github.com/e-money/bep3/module"
+	"github.com/e-money/bep3/module/simulation"
 	"github.com/e-money/bep3/module/types"
 	"github.com/stretchr/testify/require"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 )
 
-func makeTestCodec() (cdc *codec.LegacyAmino) {
-	encConfig := bep3.MakeProtoEncodingConfig()
-	// encConfig := bep3.MakeAminoEncodingConfig()
-
-	return encConfig.Amino
-}
-
 func TestDecodeBep3Store(t *testing.T) {
-	cdc := makeTestCodec()
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
 	prevBlockTime := time.Now().UTC()
 
 	oneCoin := sdk.NewCoin("coin", sdk.OneInt())
 	swap := types.NewAtomicSwap(sdk.Coins{oneCoin}, nil, 10, 100,
 		nil, nil, "otherChainSender", "otherChainRec",
 		200, types.Completed, true, types.Outgoing)
-	supply := types.AssetSupply{
-		IncomingSupply: oneCoin, OutgoingSupply: oneCoin, CurrentSupply: oneCoin,
-		TimeLimitedCurrentSupply: oneCoin, TimeElapsed: 0,
-	}
-	bz := tmbytes.HexBytes([]byte{1, 2})
+	supply := types.NewAssetSupply(oneCoin, oneCoin, oneCoin, oneCoin, 0)
+	stats := types.NewSwapStats("coin")
+	dailyStats := types.DailySwapStats{Day: 86400, Stats: stats}
+	activity := types.NewDeputyActivity("deputy", 5, prevBlockTime)
+	suspension := types.AssetSuspension{Denom: "coin", Height: 7}
+	bond := types.NewDeputyBond("deputy", sdk.Coins{oneCoin})
+	unbonding := types.NewDeputyUnbonding("deputy", sdk.Coins{oneCoin}, prevBlockTime)
+	lightClient := types.NewLightClientState("coin", "other-chain", 9, []byte{3, 4}, nil)
+	swapID := tmbytes.HexBytes([]byte{1, 2})
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
-			{Key: types.AtomicSwapKeyPrefix, Value: cdc.MustMarshalBinaryLengthPrefixed(swap)},
-			{Key: types.AssetSupplyPrefix, Value: cdc.MustMarshalBinaryLengthPrefixed(supply)},
-			{Key: types.AtomicSwapByBlockPrefix, Value: bz},
-			{Key: types.AtomicSwapByBlockPrefix, Value: bz},
-			{Key: types.PreviousBlockTimeKey, Value: cdc.MustMarshalBinaryLengthPrefixed(prevBlockTime)},
+			{Key: types.AtomicSwapKeyPrefix, Value: cdc.MustMarshalBinaryLengthPrefixed(&swap)},
+			{Key: types.AtomicSwapByBlockPrefix, Value: swapID},
+			{Key: types.AtomicSwapLongtermStoragePrefix, Value: swapID},
+			{Key: types.AssetSupplyPrefix, Value: cdc.MustMarshalBinaryBare(&supply)},
+			{Key: types.PreviousBlockTimeKey, Value: cdc.MustMarshalBinaryLengthPrefixed(&types.PrevBlockTime{Val: prevBlockTime})},
+			{Key: types.DenyListPrefix, Value: []byte("otherChainSender")},
+			{Key: types.AtomicSwapByRandomNumberHashPrefix, Value: swapID},
+			{Key: types.SwapStatsPrefix, Value: cdc.MustMarshalBinaryBare(&stats)},
+			{Key: types.DailySwapStatsPrefix, Value: cdc.MustMarshalBinaryBare(&dailyStats)},
+			{Key: types.DeputyActivityPrefix, Value: cdc.MustMarshalBinaryBare(&activity)},
+			{Key: types.AssetSuspensionPrefix, Value: cdc.MustMarshalBinaryBare(&suspension)},
+			{Key: types.DeputyBondPrefix, Value: cdc.MustMarshalBinaryBare(&bond)},
+			{Key: types.DeputyUnbondingQueuePrefix, Value: cdc.MustMarshalBinaryBare(&unbonding)},
+			{Key: types.LightClientStatePrefix, Value: cdc.MustMarshalBinaryBare(&lightClient)},
+			{Key: types.OpenSwapCountKey, Value: sdk.Uint64ToBigEndian(3)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -51,14 +59,23 @@ func TestDecodeBep3Store(t *testing.T) {
 		expectedLog string
 	}{
 		{"AtomicSwap", fmt.Sprintf("%v\n%v", swap, swap)},
-		{"AssetSupply", fmt.Sprintf("%v\n%v", supply, supply)},
-		{"AtomicSwapByBlock", fmt.Sprintf("%s\n%s", bz, bz)},
-		{"AtomicSwapLongtermStorage", fmt.Sprintf("%s\n%s", bz, bz)},
+		{"AtomicSwapByBlock", fmt.Sprintf("%s\n%s", swapID, swapID)},
+		{"AtomicSwapLongtermStorage", fmt.Sprintf("%s\n%s", swapID, swapID)},
+		{"AssetSupply", fmt.Sprintf("%s\n%s", supply, supply)},
 		{"PreviousBlockTime", fmt.Sprintf("%s\n%s", prevBlockTime, prevBlockTime)},
+		{"DenyList", "otherChainSender\notherChainSender"},
+		{"AtomicSwapByRandomNumberHash", fmt.Sprintf("%s\n%s", swapID, swapID)},
+		{"SwapStats", fmt.Sprintf("%s\n%s", stats, stats)},
+		{"DailySwapStats", fmt.Sprintf("86400 %s\n86400 %s", stats, stats)},
+		{"DeputyActivity", fmt.Sprintf("%v\n%v", activity, activity)},
+		{"AssetSuspension", fmt.Sprintf("%v\n%v", suspension, suspension)},
+		{"DeputyBond", fmt.Sprintf("%v\n%v", bond, bond)},
+		{"DeputyUnbonding", fmt.Sprintf("%v\n%v", unbonding, unbonding)},
+		{"LightClientState", fmt.Sprintf("%v\n%v", lightClient, lightClient)},
+		{"OpenSwapCount", "3\n3"},
 		{"other", ""},
 	}
-
-	decodeStore := bep3.NewDecodeStore(cdc)
+	decodeStore := simulation.NewDecodeStore(cdc)
 
 	for i, tt := range tests {
 		i, tt := i, tt
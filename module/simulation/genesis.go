@@ -0,0 +1,186 @@
+package simulation
+
+import (
+	"math/rand"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/e-money/bep3/module/types"
+)
+
+var (
+	ConsistentDenoms   = [3]string{"bnb", "xrp", "btc"}
+	MaxSupplyLimit     = 1000000000000
+	MinSupplyLimit     = 100000000
+	MinSwapAmountLimit = 999
+	accs               []simtypes.Account
+	MinBlockLock       = uint64(5)
+	// MaxSimAssets bounds the number of assets, which are all encoded in each asset param change proposal
+	MaxSimAssets = 10
+)
+
+// RandomizedGenState generates a random GenesisState
+// https://github.com/cosmos/cosmos-sdk/blob/1c6e2679641d0892a3f35d778d7c2316a2937a7c/x/bank/simulation/genesis.go#L54
+func RandomizedGenState(simState *module.SimulationState) {
+	accs = simState.Accounts
+
+	bep3Genesis := loadRandomBep3GenState(simState)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&bep3Genesis)
+
+	// Update bank supply to match amount of coins in auth
+	bankGenesis, totalCoins := loadBankGenState(simState, bep3Genesis)
+
+	for _, deputyCoin := range totalCoins {
+		bankGenesis.Supply = bankGenesis.Supply.Add(deputyCoin...)
+	}
+
+	simState.GenState[banktypes.ModuleName] = simState.Cdc.MustMarshalJSON(&bankGenesis)
+}
+
+func loadBankGenState(simState *module.SimulationState, bep3Genesis types.GenesisState) (banktypes.GenesisState, []sdk.Coins) {
+	var bankGenesis banktypes.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[banktypes.ModuleName], &bankGenesis)
+
+	var totalCoins []sdk.Coins
+
+	// Load total limit of each supported asset to deputy's account, which already holds the balance of a
+	// simulation account
+	for _, asset := range bep3Genesis.Params.AssetParams {
+		assetCoin := sdk.NewCoins(sdk.NewCoin(asset.Denom, asset.SupplyLimit.Limit))
+		bankGenesis.Balances = addGenesisBalance(bankGenesis.Balances, asset.DeputyAddress, assetCoin)
+		totalCoins = append(totalCoins, assetCoin)
+	}
+
+	return bankGenesis, totalCoins
+}
+
+func addGenesisBalance(balances []banktypes.Balance, address string, coins sdk.Coins) []banktypes.Balance {
+	for i, balance := range balances {
+		if balance.Address == address {
+			balances[i].Coins = balance.Coins.Add(coins...)
+			return balances
+		}
+	}
+	return append(balances, banktypes.Balance{Address: address, Coins: coins})
+}
+
+// GenSupportedAssets gets randomized SupportedAssets
+func GenSupportedAssets(r *rand.Rand) types.AssetParams {
+	numAssets := r.Intn(MaxSimAssets/2) + 1
+	assets := make(types.AssetParams, numAssets+1)
+	for i := 0; i < numAssets; i++ {
+		denom := strings.ToLower(simtypes.RandStringOfLength(r, r.Intn(3)+3))
+		asset := genSupportedAsset(r, denom)
+		assets[i] = asset
+	}
+	// Add bnb, btc, or xrp as a supported asset for interactions with other modules
+	assets[len(assets)-1] = genSupportedAsset(r, ConsistentDenoms[r.Intn(3)])
+
+	return assets
+}
+
+func genSupportedAsset(r *rand.Rand, denom string) types.AssetParam {
+	coinID, _ := simtypes.RandPositiveInt(r, sdk.NewInt(100000))
+	limit := GenSupplyLimit(r, MaxSupplyLimit)
+
+	minSwapAmount := GenMinSwapAmount(r)
+	timeLimited := r.Float32() < 0.5
+	timeBasedLimit := sdk.ZeroInt()
+	if timeLimited {
+		// set time-based limit to between 10 and 25% of the total limit
+		min := int(limit.Quo(sdk.NewInt(10)).Int64())
+		max := int(limit.Quo(sdk.NewInt(4)).Int64())
+		timeBasedLimit = sdk.NewInt(int64(simtypes.RandIntBetween(r, min, max)))
+	}
+	return types.AssetParam{
+		Denom:  denom,
+		CoinID: coinID.Int64(),
+		SupplyLimit: types.SupplyLimit{
+			Limit:          limit,
+			TimeLimited:    timeLimited,
+			TimePeriod:     int64(time.Hour * 24),
+			TimeBasedLimit: timeBasedLimit,
+		},
+		Active:          true,
+		DeputyAddress:   GenRandBnbDeputy(r).Address.String(),
+		FixedFee:        GenRandFixedFee(r),
+		MinSwapAmount:   minSwapAmount,
+		MaxSwapAmount:   GenMaxSwapAmount(r, minSwapAmount, limit),
+		SwapTimestamp:   time.Now().Unix(),
+		SwapTimeSpanMin: int64(simtypes.RandIntBetween(r, 60, int(types.ThreeDayMinutes)+1)),
+	}
+}
+
+func loadRandomBep3GenState(simState *module.SimulationState) types.GenesisState {
+	supportedAssets := GenSupportedAssets(simState.Rand)
+	supplies := types.AssetSupplies{}
+	for _, asset := range supportedAssets {
+		supply := GenAssetSupply(simState.Rand, asset.Denom)
+		supplies.AssetSupplies = append(supplies.AssetSupplies, supply)
+	}
+
+	bep3Genesis := types.GenesisState{
+		Params: types.Params{
+			AssetParams:              supportedAssets,
+			LongtermStorageRetention: types.DefaultLongtermStorageRetention,
+		},
+		Supplies:          supplies,
+		PreviousBlockTime: types.DefaultPreviousBlockTime,
+	}
+
+	return bep3Genesis
+}
+
+// GenSupplyLimit generates a random SupplyLimit
+func GenSupplyLimit(r *rand.Rand, max int) sdk.Int {
+	max = simtypes.RandIntBetween(r, MinSupplyLimit, max)
+	return sdk.NewInt(int64(max))
+}
+
+// GenSupplyLimit generates a random SupplyLimit
+func GenAssetSupply(r *rand.Rand, denom string) types.AssetSupply {
+	return types.NewAssetSupply(
+		sdk.NewCoin(denom, sdk.ZeroInt()), sdk.NewCoin(denom, sdk.ZeroInt()),
+		sdk.NewCoin(denom, sdk.ZeroInt()), sdk.NewCoin(denom, sdk.ZeroInt()), 0)
+}
+
+// GenMinBlockLock randomized MinBlockLock
+func GenMinBlockLock(r *rand.Rand) uint64 {
+	return MinBlockLock
+}
+
+// GenMaxBlockLock randomized MaxBlockLock
+func GenMaxBlockLock(r *rand.Rand, minBlockLock uint64) uint64 {
+	max := int(50)
+	return uint64(r.Intn(max-int(MinBlockLock)) + int(MinBlockLock+1))
+}
+
+// GenRandBnbDeputy randomized BnbDeputyAddress
+func GenRandBnbDeputy(r *rand.Rand) simtypes.Account {
+	acc, _ := simtypes.RandomAcc(r, accs)
+	return acc
+}
+
+// GenRandFixedFee randomized FixedFee in range [1, 10000]
+func GenRandFixedFee(r *rand.Rand) sdk.Int {
+	min := int(1)
+	max := types.DeputyFee
+	return sdk.NewInt(int64(r.Intn(int(max)-min) + min))
+}
+
+// GenMinSwapAmount randomized MinAmount in range [1, 1000]
+func GenMinSwapAmount(r *rand.Rand) sdk.Int {
+	return sdk.OneInt().Add(simtypes.RandomAmount(r, sdk.NewInt(int64(MinSwapAmountLimit))))
+}
+
+// GenMaxSwapAmount randomized MaxAmount
+func GenMaxSwapAmount(r *rand.Rand, minAmount sdk.Int, supplyMax sdk.Int) sdk.Int {
+	min := minAmount.Int64()
+	max := supplyMax.Quo(sdk.NewInt(100)).Int64()
+
+	return sdk.NewInt((int64(r.Intn(int(max-min))) + min))
+}
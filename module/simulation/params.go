@@ -0,0 +1,27 @@
+package simulation
+
+import (
+	"math/rand"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	simmod "github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/e-money/bep3/module/types"
+)
+
+const keyAssetParams = "AssetParams"
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simmod.NewSimParamChange(types.ModuleName, keyAssetParams,
+			func(r *rand.Rand) string {
+				return marshalAssetParams(GenSupportedAssets(r))
+			},
+		),
+	}
+}
+
+// marshalAssetParams encodes asset params as a param change value, which the params keeper decodes with amino
+func marshalAssetParams(assets types.AssetParams) string {
+	return string(types.ModuleCdc.LegacyAmino.MustMarshalJSON([]types.AssetParam(assets)))
+}
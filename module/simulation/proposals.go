@@ -0,0 +1,90 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	simmod "github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/e-money/bep3/module/keeper"
+	"github.com/e-money/bep3/module/types"
+)
+
+// Simulation proposal weights constants
+const (
+	OpWeightAddAssetProposal        = "op_weight_add_asset_proposal"
+	OpWeightDeactivateAssetProposal = "op_weight_deactivate_asset_proposal"
+)
+
+// ProposalContents returns the param change proposals that add an asset and deactivate an asset, so that
+// swaps are simulated while the supported assets change.
+func ProposalContents(k keeper.Keeper) []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simmod.NewWeightedProposalContent(
+			OpWeightAddAssetProposal,
+			simappparams.DefaultWeightParamChangeProposal,
+			SimulateAddAssetProposalContent(k),
+		),
+		simmod.NewWeightedProposalContent(
+			OpWeightDeactivateAssetProposal,
+			simappparams.DefaultWeightParamChangeProposal,
+			SimulateDeactivateAssetProposalContent(k),
+		),
+	}
+}
+
+// SimulateAddAssetProposalContent generates a param change proposal adding a random asset. The asset's supply
+// is created in the begin blocker once the proposal has passed.
+func SimulateAddAssetProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		assets, _ := k.GetAssets(ctx)
+		if len(assets) >= MaxSimAssets {
+			return nil
+		}
+		denom := strings.ToLower(simtypes.RandStringOfLength(r, r.Intn(3)+3))
+		if _, err := k.GetAsset(ctx, denom); err == nil {
+			return nil
+		}
+
+		asset := genSupportedAsset(r, denom)
+		deputy, _ := simtypes.RandomAcc(r, accs)
+		asset.DeputyAddress = deputy.Address.String()
+
+		return newAssetParamsProposal(r, fmt.Sprintf("Add asset %s", denom), append(assets, asset))
+	}
+}
+
+// SimulateDeactivateAssetProposalContent generates a param change proposal deactivating a random active asset.
+func SimulateDeactivateAssetProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		assets, _ := k.GetAssets(ctx)
+		var active []int
+		for i, asset := range assets {
+			if asset.Active {
+				active = append(active, i)
+			}
+		}
+		if len(active) == 0 {
+			return nil
+		}
+
+		i := active[r.Intn(len(active))]
+		assets[i].Active = false
+
+		return newAssetParamsProposal(r, fmt.Sprintf("Deactivate asset %s", assets[i].Denom), assets)
+	}
+}
+
+func newAssetParamsProposal(r *rand.Rand, title string, assets types.AssetParams) simtypes.Content {
+	return paramproposal.NewParameterChangeProposal(
+		title,
+		simtypes.RandStringOfLength(r, 100),
+		[]paramproposal.ParamChange{
+			paramproposal.NewParamChange(types.DefaultParamspace, keyAssetParams, marshalAssetParams(assets)),
+		},
+	)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 var (
@@ -21,9 +22,19 @@ var (
 
 // RegisterCodec registers concrete types on amino
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
-	cdc.RegisterConcrete(MsgCreateAtomicSwap{}, "bep3/MsgCreateAtomicSwap", nil)
-	cdc.RegisterConcrete(MsgRefundAtomicSwap{}, "bep3/MsgRefundAtomicSwap", nil)
-	cdc.RegisterConcrete(MsgClaimAtomicSwap{}, "bep3/MsgClaimAtomicSwap", nil)
+	cdc.RegisterConcrete(&MsgCreateAtomicSwap{}, "bep3/MsgCreateAtomicSwap", nil)
+	cdc.RegisterConcrete(&MsgRefundAtomicSwap{}, "bep3/MsgRefundAtomicSwap", nil)
+	cdc.RegisterConcrete(&MsgClaimAtomicSwap{}, "bep3/MsgClaimAtomicSwap", nil)
+	cdc.RegisterConcrete(&MsgBatchClaimAtomicSwaps{}, "bep3/MsgBatchClaimAtomicSwaps", nil)
+	cdc.RegisterConcrete(&MsgBatchRefundAtomicSwaps{}, "bep3/MsgBatchRefundAtomicSwaps", nil)
+	cdc.RegisterConcrete(&MsgAnnotateSwap{}, "bep3/MsgAnnotateSwap", nil)
+	cdc.RegisterConcrete(&MsgDeputyBond{}, "bep3/MsgDeputyBond", nil)
+	cdc.RegisterConcrete(&MsgDeputyUnbond{}, "bep3/MsgDeputyUnbond", nil)
+	cdc.RegisterConcrete(&MsgCreateInterchainSwap{}, "bep3/MsgCreateInterchainSwap", nil)
+	cdc.RegisterConcrete(&MsgCreateLocalSwap{}, "bep3/MsgCreateLocalSwap", nil)
+	cdc.RegisterConcrete(&UpdateDenyListProposal{}, "bep3/UpdateDenyListProposal", nil)
+	cdc.RegisterConcrete(&DeputySlashProposal{}, "bep3/DeputySlashProposal", nil)
+	cdc.RegisterConcrete(&SetLightClientStateProposal{}, "bep3/SetLightClientStateProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -31,6 +42,18 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgCreateAtomicSwap{},
 		&MsgRefundAtomicSwap{},
 		&MsgClaimAtomicSwap{},
+		&MsgBatchClaimAtomicSwaps{},
+		&MsgBatchRefundAtomicSwaps{},
+		&MsgAnnotateSwap{},
+		&MsgDeputyBond{},
+		&MsgDeputyUnbond{},
+		&MsgCreateInterchainSwap{},
+		&MsgCreateLocalSwap{},
+	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&UpdateDenyListProposal{},
+		&DeputySlashProposal{},
+		&SetLightClientStateProposal{},
 	)
 	sdk.RegisterInterfaces(registry)
 	cryptocodec.RegisterInterfaces(registry)
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDeputyActivity returns a new DeputyActivity
+func NewDeputyActivity(deputy string, height int64, t time.Time) DeputyActivity {
+	return DeputyActivity{
+		DeputyAddress:    deputy,
+		LastActiveHeight: height,
+		LastActiveTime:   t,
+	}
+}
+
+// Validate performs a basic validation of deputy activity fields.
+func (a DeputyActivity) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.DeputyAddress); err != nil {
+		return fmt.Errorf("invalid deputy address %s: %w", a.DeputyAddress, err)
+	}
+	if a.LastActiveHeight < 0 {
+		return fmt.Errorf("deputy %s has negative last active height %d", a.DeputyAddress, a.LastActiveHeight)
+	}
+	return nil
+}
+
+// NewAssetSuspension returns a new AssetSuspension
+func NewAssetSuspension(denom string, height int64) AssetSuspension {
+	return AssetSuspension{
+		Denom:  denom,
+		Height: height,
+	}
+}
+
+// Validate performs a basic validation of asset suspension fields.
+func (s AssetSuspension) Validate() error {
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return err
+	}
+	if s.Height < 0 {
+		return fmt.Errorf("asset %s has negative suspension height %d", s.Denom, s.Height)
+	}
+	return nil
+}
+
+// NewDeputyBond returns a new DeputyBond
+func NewDeputyBond(deputy string, amount sdk.Coins) DeputyBond {
+	return DeputyBond{
+		DeputyAddress: deputy,
+		Amount:        amount,
+	}
+}
+
+// Validate performs a basic validation of deputy bond fields.
+func (b DeputyBond) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(b.DeputyAddress); err != nil {
+		return fmt.Errorf("invalid deputy address %s: %w", b.DeputyAddress, err)
+	}
+	if !b.Amount.IsValid() {
+		return fmt.Errorf("deputy %s has invalid bond %s", b.DeputyAddress, b.Amount)
+	}
+	return nil
+}
+
+// NewDeputyUnbonding returns a new DeputyUnbonding
+func NewDeputyUnbonding(deputy string, amount sdk.Coins, completionTime time.Time) DeputyUnbonding {
+	return DeputyUnbonding{
+		DeputyAddress:  deputy,
+		Amount:         amount,
+		CompletionTime: completionTime,
+	}
+}
+
+// Validate performs a basic validation of deputy unbonding fields.
+func (u DeputyUnbonding) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(u.DeputyAddress); err != nil {
+		return fmt.Errorf("invalid deputy address %s: %w", u.DeputyAddress, err)
+	}
+	if !u.Amount.IsValid() {
+		return fmt.Errorf("deputy %s has invalid unbonding amount %s", u.DeputyAddress, u.Amount)
+	}
+	return nil
+}
@@ -45,4 +45,34 @@ var (
 	ErrInvalidSwapAccount = sdkerrors.Register(ModuleName, 19, "atomic swap has invalid account")
 	// ErrExceedsTimeBasedSupplyLimit error for when the proposed supply increase would put the supply above limit for the current time period
 	ErrExceedsTimeBasedSupplyLimit = sdkerrors.Register(ModuleName, 20, "asset supply over limit for current time period")
+	// ErrAddressDenied error for when a swap involves an address on the deny list
+	ErrAddressDenied = sdkerrors.Register(ModuleName, 21, "address is on the deny list")
+	// ErrInvalidDenyListProposal error for when a deny list proposal is malformed
+	ErrInvalidDenyListProposal = sdkerrors.Register(ModuleName, 22, "invalid deny list proposal")
+	// ErrInvalidClaimTip error for when a swap's claim tip is not a valid part of its amount
+	ErrInvalidClaimTip = sdkerrors.Register(ModuleName, 23, "invalid claim tip")
+	// ErrInvalidBatch error for when a batch claim or refund msg is malformed
+	ErrInvalidBatch = sdkerrors.Register(ModuleName, 24, "invalid batch")
+	// ErrInvalidSwapMetadata error for when a swap's memo or other chain tx hash is malformed
+	ErrInvalidSwapMetadata = sdkerrors.Register(ModuleName, 25, "invalid swap metadata")
+	// ErrSwapAlreadyAnnotated error for when a swap's other chain tx hash has already been set
+	ErrSwapAlreadyAnnotated = sdkerrors.Register(ModuleName, 26, "atomic swap already annotated")
+	// ErrDeputyInactive error for when an outgoing swap is created for an asset suspended due to deputy inactivity
+	ErrDeputyInactive = sdkerrors.Register(ModuleName, 27, "asset deputy is inactive")
+	// ErrInsufficientDeputyBond error for when a deputy's bonded collateral is below what is required or unbonded
+	ErrInsufficientDeputyBond = sdkerrors.Register(ModuleName, 28, "insufficient deputy bond")
+	// ErrInvalidDeputySlash error for when a deputy cannot be slashed for a swap
+	ErrInvalidDeputySlash = sdkerrors.Register(ModuleName, 29, "invalid deputy slash")
+	// ErrInvalidSwapProof error for when an incoming swap's proof of the HTLC on the counterparty chain is missing or invalid
+	ErrInvalidSwapProof = sdkerrors.Register(ModuleName, 30, "invalid swap proof")
+	// ErrLightClientNotFound error for when an asset that verifies incoming swaps has no light client state
+	ErrLightClientNotFound = sdkerrors.Register(ModuleName, 31, "light client state not found")
+	// ErrInvalidInterchainSwap error for when an interchain swap or its packet is malformed or cannot be settled
+	ErrInvalidInterchainSwap = sdkerrors.Register(ModuleName, 32, "invalid interchain swap")
+	// ErrInvalidChannel error for when a channel opened on the bep3 port does not support interchain swaps
+	ErrInvalidChannel = sdkerrors.Register(ModuleName, 33, "invalid interchain swap channel")
+	// ErrInvalidPartialClaim error for when a claim amount is not a part of the remaining amount of a swap with partial claims
+	ErrInvalidPartialClaim = sdkerrors.Register(ModuleName, 34, "invalid partial claim")
+	// ErrInvalidLightClientState error for when a light client state set by governance is malformed or of an unsupported asset
+	ErrInvalidLightClientState = sdkerrors.Register(ModuleName, 35, "invalid light client state")
 )
@@ -6,20 +6,51 @@ const (
 	EventTypeClaimAtomicSwap  = "claim_atomic_swap"
 	EventTypeRefundAtomicSwap = "refund_atomic_swap"
 	EventTypeSwapsExpired     = "swaps_expired"
+	EventTypeUpdateDenyList   = "update_deny_list"
+	EventTypeAnnotateSwap     = "annotate_swap"
+	EventTypeDeputyInactive   = "deputy_inactive"
+	EventTypeDeputyActive     = "deputy_active"
+	EventTypeDeputyBond       = "deputy_bond"
+	EventTypeDeputyUnbond     = "deputy_unbond"
+	EventTypeCompleteUnbond   = "complete_deputy_unbonding"
+	EventTypeSlashDeputy      = "slash_deputy"
+	EventTypeSetLightClient   = "set_light_client_state"
 
-	AttributeValueCategory       = ModuleName
-	AttributeKeySender           = "sender"
-	AttributeKeyRecipient        = "recipient"
-	AttributeKeyAtomicSwapID     = "atomic_swap_id"
-	AttributeKeyRandomNumberHash = "random_number_hash"
-	AttributeKeyTimestamp        = "timestamp"
-	AttributeKeySenderOtherChain = "sender_other_chain"
-	AttributeKeyExpireTimestamp  = "expire_timestamp"
-	AttributeKeyAmount           = "amount"
-	AttributeKeyDirection        = "direction"
-	AttributeKeyClaimSender      = "claim_sender"
-	AttributeKeyRandomNumber     = "random_number"
-	AttributeKeyRefundSender     = "refund_sender"
-	AttributeKeyAtomicSwapIDs    = "atomic_swap_ids"
-	AttributeExpirationBlock     = "expiration_block"
+	AttributeValueCategory          = ModuleName
+	AttributeKeySender              = "sender"
+	AttributeKeyRecipient           = "recipient"
+	AttributeKeyAtomicSwapID        = "atomic_swap_id"
+	AttributeKeyRandomNumberHash    = "random_number_hash"
+	AttributeKeyTimestamp           = "timestamp"
+	AttributeKeySenderOtherChain    = "sender_other_chain"
+	AttributeKeyRecipientOtherChain = "recipient_other_chain"
+	AttributeKeyExpireTimestamp     = "expire_timestamp"
+	AttributeKeyAmount              = "amount"
+	AttributeKeyDirection           = "direction"
+	AttributeKeyClaimSender         = "claim_sender"
+	AttributeKeyRandomNumber        = "random_number"
+	AttributeKeyClaimTip            = "claim_tip"
+	AttributeKeyRefundSender        = "refund_sender"
+	AttributeKeyAtomicSwapIDs       = "atomic_swap_ids"
+	AttributeExpirationBlock        = "expiration_block"
+	AttributeKeyDenied              = "denied"
+	AttributeKeyAllowed             = "allowed"
+	AttributeKeyMemo                = "memo"
+	AttributeKeyOtherChainTxHash    = "other_chain_tx_hash"
+	AttributeKeyAnnotateSender      = "annotate_sender"
+	AttributeKeyDenom               = "denom"
+	AttributeKeyDeputy              = "deputy"
+	AttributeKeyLastActiveHeight    = "last_active_height"
+	AttributeKeyCompletionTime      = "completion_time"
+	AttributeKeySlashReason         = "reason"
+	AttributeKeyChannel             = "channel"
+	AttributeKeyPacketSequence      = "packet_sequence"
+	AttributeKeyError               = "error"
+	AttributeKeyPartialClaims       = "partial_claims"
+	AttributeKeyRemainingAmount     = "remaining_amount"
+	AttributeKeyChainID             = "chain_id"
+	AttributeKeyHeight              = "height"
+
+	AttributeValueSlashReasonRefund   = "refund"
+	AttributeValueSlashReasonEvidence = "evidence"
 )
@@ -5,15 +5,29 @@ import (
 	"encoding/hex"
 	"fmt"
 	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(params Params, swaps AtomicSwaps, supplies AssetSupplies, previousBlockTime time.Time) *GenesisState {
+func NewGenesisState(params Params, swaps AtomicSwaps, supplies AssetSupplies, previousBlockTime time.Time,
+	denyList []string, stats []SwapStats, dailyStats []DailySwapStats, deputyActivity []DeputyActivity,
+	suspendedAssets []AssetSuspension, deputyBonds []DeputyBond, deputyUnbondings []DeputyUnbonding,
+	lightClientStates []LightClientState) *GenesisState {
 	return &GenesisState{
 		Params:            params,
 		AtomicSwaps:       swaps,
 		Supplies:          supplies,
 		PreviousBlockTime: previousBlockTime,
+		DenyList:          denyList,
+		Stats:             stats,
+		DailyStats:        dailyStats,
+		DeputyActivity:    deputyActivity,
+		SuspendedAssets:   suspendedAssets,
+		DeputyBonds:       deputyBonds,
+		DeputyUnbondings:  deputyUnbondings,
+		LightClientStates: lightClientStates,
 	}
 }
 
@@ -24,6 +38,14 @@ func DefaultGenesisState() *GenesisState {
 		AtomicSwaps{},
 		AssetSupplies{},
 		DefaultPreviousBlockTime,
+		[]string{},
+		[]SwapStats{},
+		[]DailySwapStats{},
+		[]DeputyActivity{},
+		[]AssetSuspension{},
+		[]DeputyBond{},
+		[]DeputyUnbonding{},
+		[]LightClientState{},
 	)
 }
 
@@ -39,34 +61,271 @@ func (gs GenesisState) IsEmpty() bool {
 	return gs.Equal(GenesisState{})
 }
 
-// Validate validates genesis inputs. It returns error if validation of any input fails.
+// Rebase moves the heights and times recorded in a genesis state exported for a zero height genesis onto a
+// chain whose block before the first one has the given height and time. Closed swaps keep the blocks and time
+// left until they are deleted, open swaps and unbondings the time left until they expire or complete, and
+// deputies the blocks they have been inactive for. Close, activity and suspension heights before the first block
+// of the chain are recorded as 0.
+func (gs GenesisState) Rebase(height int64, blockTime time.Time) GenesisState {
+	heightShift := height - gs.ExportHeight
+	timeShift := blockTime.Sub(gs.PreviousBlockTime)
+	secondsShift := int64(timeShift / time.Second)
+
+	swaps := make(AtomicSwaps, len(gs.AtomicSwaps))
+	for i, swap := range gs.AtomicSwaps {
+		swap.ExpireTimestamp += secondsShift
+		if swap.Status == Completed {
+			swap.ClosedBlock = rebaseHeight(swap.ClosedBlock, heightShift)
+			swap.ClosedTime += secondsShift
+		}
+		swaps[i] = swap
+	}
+	activities := make([]DeputyActivity, len(gs.DeputyActivity))
+	for i, activity := range gs.DeputyActivity {
+		activity.LastActiveHeight = rebaseHeight(activity.LastActiveHeight, heightShift)
+		activity.LastActiveTime = activity.LastActiveTime.Add(timeShift)
+		activities[i] = activity
+	}
+	suspensions := make([]AssetSuspension, len(gs.SuspendedAssets))
+	for i, suspension := range gs.SuspendedAssets {
+		suspension.Height = rebaseHeight(suspension.Height, heightShift)
+		suspensions[i] = suspension
+	}
+	unbondings := make([]DeputyUnbonding, len(gs.DeputyUnbondings))
+	for i, unbonding := range gs.DeputyUnbondings {
+		unbonding.CompletionTime = unbonding.CompletionTime.Add(timeShift)
+		unbondings[i] = unbonding
+	}
+
+	gs.AtomicSwaps = swaps
+	gs.DeputyActivity = activities
+	gs.SuspendedAssets = suspensions
+	gs.DeputyUnbondings = unbondings
+	gs.PreviousBlockTime = blockTime
+	gs.ExportHeight = 0
+	return gs
+}
+
+func rebaseHeight(height, shift int64) int64 {
+	if height+shift < 0 {
+		return 0
+	}
+	return height + shift
+}
+
+// Validate validates genesis inputs. It returns the first problem found by ValidateAll.
 func (gs GenesisState) Validate() error {
+	if errs := gs.ValidateAll(); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// ValidateAll validates the genesis inputs and their consistency with each other. It returns every problem found
+// rather than stopping at the first one. Problems with a swap name its ID.
+func (gs GenesisState) ValidateAll() []error {
+	var errs []error
 	if err := gs.Params.Validate(); err != nil {
-		return err
+		errs = append(errs, err)
+	}
+	if gs.ExportHeight < 0 {
+		errs = append(errs, fmt.Errorf("export height cannot be negative: %d", gs.ExportHeight))
+	}
+
+	assets := map[string]AssetParam{}
+	for _, asset := range gs.Params.AssetParams {
+		assets[asset.Denom] = asset
 	}
 
 	ids := map[string]bool{}
+	incoming, outgoing := sdk.NewCoins(), sdk.NewCoins()
 	for _, swap := range gs.AtomicSwaps {
-		if ids[hex.EncodeToString(swap.GetSwapID())] {
-			return fmt.Errorf("found duplicate atomic swap ID %s", hex.EncodeToString(swap.GetSwapID()))
+		id := hex.EncodeToString(swap.GetSwapID())
+		if ids[id] {
+			errs = append(errs, fmt.Errorf("found duplicate atomic swap ID %s", id))
 		}
+		ids[id] = true
 
 		if err := swap.Validate(); err != nil {
-			return err
+			errs = append(errs, sdkerrors.Wrapf(err, "swap %s", id))
+			continue
 		}
 
-		ids[hex.EncodeToString(swap.GetSwapID())] = true
+		// Local swaps may lock any denom, and are not part of the asset supplies
+		if swap.Direction == Local {
+			continue
+		}
+
+		// Atomic swap assets must be both supported and active
+		asset, found := assets[swap.Amount[0].Denom]
+		if !found {
+			errs = append(errs, sdkerrors.Wrapf(ErrAssetNotSupported, "swap %s: %s", id, swap.Amount[0].Denom))
+		} else if !asset.Active {
+			errs = append(errs, sdkerrors.Wrapf(ErrAssetNotActive, "swap %s: %s", id, asset.Denom))
+		}
+
+		// The unclaimed amounts of swaps that have not been closed make up the incoming and outgoing supplies
+		if swap.Status == Completed {
+			continue
+		}
+		switch swap.Direction {
+		case Incoming:
+			incoming = incoming.Add(swap.UnclaimedAmount()...)
+		case Outgoing:
+			outgoing = outgoing.Add(swap.UnclaimedAmount()...)
+		}
 	}
 
 	supplyDenoms := map[string]bool{}
 	for _, supply := range gs.Supplies.AssetSupplies {
+		denom := supply.GetDenom()
 		if err := supply.Validate(); err != nil {
-			return err
+			errs = append(errs, err)
+			continue
+		}
+		if supplyDenoms[denom] {
+			errs = append(errs, fmt.Errorf("found duplicate denom in asset supplies %s", denom))
 		}
-		if supplyDenoms[supply.GetDenom()] {
-			return fmt.Errorf("found duplicate denom in asset supplies %s", supply.GetDenom())
+		supplyDenoms[denom] = true
+
+		// Asset's given incoming/outgoing supply must match the amount of coins in incoming/outgoing atomic swaps
+		if !supply.IncomingSupply.Amount.Equal(incoming.AmountOf(denom)) {
+			errs = append(errs, fmt.Errorf("asset's incoming supply %s does not match amount %s in incoming atomic swaps",
+				supply.IncomingSupply, incoming.AmountOf(denom)))
+		}
+		if !supply.OutgoingSupply.Amount.Equal(outgoing.AmountOf(denom)) {
+			errs = append(errs, fmt.Errorf("asset's outgoing supply %s does not match amount %s in outgoing atomic swaps",
+				supply.OutgoingSupply, outgoing.AmountOf(denom)))
+		}
+
+		asset, found := assets[denom]
+		if !found {
+			errs = append(errs, sdkerrors.Wrapf(ErrAssetNotSupported, "asset supply: %s", denom))
+			continue
+		}
+		limit := asset.SupplyLimit.Limit
+		if supply.CurrentSupply.Amount.GT(limit) {
+			errs = append(errs, fmt.Errorf("asset's current supply %s is over the supply limit %s", supply.CurrentSupply, limit))
+		}
+		if supply.IncomingSupply.Amount.GT(limit) {
+			errs = append(errs, fmt.Errorf("asset's incoming supply %s is over the supply limit %s", supply.IncomingSupply, limit))
+		}
+		if supply.IncomingSupply.Amount.Add(supply.CurrentSupply.Amount).GT(limit) {
+			errs = append(errs, fmt.Errorf("asset's incoming supply + current supply %s is over the supply limit %s",
+				supply.IncomingSupply.Add(supply.CurrentSupply), limit))
+		}
+		if supply.OutgoingSupply.Amount.GT(limit) {
+			errs = append(errs, fmt.Errorf("asset's outgoing supply %s is over the supply limit %s", supply.OutgoingSupply, limit))
 		}
-		supplyDenoms[supply.GetDenom()] = true
 	}
-	return nil
+
+	denied := map[string]bool{}
+	for _, address := range gs.DenyList {
+		if err := ValidateDenyListAddress(address); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		normalized := NormalizeDenyListAddress(address)
+		if denied[normalized] {
+			errs = append(errs, fmt.Errorf("found duplicate address in deny list %s", address))
+		}
+		denied[normalized] = true
+	}
+
+	statsDenoms := map[string]bool{}
+	for _, stats := range gs.Stats {
+		if err := stats.Validate(); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if statsDenoms[stats.Denom] {
+			errs = append(errs, fmt.Errorf("found duplicate denom in swap stats %s", stats.Denom))
+		}
+		statsDenoms[stats.Denom] = true
+	}
+
+	days := map[string]bool{}
+	for _, daily := range gs.DailyStats {
+		if err := daily.Validate(); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		key := fmt.Sprintf("%s/%d", daily.Stats.Denom, daily.Day)
+		if days[key] {
+			errs = append(errs, fmt.Errorf("found duplicate day %d in daily swap stats of %s", daily.Day, daily.Stats.Denom))
+		}
+		days[key] = true
+	}
+
+	deputies := map[string]bool{}
+	for _, activity := range gs.DeputyActivity {
+		if err := activity.Validate(); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if deputies[activity.DeputyAddress] {
+			errs = append(errs, fmt.Errorf("found duplicate deputy in deputy activity %s", activity.DeputyAddress))
+		}
+		deputies[activity.DeputyAddress] = true
+	}
+
+	suspended := map[string]bool{}
+	for _, suspension := range gs.SuspendedAssets {
+		if err := suspension.Validate(); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if suspended[suspension.Denom] {
+			errs = append(errs, fmt.Errorf("found duplicate denom in suspended assets %s", suspension.Denom))
+		}
+		suspended[suspension.Denom] = true
+	}
+
+	bonded := map[string]bool{}
+	for _, bond := range gs.DeputyBonds {
+		if err := bond.Validate(); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if bonded[bond.DeputyAddress] {
+			errs = append(errs, fmt.Errorf("found duplicate deputy in deputy bonds %s", bond.DeputyAddress))
+		}
+		bonded[bond.DeputyAddress] = true
+	}
+
+	unbondings := map[string]bool{}
+	for _, unbonding := range gs.DeputyUnbondings {
+		if err := unbonding.Validate(); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		key := string(GetDeputyUnbondingKey(unbonding.CompletionTime, unbonding.DeputyAddress))
+		if unbondings[key] {
+			errs = append(errs, fmt.Errorf("found duplicate unbonding of deputy %s completing at %s",
+				unbonding.DeputyAddress, unbonding.CompletionTime))
+		}
+		unbondings[key] = true
+	}
+
+	clients := map[string]bool{}
+	for _, state := range gs.LightClientStates {
+		if err := state.Validate(); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if clients[state.Denom] {
+			errs = append(errs, fmt.Errorf("found duplicate denom in light client states %s", state.Denom))
+		}
+		clients[state.Denom] = true
+		if _, found := assets[state.Denom]; !found {
+			errs = append(errs, sdkerrors.Wrapf(ErrAssetNotSupported, "light client state: %s", state.Denom))
+		}
+	}
+	// Incoming swaps of assets that verify them can only be created once the counterparty chain is trusted
+	for _, asset := range gs.Params.AssetParams {
+		if asset.VerifyIncoming && !clients[asset.Denom] {
+			errs = append(errs, sdkerrors.Wrapf(ErrLightClientNotFound, "asset %s verifies incoming swaps", asset.Denom))
+		}
+	}
+	return errs
 }
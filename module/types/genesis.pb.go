@@ -111,6 +111,24 @@ type AssetParam struct {
 	// minutes span before time expiration
 	// Original SwapTimeSpan int64 `json:"time_span" yaml:"time_span"`
 	SwapTimeSpanMin int64 `protobuf:"varint,10,opt,name=swap_time_span_min,json=swapTimeSpanMin,proto3" json:"swap_time_span_min,omitempty" yaml:"swap_time_span_min"`
+	// number of blocks the deputy may go without acting before new outgoing
+	// swaps are suspended, zero disables the check
+	MaxDeputyInactivity int64 `protobuf:"varint,11,opt,name=max_deputy_inactivity,json=maxDeputyInactivity,proto3" json:"max_deputy_inactivity,omitempty" yaml:"max_deputy_inactivity"`
+	// collateral the deputy must have bonded for swaps of the asset to be
+	// created, empty if no bond is required
+	DeputyBond github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=deputy_bond,json=deputyBond,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deputy_bond" yaml:"deputy_bond"`
+	// seconds unbonded collateral remains slashable before it is returned
+	DeputyUnbondingPeriod int64 `protobuf:"varint,13,opt,name=deputy_unbonding_period,json=deputyUnbondingPeriod,proto3" json:"deputy_unbonding_period,omitempty" yaml:"deputy_unbonding_period"`
+	// collateral slashed for each swap the deputy failed to honor
+	DeputySlashAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,14,rep,name=deputy_slash_amount,json=deputySlashAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deputy_slash_amount" yaml:"deputy_slash_amount"`
+	// whether refunding an expired outgoing swap slashes the deputy
+	SlashOnRefund bool `protobuf:"varint,15,opt,name=slash_on_refund,json=slashOnRefund,proto3" json:"slash_on_refund,omitempty" yaml:"slash_on_refund"`
+	// whether incoming swaps must carry a proof of the HTLC on the counterparty
+	// chain, verified against the light client state of the asset
+	VerifyIncoming bool `protobuf:"varint,16,opt,name=verify_incoming,json=verifyIncoming,proto3" json:"verify_incoming,omitempty" yaml:"verify_incoming"`
+	// channel of the bep3 port that interchain swaps of the asset are sent and
+	// received on, empty if the asset cannot be swapped over IBC
+	IBCChannel string `protobuf:"bytes,17,opt,name=ibc_channel,json=ibcChannel,proto3" json:"ibc_channel,omitempty" yaml:"ibc_channel"`
 }
 
 func (m *AssetParam) Reset()      { *m = AssetParam{} }
@@ -194,9 +212,60 @@ func (m *AssetParam) GetSwapTimeSpanMin() int64 {
 	return 0
 }
 
+func (m *AssetParam) GetMaxDeputyInactivity() int64 {
+	if m != nil {
+		return m.MaxDeputyInactivity
+	}
+	return 0
+}
+
+func (m *AssetParam) GetDeputyBond() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DeputyBond
+	}
+	return nil
+}
+
+func (m *AssetParam) GetDeputyUnbondingPeriod() int64 {
+	if m != nil {
+		return m.DeputyUnbondingPeriod
+	}
+	return 0
+}
+
+func (m *AssetParam) GetDeputySlashAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DeputySlashAmount
+	}
+	return nil
+}
+
+func (m *AssetParam) GetSlashOnRefund() bool {
+	if m != nil {
+		return m.SlashOnRefund
+	}
+	return false
+}
+
+func (m *AssetParam) GetVerifyIncoming() bool {
+	if m != nil {
+		return m.VerifyIncoming
+	}
+	return false
+}
+
+func (m *AssetParam) GetIBCChannel() string {
+	if m != nil {
+		return m.IBCChannel
+	}
+	return ""
+}
+
 // Params governance parameters for bep3 module
 type Params struct {
 	AssetParams []AssetParam `protobuf:"bytes,1,rep,name=asset_params,json=assetParams,proto3" json:"asset_params" yaml:"asset_params"`
+	// how long closed swaps are kept in state before they are deleted
+	LongtermStorageRetention LongtermStorageRetention `protobuf:"bytes,2,opt,name=longterm_storage_retention,json=longtermStorageRetention,proto3" json:"longterm_storage_retention" yaml:"longterm_storage_retention"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -238,6 +307,68 @@ func (m *Params) GetAssetParams() []AssetParam {
 	return nil
 }
 
+func (m *Params) GetLongtermStorageRetention() LongtermStorageRetention {
+	if m != nil {
+		return m.LongtermStorageRetention
+	}
+	return LongtermStorageRetention{}
+}
+
+// LongtermStorageRetention is the period closed swaps are retained for, in an
+// explicit unit.
+type LongtermStorageRetention struct {
+	// the unit of value, either "seconds" or "blocks"
+	Unit string `protobuf:"bytes,1,opt,name=unit,proto3" json:"unit,omitempty" yaml:"unit"`
+	// the retention period in unit
+	Value int64 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty" yaml:"value"`
+}
+
+func (m *LongtermStorageRetention) Reset()      { *m = LongtermStorageRetention{} }
+func (*LongtermStorageRetention) ProtoMessage() {}
+func (*LongtermStorageRetention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd4db49772fc257b, []int{3}
+}
+func (m *LongtermStorageRetention) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LongtermStorageRetention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LongtermStorageRetention.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LongtermStorageRetention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LongtermStorageRetention.Merge(m, src)
+}
+func (m *LongtermStorageRetention) XXX_Size() int {
+	return m.Size()
+}
+func (m *LongtermStorageRetention) XXX_DiscardUnknown() {
+	xxx_messageInfo_LongtermStorageRetention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LongtermStorageRetention proto.InternalMessageInfo
+
+func (m *LongtermStorageRetention) GetUnit() string {
+	if m != nil {
+		return m.Unit
+	}
+	return ""
+}
+
+func (m *LongtermStorageRetention) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
 // AssetSupply contains information about an asset's supply
 type AssetSupply struct {
 	IncomingSupply           types.Coin `protobuf:"bytes,1,opt,name=incoming_supply,json=incomingSupply,proto3" json:"incoming_supply" yaml:"incoming_supply"`
@@ -251,7 +382,7 @@ type AssetSupply struct {
 func (m *AssetSupply) Reset()      { *m = AssetSupply{} }
 func (*AssetSupply) ProtoMessage() {}
 func (*AssetSupply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd4db49772fc257b, []int{3}
+	return fileDescriptor_dd4db49772fc257b, []int{4}
 }
 func (m *AssetSupply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -324,7 +455,7 @@ func (m *AssetSupplies) Reset()         { *m = AssetSupplies{} }
 func (m *AssetSupplies) String() string { return proto.CompactTextString(m) }
 func (*AssetSupplies) ProtoMessage()    {}
 func (*AssetSupplies) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd4db49772fc257b, []int{4}
+	return fileDescriptor_dd4db49772fc257b, []int{5}
 }
 func (m *AssetSupplies) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -360,26 +491,36 @@ func (m *AssetSupplies) GetAssetSupplies() []AssetSupply {
 	return nil
 }
 
-//type GenesisState struct {
-type GenesisState struct {
-	Params            Params        `protobuf:"bytes,1,opt,name=params,proto3" json:"params" yaml:"params"`
-	AtomicSwaps       []AtomicSwap  `protobuf:"bytes,2,rep,name=atomic_swaps,json=atomicSwaps,proto3" json:"atomic_swaps" yaml:"atomic_swaps"`
-	Supplies          AssetSupplies `protobuf:"bytes,3,opt,name=supplies,proto3" json:"supplies" yaml:"supplies"`
-	PreviousBlockTime time.Time     `protobuf:"bytes,4,opt,name=previous_block_time,json=previousBlockTime,proto3,stdtime" json:"previous_block_time" yaml:"previous_block_time"`
+// SwapStats holds the cumulative swap counters and volumes of an asset
+type SwapStats struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// number of swaps created
+	Created uint64 `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty" yaml:"created"`
+	// number of swaps claimed
+	Claimed uint64 `protobuf:"varint,3,opt,name=claimed,proto3" json:"claimed,omitempty" yaml:"claimed"`
+	// number of swaps refunded
+	Refunded uint64 `protobuf:"varint,4,opt,name=refunded,proto3" json:"refunded,omitempty" yaml:"refunded"`
+	// amount moved onto this chain by claimed incoming swaps
+	IncomingVolume types.Coin `protobuf:"bytes,5,opt,name=incoming_volume,json=incomingVolume,proto3" json:"incoming_volume" yaml:"incoming_volume"`
+	// amount moved off this chain by claimed outgoing swaps
+	OutgoingVolume types.Coin `protobuf:"bytes,6,opt,name=outgoing_volume,json=outgoingVolume,proto3" json:"outgoing_volume" yaml:"outgoing_volume"`
+	// deputy fixed fees charged on claimed outgoing swaps
+	Fees types.Coin `protobuf:"bytes,7,opt,name=fees,proto3" json:"fees" yaml:"fees"`
+	// claim tips paid to claim submitters
+	ClaimTips types.Coin `protobuf:"bytes,8,opt,name=claim_tips,json=claimTips,proto3" json:"claim_tips" yaml:"claim_tips"`
 }
 
-func (m *GenesisState) Reset()         { *m = GenesisState{} }
-func (m *GenesisState) String() string { return proto.CompactTextString(m) }
-func (*GenesisState) ProtoMessage()    {}
-func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd4db49772fc257b, []int{5}
+func (m *SwapStats) Reset()      { *m = SwapStats{} }
+func (*SwapStats) ProtoMessage() {}
+func (*SwapStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd4db49772fc257b, []int{6}
 }
-func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+func (m *SwapStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SwapStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SwapStats.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -389,637 +530,3853 @@ func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *GenesisState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GenesisState.Merge(m, src)
+func (m *SwapStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapStats.Merge(m, src)
 }
-func (m *GenesisState) XXX_Size() int {
+func (m *SwapStats) XXX_Size() int {
 	return m.Size()
 }
-func (m *GenesisState) XXX_DiscardUnknown() {
-	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+func (m *SwapStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapStats.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+var xxx_messageInfo_SwapStats proto.InternalMessageInfo
 
-func (m *GenesisState) GetParams() Params {
+func (m *SwapStats) GetDenom() string {
 	if m != nil {
-		return m.Params
+		return m.Denom
 	}
-	return Params{}
+	return ""
 }
 
-func (m *GenesisState) GetAtomicSwaps() []AtomicSwap {
+func (m *SwapStats) GetCreated() uint64 {
 	if m != nil {
-		return m.AtomicSwaps
+		return m.Created
 	}
-	return nil
+	return 0
 }
 
-func (m *GenesisState) GetSupplies() AssetSupplies {
+func (m *SwapStats) GetClaimed() uint64 {
 	if m != nil {
-		return m.Supplies
+		return m.Claimed
 	}
-	return AssetSupplies{}
+	return 0
 }
 
-func (m *GenesisState) GetPreviousBlockTime() time.Time {
+func (m *SwapStats) GetRefunded() uint64 {
 	if m != nil {
-		return m.PreviousBlockTime
+		return m.Refunded
 	}
-	return time.Time{}
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*SupplyLimit)(nil), "bep3.SupplyLimit")
-	proto.RegisterType((*AssetParam)(nil), "bep3.AssetParam")
-	proto.RegisterType((*Params)(nil), "bep3.Params")
-	proto.RegisterType((*AssetSupply)(nil), "bep3.AssetSupply")
-	proto.RegisterType((*AssetSupplies)(nil), "bep3.AssetSupplies")
-	proto.RegisterType((*GenesisState)(nil), "bep3.GenesisState")
+func (m *SwapStats) GetIncomingVolume() types.Coin {
+	if m != nil {
+		return m.IncomingVolume
+	}
+	return types.Coin{}
 }
 
-func init() { proto.RegisterFile("bep3/genesis.proto", fileDescriptor_dd4db49772fc257b) }
+func (m *SwapStats) GetOutgoingVolume() types.Coin {
+	if m != nil {
+		return m.OutgoingVolume
+	}
+	return types.Coin{}
+}
 
-var fileDescriptor_dd4db49772fc257b = []byte{
-	// 1020 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6b, 0x1b, 0xc7,
-	0x1b, 0xd7, 0x4a, 0xb6, 0x22, 0x8f, 0xde, 0xec, 0xf1, 0x3f, 0xf1, 0xda, 0xf9, 0x47, 0x6b, 0x86,
-	0xd6, 0xb8, 0x85, 0xec, 0x12, 0x87, 0x52, 0x70, 0x21, 0xd4, 0x9b, 0xbe, 0xc4, 0x25, 0x05, 0x77,
-	0x1c, 0x28, 0xf4, 0xb2, 0x1d, 0x69, 0xc7, 0xca, 0x10, 0xed, 0xce, 0xa2, 0x59, 0x39, 0xd6, 0xbd,
-	0xf4, 0x9c, 0x63, 0x8f, 0xfd, 0x38, 0x39, 0x06, 0x7a, 0x29, 0x2d, 0x6c, 0x8b, 0xfc, 0x0d, 0xf4,
-	0x05, 0x5a, 0xe6, 0x65, 0x57, 0x2b, 0xf9, 0x10, 0x0c, 0x3d, 0x69, 0x9f, 0xd7, 0xdf, 0x3c, 0xcf,
-	0xfc, 0x9e, 0x67, 0x04, 0x60, 0x9f, 0x26, 0x8f, 0xbd, 0x21, 0x8d, 0xa9, 0x60, 0xc2, 0x4d, 0xc6,
-	0x3c, 0xe5, 0x70, 0x4d, 0xea, 0xf6, 0xfe, 0x37, 0xe4, 0x43, 0xae, 0x14, 0x9e, 0xfc, 0xd2, 0xb6,
-	0x3d, 0x67, 0xc8, 0xf9, 0x70, 0x44, 0x3d, 0x25, 0xf5, 0x27, 0x17, 0x5e, 0xca, 0x22, 0x2a, 0x52,
-	0x12, 0x25, 0xc6, 0xa1, 0x37, 0xe0, 0x22, 0xe2, 0xc2, 0xeb, 0x13, 0x41, 0xbd, 0xcb, 0x47, 0x7d,
-	0x9a, 0x92, 0x47, 0xde, 0x80, 0xb3, 0xd8, 0xd8, 0xbb, 0x0a, 0x50, 0xbc, 0x26, 0x26, 0x00, 0xfd,
-	0x56, 0x05, 0xcd, 0xf3, 0x49, 0x92, 0x8c, 0xa6, 0xcf, 0x59, 0xc4, 0x52, 0xf8, 0x02, 0xac, 0x8f,
-	0xe4, 0x87, 0x6d, 0xed, 0x5b, 0x87, 0x1b, 0xfe, 0x93, 0xb7, 0x99, 0x53, 0xf9, 0x23, 0x73, 0x0e,
-	0x86, 0x2c, 0x7d, 0x39, 0xe9, 0xbb, 0x03, 0x1e, 0x79, 0x06, 0x42, 0xff, 0x3c, 0x14, 0xe1, 0x2b,
-	0x2f, 0x9d, 0x26, 0x54, 0xb8, 0xa7, 0x71, 0x3a, 0xcf, 0x9c, 0xd6, 0x94, 0x44, 0xa3, 0x63, 0xa4,
-	0x92, 0x20, 0xac, 0x93, 0xc1, 0x63, 0xd0, 0x92, 0x27, 0x0d, 0x94, 0x44, 0x43, 0xbb, 0xba, 0x6f,
-	0x1d, 0x36, 0xfc, 0x9d, 0x79, 0xe6, 0x6c, 0x6b, 0xf7, 0xb2, 0x15, 0xe1, 0xa6, 0x14, 0x9f, 0x6b,
-	0x09, 0x7e, 0x0a, 0x94, 0x18, 0x24, 0x74, 0xcc, 0x78, 0x68, 0xd7, 0xf6, 0xad, 0xc3, 0x9a, 0x7f,
-	0x6f, 0x9e, 0x39, 0xb0, 0x14, 0xaa, 0x8d, 0x08, 0x03, 0x29, 0x9d, 0x29, 0x01, 0x0a, 0xb0, 0xa9,
-	0x6c, 0xb2, 0x17, 0xa1, 0x4e, 0x6e, 0xaf, 0xa9, 0xaa, 0x4e, 0x6f, 0x5d, 0xd5, 0x4e, 0x09, 0xab,
-	0x94, 0x0f, 0xe1, 0x8e, 0x54, 0xf9, 0x52, 0xa3, 0xce, 0x7b, 0xbc, 0xf6, 0xcb, 0xaf, 0x4e, 0x05,
-	0xfd, 0x5c, 0x07, 0xe0, 0x44, 0x08, 0x9a, 0x9e, 0x91, 0x31, 0x89, 0xe0, 0x01, 0x58, 0x0f, 0x69,
-	0xcc, 0x23, 0xd3, 0xd4, 0xcd, 0x45, 0x9b, 0x94, 0x1a, 0x61, 0x6d, 0x86, 0x9f, 0x80, 0x3b, 0xf2,
-	0xae, 0x02, 0xa6, 0x3b, 0x54, 0xf3, 0xff, 0x3f, 0xcb, 0x9c, 0xfa, 0x53, 0xce, 0xe2, 0xd3, 0x2f,
-	0xe6, 0x99, 0xd3, 0xd1, 0x31, 0xc6, 0x05, 0xe1, 0xba, 0xfc, 0x3a, 0x0d, 0xe1, 0x77, 0xa0, 0x25,
-	0xd4, 0x15, 0x9a, 0x22, 0x65, 0x8b, 0x9a, 0x47, 0x5b, 0xae, 0xbc, 0x6b, 0xb7, 0x74, 0xb9, 0xfe,
-	0x7d, 0x59, 0xf7, 0xa2, 0xe9, 0xe5, 0x20, 0x84, 0x9b, 0xa2, 0x44, 0x83, 0x8f, 0x40, 0x9d, 0x0c,
-	0x52, 0x76, 0x49, 0x55, 0xc7, 0x1a, 0xfe, 0xd6, 0x3c, 0x73, 0xda, 0x3a, 0x4a, 0xeb, 0x11, 0x36,
-	0x0e, 0xf0, 0x73, 0xd0, 0x09, 0x69, 0x32, 0x49, 0xa7, 0x01, 0x09, 0xc3, 0x31, 0x15, 0xc2, 0x5e,
-	0x57, 0x55, 0xee, 0xce, 0x33, 0xe7, 0x6e, 0x5e, 0x65, 0xd9, 0x8e, 0x70, 0x5b, 0x2b, 0x4e, 0xb4,
-	0x0c, 0x03, 0xb0, 0x71, 0xc1, 0xae, 0x68, 0x18, 0x5c, 0x50, 0x6a, 0xd7, 0x55, 0xb0, 0x7f, 0xeb,
-	0x1b, 0xda, 0xd4, 0x50, 0x45, 0x22, 0x84, 0x1b, 0xea, 0xfb, 0x2b, 0x4a, 0x61, 0x02, 0xba, 0x11,
-	0x8b, 0x03, 0x49, 0xfb, 0x80, 0x44, 0x7c, 0x12, 0xa7, 0xf6, 0x1d, 0x05, 0xf3, 0xec, 0xd6, 0x30,
-	0xf7, 0x34, 0xcc, 0x4a, 0x3a, 0x84, 0xdb, 0x11, 0x8b, 0xcf, 0x5f, 0x93, 0xe4, 0x44, 0xc9, 0x0a,
-	0x91, 0x5c, 0x2d, 0x21, 0x36, 0xfe, 0x73, 0x44, 0x72, 0x55, 0x42, 0xf4, 0xc1, 0x86, 0x32, 0x4b,
-	0x3e, 0xda, 0x1b, 0x8a, 0x3d, 0x1f, 0xce, 0x32, 0xa7, 0x2d, 0x5d, 0x5e, 0xe4, 0x5b, 0x62, 0xd1,
-	0xa7, 0xc2, 0x17, 0xe1, 0x86, 0x30, 0x2e, 0xf0, 0x1b, 0x00, 0x0b, 0x7d, 0x20, 0x12, 0x12, 0x07,
-	0x11, 0x8b, 0x6d, 0xa0, 0x92, 0x3d, 0x98, 0x67, 0xce, 0xee, 0x4a, 0x6c, 0xe1, 0x83, 0x70, 0x37,
-	0x4f, 0x72, 0x9e, 0x90, 0xf8, 0x5b, 0x16, 0x9b, 0x41, 0xf8, 0x11, 0xd4, 0xd5, 0x08, 0x08, 0x78,
-	0x06, 0x5a, 0x44, 0x4e, 0x44, 0x90, 0x28, 0xd9, 0xb6, 0xf6, 0x6b, 0x87, 0xcd, 0xa3, 0x4d, 0x4d,
-	0xd2, 0xc5, 0xac, 0xac, 0x72, 0xb4, 0x1c, 0x83, 0x70, 0x93, 0x14, 0x8e, 0xc2, 0x20, 0xfc, 0x53,
-	0x03, 0x4d, 0x15, 0xae, 0x89, 0x0e, 0xfb, 0xa0, 0xcb, 0xe2, 0x01, 0x8f, 0x58, 0x3c, 0x0c, 0x34,
-	0xa3, 0xd5, 0xd4, 0x35, 0x8f, 0x76, 0x5d, 0xdd, 0x60, 0x57, 0xce, 0xaf, 0x6b, 0x76, 0xa3, 0x2b,
-	0x87, 0xcb, 0xef, 0x19, 0x4c, 0xd3, 0xea, 0x95, 0x78, 0x84, 0x3b, 0xb9, 0x66, 0x81, 0xc1, 0x27,
-	0xe9, 0x90, 0x97, 0x30, 0xaa, 0xb7, 0xc4, 0x58, 0x89, 0x47, 0xb8, 0x93, 0x6b, 0x0c, 0x46, 0x00,
-	0x3a, 0x83, 0xc9, 0x78, 0x4c, 0xe3, 0x34, 0x87, 0xa8, 0xbd, 0x0f, 0xe2, 0x81, 0x81, 0x30, 0x53,
-	0xb7, 0x1c, 0x8e, 0x70, 0xdb, 0x28, 0x0c, 0xc0, 0x4f, 0x16, 0xb8, 0x5f, 0x5e, 0xbb, 0xc1, 0x0a,
-	0xdc, 0xda, 0xfb, 0xe0, 0x3e, 0x36, 0x70, 0xe8, 0xe6, 0x0a, 0x0f, 0x56, 0xb1, 0xed, 0xd2, 0x46,
-	0x7f, 0xba, 0x74, 0x8c, 0xfc, 0x69, 0xa0, 0x23, 0x92, 0x08, 0x1a, 0xaa, 0xe5, 0x51, 0xbb, 0xf1,
-	0x34, 0x18, 0xab, 0x79, 0x1a, 0xbe, 0xd4, 0x92, 0x61, 0xc0, 0x4b, 0xd0, 0x5e, 0x10, 0x80, 0x51,
-	0x01, 0xbf, 0x07, 0x1d, 0x4d, 0x1b, 0x61, 0x34, 0x86, 0x6c, 0x5b, 0x25, 0xb2, 0x69, 0xf4, 0xd5,
-	0x96, 0x2d, 0x87, 0x21, 0xdc, 0x26, 0xe5, 0xc4, 0xe8, 0xcf, 0x2a, 0x68, 0x7d, 0xad, 0x1f, 0xeb,
-	0xf3, 0x94, 0xa4, 0x14, 0x7e, 0x06, 0xea, 0x05, 0x9d, 0x65, 0xb7, 0x5a, 0x1a, 0x41, 0x13, 0xd4,
-	0xbf, 0x6b, 0x92, 0x9b, 0xc5, 0x99, 0x93, 0xd8, 0x84, 0xa8, 0x89, 0x48, 0x79, 0xc4, 0x06, 0x6a,
-	0xae, 0x85, 0x5d, 0x5d, 0x9a, 0x08, 0x65, 0x91, 0xc3, 0x7b, 0x63, 0x22, 0x4a, 0x31, 0x72, 0x22,
-	0x0a, 0x47, 0x01, 0x9f, 0x81, 0x46, 0x51, 0xb2, 0x66, 0xcb, 0xf6, 0x6a, 0xc9, 0x8c, 0x0a, 0x7f,
-	0xc7, 0x24, 0xec, 0x96, 0x9e, 0x01, 0x55, 0x6e, 0x11, 0x0d, 0xc7, 0x60, 0x3b, 0x19, 0xd3, 0x4b,
-	0xc6, 0x27, 0x22, 0xe8, 0x8f, 0xf8, 0xe0, 0x95, 0xde, 0x2b, 0x9a, 0x13, 0x7b, 0xae, 0xfe, 0x1b,
-	0xe2, 0xe6, 0x7f, 0x43, 0xdc, 0x62, 0xc1, 0xf8, 0x07, 0x26, 0xf7, 0x9e, 0xa9, 0xf9, 0x66, 0x12,
-	0xf4, 0xe6, 0x2f, 0xc7, 0xc2, 0x5b, 0xb9, 0xc5, 0x97, 0x06, 0x19, 0xef, 0x3f, 0x79, 0x3b, 0xeb,
-	0x59, 0xef, 0x66, 0x3d, 0xeb, 0xef, 0x59, 0xcf, 0x7a, 0x73, 0xdd, 0xab, 0xbc, 0xbb, 0xee, 0x55,
-	0x7e, 0xbf, 0xee, 0x55, 0x7e, 0xf8, 0xa0, 0xb4, 0x2c, 0xe9, 0xc3, 0x88, 0xc7, 0x74, 0xea, 0xa9,
-	0x3f, 0x32, 0x11, 0x0f, 0x27, 0x23, 0xaa, 0xd7, 0x65, 0xbf, 0xae, 0x8e, 0xf3, 0xf8, 0xdf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xbb, 0x21, 0x69, 0x8b, 0x55, 0x09, 0x00, 0x00,
+func (m *SwapStats) GetFees() types.Coin {
+	if m != nil {
+		return m.Fees
+	}
+	return types.Coin{}
 }
 
-func (m *SupplyLimit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SwapStats) GetClaimTips() types.Coin {
+	if m != nil {
+		return m.ClaimTips
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *SupplyLimit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DailySwapStats holds the swap counters and volumes of an asset for one UTC day
+type DailySwapStats struct {
+	// unix seconds of the start of the UTC day
+	Day   int64     `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty" yaml:"day"`
+	Stats SwapStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats" yaml:"stats"`
 }
 
-func (m *SupplyLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TimeBasedLimit.Size()
-		i -= size
-		if _, err := m.TimeBasedLimit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *DailySwapStats) Reset()         { *m = DailySwapStats{} }
+func (m *DailySwapStats) String() string { return proto.CompactTextString(m) }
+func (*DailySwapStats) ProtoMessage()    {}
+func (*DailySwapStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd4db49772fc257b, []int{7}
+}
+func (m *DailySwapStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DailySwapStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DailySwapStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	if m.TimePeriod != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TimePeriod))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *DailySwapStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DailySwapStats.Merge(m, src)
+}
+func (m *DailySwapStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *DailySwapStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_DailySwapStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DailySwapStats proto.InternalMessageInfo
+
+func (m *DailySwapStats) GetDay() int64 {
+	if m != nil {
+		return m.Day
 	}
-	if m.TimeLimited {
-		i--
-		if m.TimeLimited {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *DailySwapStats) GetStats() SwapStats {
+	if m != nil {
+		return m.Stats
 	}
-	{
-		size := m.Limit.Size()
-		i -= size
-		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return SwapStats{}
+}
+
+// DeputyActivity records the last block a deputy created an incoming swap or
+// claimed an outgoing swap in
+type DeputyActivity struct {
+	DeputyAddress    string    `protobuf:"bytes,1,opt,name=deputy_address,json=deputyAddress,proto3" json:"deputy_address,omitempty" yaml:"deputy_address"`
+	LastActiveHeight int64     `protobuf:"varint,2,opt,name=last_active_height,json=lastActiveHeight,proto3" json:"last_active_height,omitempty" yaml:"last_active_height"`
+	LastActiveTime   time.Time `protobuf:"bytes,3,opt,name=last_active_time,json=lastActiveTime,proto3,stdtime" json:"last_active_time" yaml:"last_active_time"`
+}
+
+func (m *DeputyActivity) Reset()         { *m = DeputyActivity{} }
+func (m *DeputyActivity) String() string { return proto.CompactTextString(m) }
+func (*DeputyActivity) ProtoMessage()    {}
+func (*DeputyActivity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd4db49772fc257b, []int{8}
+}
+func (m *DeputyActivity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeputyActivity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeputyActivity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *DeputyActivity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeputyActivity.Merge(m, src)
+}
+func (m *DeputyActivity) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeputyActivity) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeputyActivity.DiscardUnknown(m)
 }
 
-func (m *AssetParam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DeputyActivity proto.InternalMessageInfo
+
+func (m *DeputyActivity) GetDeputyAddress() string {
+	if m != nil {
+		return m.DeputyAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *AssetParam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DeputyActivity) GetLastActiveHeight() int64 {
+	if m != nil {
+		return m.LastActiveHeight
+	}
+	return 0
 }
 
-func (m *AssetParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SwapTimeSpanMin != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SwapTimeSpanMin))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.SwapTimestamp != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SwapTimestamp))
-		i--
-		dAtA[i] = 0x48
+func (m *DeputyActivity) GetLastActiveTime() time.Time {
+	if m != nil {
+		return m.LastActiveTime
 	}
-	{
-		size := m.MaxSwapAmount.Size()
-		i -= size
-		if _, err := m.MaxSwapAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.MinSwapAmount.Size()
-		i -= size
-		if _, err := m.MinSwapAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.FixedFee.Size()
-		i -= size
-		if _, err := m.FixedFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.DeputyAddress) > 0 {
-		i -= len(m.DeputyAddress)
-		copy(dAtA[i:], m.DeputyAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DeputyAddress)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Active {
-		i--
-		if m.Active {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size, err := m.SupplyLimit.MarshalToSizedBuffer(dAtA[:i])
+	return time.Time{}
+}
+
+// AssetSuspension marks an asset whose new outgoing swaps are suspended
+// because its deputy has been inactive for too long
+type AssetSuspension struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// height of the block the asset was suspended in
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+}
+
+func (m *AssetSuspension) Reset()         { *m = AssetSuspension{} }
+func (m *AssetSuspension) String() string { return proto.CompactTextString(m) }
+func (*AssetSuspension) ProtoMessage()    {}
+func (*AssetSuspension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd4db49772fc257b, []int{9}
+}
+func (m *AssetSuspension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetSuspension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetSuspension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.CoinID != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.CoinID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *AssetSuspension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetSuspension.Merge(m, src)
 }
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AssetSuspension) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetSuspension) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetSuspension.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AssetParams) > 0 {
-		for iNdEx := len(m.AssetParams) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AssetParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_AssetSuspension proto.InternalMessageInfo
+
+func (m *AssetSuspension) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *AssetSupply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AssetSuspension) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *AssetSupply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DeputyBond is the collateral a deputy has bonded into the module account
+type DeputyBond struct {
+	DeputyAddress string                                   `protobuf:"bytes,1,opt,name=deputy_address,json=deputyAddress,proto3" json:"deputy_address,omitempty" yaml:"deputy_address"`
+	Amount        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
 }
 
-func (m *AssetSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TimeElapsed != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeElapsed))
-		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size, err := m.TimeLimitedCurrentSupply.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.CurrentSupply.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.OutgoingSupply.MarshalToSizedBuffer(dAtA[:i])
+func (m *DeputyBond) Reset()         { *m = DeputyBond{} }
+func (m *DeputyBond) String() string { return proto.CompactTextString(m) }
+func (*DeputyBond) ProtoMessage()    {}
+func (*DeputyBond) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd4db49772fc257b, []int{10}
+}
+func (m *DeputyBond) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeputyBond) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeputyBond.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.IncomingSupply.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+}
+func (m *DeputyBond) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeputyBond.Merge(m, src)
+}
+func (m *DeputyBond) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeputyBond) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeputyBond.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeputyBond proto.InternalMessageInfo
+
+func (m *DeputyBond) GetDeputyAddress() string {
+	if m != nil {
+		return m.DeputyAddress
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *AssetSupplies) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DeputyBond) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AssetSupplies) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DeputyUnbonding is collateral that is returned to a deputy once its
+// unbonding period has passed. It can be slashed until then.
+type DeputyUnbonding struct {
+	DeputyAddress  string                                   `protobuf:"bytes,1,opt,name=deputy_address,json=deputyAddress,proto3" json:"deputy_address,omitempty" yaml:"deputy_address"`
+	Amount         github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+	CompletionTime time.Time                                `protobuf:"bytes,3,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
 }
 
-func (m *AssetSupplies) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AssetSupplies) > 0 {
-		for iNdEx := len(m.AssetSupplies) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AssetSupplies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *DeputyUnbonding) Reset()         { *m = DeputyUnbonding{} }
+func (m *DeputyUnbonding) String() string { return proto.CompactTextString(m) }
+func (*DeputyUnbonding) ProtoMessage()    {}
+func (*DeputyUnbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd4db49772fc257b, []int{11}
+}
+func (m *DeputyUnbonding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeputyUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeputyUnbonding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DeputyUnbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeputyUnbonding.Merge(m, src)
+}
+func (m *DeputyUnbonding) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeputyUnbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeputyUnbonding.DiscardUnknown(m)
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DeputyUnbonding proto.InternalMessageInfo
+
+func (m *DeputyUnbonding) GetDeputyAddress() string {
+	if m != nil {
+		return m.DeputyAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DeputyUnbonding) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PreviousBlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PreviousBlockTime):])
-	if err6 != nil {
-		return 0, err6
+func (m *DeputyUnbonding) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
 	}
-	i -= n6
-	i = encodeVarintGenesis(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.Supplies.MarshalToSizedBuffer(dAtA[:i])
+	return time.Time{}
+}
+
+// LightClientState is the trusted state of the counterparty chain of an asset,
+// against which the proofs of incoming swaps are verified
+type LightClientState struct {
+	// the asset whose counterparty chain is followed
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// chain ID of the counterparty chain
+	ChainID string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	// height of the latest trusted header of the counterparty chain
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	// root of the counterparty chain state at height, which inclusion proofs
+	// are verified against
+	Root []byte `protobuf:"bytes,4,opt,name=root,proto3" json:"root,omitempty" yaml:"root"`
+	// state specific to the verifier, such as the trusted validator set
+	Data []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty" yaml:"data"`
+}
+
+func (m *LightClientState) Reset()         { *m = LightClientState{} }
+func (m *LightClientState) String() string { return proto.CompactTextString(m) }
+func (*LightClientState) ProtoMessage()    {}
+func (*LightClientState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd4db49772fc257b, []int{12}
+}
+func (m *LightClientState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightClientState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightClientState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.AtomicSwaps) > 0 {
-		for iNdEx := len(m.AtomicSwaps) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AtomicSwaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+}
+func (m *LightClientState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightClientState.Merge(m, src)
+}
+func (m *LightClientState) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightClientState) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightClientState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightClientState proto.InternalMessageInfo
+
+func (m *LightClientState) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *LightClientState) GetChainID() string {
+	if m != nil {
+		return m.ChainID
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *LightClientState) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *SupplyLimit) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *LightClientState) GetRoot() []byte {
+	if m != nil {
+		return m.Root
 	}
-	var l int
-	_ = l
-	l = m.Limit.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if m.TimeLimited {
-		n += 2
+	return nil
+}
+
+func (m *LightClientState) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	if m.TimePeriod != 0 {
-		n += 1 + sovGenesis(uint64(m.TimePeriod))
+	return nil
+}
+
+// type GenesisState struct {
+type GenesisState struct {
+	Params            Params        `protobuf:"bytes,1,opt,name=params,proto3" json:"params" yaml:"params"`
+	AtomicSwaps       []AtomicSwap  `protobuf:"bytes,2,rep,name=atomic_swaps,json=atomicSwaps,proto3" json:"atomic_swaps" yaml:"atomic_swaps"`
+	Supplies          AssetSupplies `protobuf:"bytes,3,opt,name=supplies,proto3" json:"supplies" yaml:"supplies"`
+	PreviousBlockTime time.Time     `protobuf:"bytes,4,opt,name=previous_block_time,json=previousBlockTime,proto3,stdtime" json:"previous_block_time" yaml:"previous_block_time"`
+	// addresses, local or on the other chain, that may not take part in swaps
+	DenyList []string `protobuf:"bytes,5,rep,name=deny_list,json=denyList,proto3" json:"deny_list,omitempty" yaml:"deny_list"`
+	// cumulative swap stats per asset
+	Stats []SwapStats `protobuf:"bytes,6,rep,name=stats,proto3" json:"stats" yaml:"stats"`
+	// swap stats per asset and day
+	DailyStats []DailySwapStats `protobuf:"bytes,7,rep,name=daily_stats,json=dailyStats,proto3" json:"daily_stats" yaml:"daily_stats"`
+	// last activity of each deputy
+	DeputyActivity []DeputyActivity `protobuf:"bytes,8,rep,name=deputy_activity,json=deputyActivity,proto3" json:"deputy_activity" yaml:"deputy_activity"`
+	// assets suspended for new outgoing swaps
+	SuspendedAssets []AssetSuspension `protobuf:"bytes,9,rep,name=suspended_assets,json=suspendedAssets,proto3" json:"suspended_assets" yaml:"suspended_assets"`
+	// collateral bonded by deputies
+	DeputyBonds []DeputyBond `protobuf:"bytes,10,rep,name=deputy_bonds,json=deputyBonds,proto3" json:"deputy_bonds" yaml:"deputy_bonds"`
+	// collateral being unbonded by deputies
+	DeputyUnbondings []DeputyUnbonding `protobuf:"bytes,11,rep,name=deputy_unbondings,json=deputyUnbondings,proto3" json:"deputy_unbondings" yaml:"deputy_unbondings"`
+	// height of the last block of the exporting chain, set by an export for a zero height genesis. When
+	// set, InitGenesis rebases the recorded heights relative to it and the recorded times relative to the
+	// previous block time.
+	ExportHeight int64 `protobuf:"varint,12,opt,name=export_height,json=exportHeight,proto3" json:"export_height,omitempty" yaml:"export_height"`
+	// trusted states of the counterparty chains of assets that verify
+	// incoming swaps
+	LightClientStates []LightClientState `protobuf:"bytes,13,rep,name=light_client_states,json=lightClientStates,proto3" json:"light_client_states" yaml:"light_client_states"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd4db49772fc257b, []int{13}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.TimeBasedLimit.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
 }
 
-func (m *AssetParam) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return Params{}
+}
+
+func (m *GenesisState) GetAtomicSwaps() []AtomicSwap {
+	if m != nil {
+		return m.AtomicSwaps
 	}
-	if m.CoinID != 0 {
-		n += 1 + sovGenesis(uint64(m.CoinID))
+	return nil
+}
+
+func (m *GenesisState) GetSupplies() AssetSupplies {
+	if m != nil {
+		return m.Supplies
 	}
-	l = m.SupplyLimit.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if m.Active {
-		n += 2
+	return AssetSupplies{}
+}
+
+func (m *GenesisState) GetPreviousBlockTime() time.Time {
+	if m != nil {
+		return m.PreviousBlockTime
 	}
-	l = len(m.DeputyAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return time.Time{}
+}
+
+func (m *GenesisState) GetDenyList() []string {
+	if m != nil {
+		return m.DenyList
 	}
-	l = m.FixedFee.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.MinSwapAmount.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.MaxSwapAmount.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if m.SwapTimestamp != 0 {
-		n += 1 + sovGenesis(uint64(m.SwapTimestamp))
+	return nil
+}
+
+func (m *GenesisState) GetStats() []SwapStats {
+	if m != nil {
+		return m.Stats
 	}
-	if m.SwapTimeSpanMin != 0 {
-		n += 1 + sovGenesis(uint64(m.SwapTimeSpanMin))
+	return nil
+}
+
+func (m *GenesisState) GetDailyStats() []DailySwapStats {
+	if m != nil {
+		return m.DailyStats
 	}
-	return n
+	return nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GenesisState) GetDeputyActivity() []DeputyActivity {
+	if m != nil {
+		return m.DeputyActivity
 	}
-	var l int
-	_ = l
-	if len(m.AssetParams) > 0 {
-		for _, e := range m.AssetParams {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return nil
+}
+
+func (m *GenesisState) GetSuspendedAssets() []AssetSuspension {
+	if m != nil {
+		return m.SuspendedAssets
 	}
-	return n
+	return nil
 }
 
-func (m *AssetSupply) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GenesisState) GetDeputyBonds() []DeputyBond {
+	if m != nil {
+		return m.DeputyBonds
 	}
-	var l int
-	_ = l
-	l = m.IncomingSupply.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.OutgoingSupply.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.CurrentSupply.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.TimeLimitedCurrentSupply.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if m.TimeElapsed != 0 {
-		n += 1 + sovGenesis(uint64(m.TimeElapsed))
+	return nil
+}
+
+func (m *GenesisState) GetDeputyUnbondings() []DeputyUnbonding {
+	if m != nil {
+		return m.DeputyUnbondings
 	}
-	return n
+	return nil
 }
 
-func (m *AssetSupplies) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GenesisState) GetExportHeight() int64 {
+	if m != nil {
+		return m.ExportHeight
 	}
-	var l int
-	_ = l
-	if len(m.AssetSupplies) > 0 {
-		for _, e := range m.AssetSupplies {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	return 0
+}
+
+func (m *GenesisState) GetLightClientStates() []LightClientState {
+	if m != nil {
+		return m.LightClientStates
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SupplyLimit)(nil), "bep3.SupplyLimit")
+	proto.RegisterType((*AssetParam)(nil), "bep3.AssetParam")
+	proto.RegisterType((*Params)(nil), "bep3.Params")
+	proto.RegisterType((*LongtermStorageRetention)(nil), "bep3.LongtermStorageRetention")
+	proto.RegisterType((*AssetSupply)(nil), "bep3.AssetSupply")
+	proto.RegisterType((*AssetSupplies)(nil), "bep3.AssetSupplies")
+	proto.RegisterType((*SwapStats)(nil), "bep3.SwapStats")
+	proto.RegisterType((*DailySwapStats)(nil), "bep3.DailySwapStats")
+	proto.RegisterType((*DeputyActivity)(nil), "bep3.DeputyActivity")
+	proto.RegisterType((*AssetSuspension)(nil), "bep3.AssetSuspension")
+	proto.RegisterType((*DeputyBond)(nil), "bep3.DeputyBond")
+	proto.RegisterType((*DeputyUnbonding)(nil), "bep3.DeputyUnbonding")
+	proto.RegisterType((*LightClientState)(nil), "bep3.LightClientState")
+	proto.RegisterType((*GenesisState)(nil), "bep3.GenesisState")
+}
+
+func init() { proto.RegisterFile("bep3/genesis.proto", fileDescriptor_dd4db49772fc257b) }
+
+var fileDescriptor_dd4db49772fc257b = []byte{
+	// 2028 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4d, 0x6f, 0x24, 0x47,
+	0xf9, 0xdf, 0xf1, 0x8c, 0xc7, 0x33, 0xcf, 0xbc, 0xba, 0xbc, 0x5e, 0xf7, 0x3a, 0xc9, 0xb4, 0xff,
+	0x95, 0xfc, 0x57, 0x5e, 0x44, 0x66, 0xb4, 0x1b, 0x21, 0xc4, 0x46, 0x44, 0xb8, 0xed, 0x84, 0x35,
+	0x18, 0xd8, 0x94, 0x17, 0x90, 0x22, 0xa1, 0x56, 0xcd, 0x74, 0x79, 0xdc, 0xa4, 0xdf, 0x34, 0xd5,
+	0xe3, 0xd8, 0x57, 0x84, 0x84, 0xc4, 0x29, 0x47, 0xb8, 0x71, 0x43, 0x70, 0xe4, 0x2b, 0xc0, 0x21,
+	0xc7, 0x48, 0x5c, 0x10, 0x87, 0x0e, 0xf2, 0xde, 0x72, 0x9c, 0x2f, 0x00, 0xaa, 0x97, 0x9e, 0x7e,
+	0xf1, 0x2e, 0xb6, 0x03, 0x17, 0x4e, 0x53, 0xf5, 0xbc, 0xfc, 0x7e, 0x53, 0x55, 0x4f, 0x3d, 0xcf,
+	0x53, 0x0d, 0x68, 0xcc, 0xa2, 0x77, 0x46, 0x53, 0x16, 0x30, 0xee, 0xf2, 0x61, 0x34, 0x0b, 0xe3,
+	0x10, 0xd5, 0x84, 0x6c, 0xfb, 0xee, 0x34, 0x9c, 0x86, 0x52, 0x30, 0x12, 0x23, 0xa5, 0xdb, 0x36,
+	0xa7, 0x61, 0x38, 0xf5, 0xd8, 0x48, 0xce, 0xc6, 0xf3, 0x93, 0x51, 0xec, 0xfa, 0x8c, 0xc7, 0xd4,
+	0x8f, 0xb4, 0xc1, 0x60, 0x12, 0x72, 0x3f, 0xe4, 0xa3, 0x31, 0xe5, 0x6c, 0x74, 0xf6, 0x68, 0xcc,
+	0x62, 0xfa, 0x68, 0x34, 0x09, 0xdd, 0x40, 0xeb, 0x7b, 0x92, 0x90, 0x7f, 0x42, 0xb5, 0x03, 0xfe,
+	0xeb, 0x0a, 0xb4, 0x8e, 0xe7, 0x51, 0xe4, 0x5d, 0x1c, 0xb9, 0xbe, 0x1b, 0xa3, 0xe7, 0xb0, 0xea,
+	0x89, 0x81, 0x51, 0xd9, 0xa9, 0xec, 0x36, 0xad, 0xf7, 0x3e, 0x4b, 0xcc, 0x3b, 0x7f, 0x4f, 0xcc,
+	0x07, 0x53, 0x37, 0x3e, 0x9d, 0x8f, 0x87, 0x93, 0xd0, 0x1f, 0x69, 0x0a, 0xf5, 0xf3, 0x36, 0x77,
+	0x3e, 0x1e, 0xc5, 0x17, 0x11, 0xe3, 0xc3, 0xc3, 0x20, 0x5e, 0x24, 0x66, 0xfb, 0x82, 0xfa, 0xde,
+	0x13, 0x2c, 0x41, 0x30, 0x51, 0x60, 0xe8, 0x09, 0xb4, 0xc5, 0x3f, 0xb5, 0xe5, 0x8c, 0x39, 0xc6,
+	0xca, 0x4e, 0x65, 0xb7, 0x61, 0x6d, 0x2d, 0x12, 0x73, 0x43, 0x99, 0xe7, 0xb5, 0x98, 0xb4, 0xc4,
+	0xf4, 0x48, 0xcd, 0xd0, 0x37, 0x41, 0x4e, 0xed, 0x88, 0xcd, 0xdc, 0xd0, 0x31, 0xaa, 0x3b, 0x95,
+	0xdd, 0xaa, 0x75, 0x6f, 0x91, 0x98, 0x28, 0xe7, 0xaa, 0x94, 0x98, 0x80, 0x98, 0x3d, 0x93, 0x13,
+	0xc4, 0xa1, 0x2f, 0x75, 0x62, 0x2f, 0x1c, 0x05, 0x6e, 0xd4, 0xe4, 0xaa, 0x0e, 0x6f, 0xbd, 0xaa,
+	0xad, 0x1c, 0x57, 0x0e, 0x0f, 0x93, 0xae, 0x10, 0x59, 0x42, 0x22, 0xff, 0xef, 0x93, 0xda, 0x6f,
+	0x7e, 0x67, 0xde, 0xc1, 0x7f, 0x68, 0x01, 0xec, 0x71, 0xce, 0xe2, 0x67, 0x74, 0x46, 0x7d, 0xf4,
+	0x00, 0x56, 0x1d, 0x16, 0x84, 0xbe, 0xde, 0xd4, 0x7e, 0xb6, 0x4d, 0x52, 0x8c, 0x89, 0x52, 0xa3,
+	0x6f, 0xc0, 0x9a, 0x38, 0x2b, 0xdb, 0x55, 0x3b, 0x54, 0xb5, 0x5e, 0xbf, 0x4c, 0xcc, 0xfa, 0x7e,
+	0xe8, 0x06, 0x87, 0x07, 0x8b, 0xc4, 0xec, 0x2a, 0x1f, 0x6d, 0x82, 0x49, 0x5d, 0x8c, 0x0e, 0x1d,
+	0xf4, 0x21, 0xb4, 0xb9, 0x3c, 0x42, 0xbd, 0x48, 0xb1, 0x45, 0xad, 0xc7, 0xeb, 0x43, 0x71, 0xd6,
+	0xc3, 0xdc, 0xe1, 0x5a, 0xaf, 0x89, 0x75, 0x67, 0x9b, 0x9e, 0x77, 0xc2, 0xa4, 0xc5, 0x73, 0x61,
+	0xf0, 0x10, 0xea, 0x74, 0x12, 0xbb, 0x67, 0x4c, 0xee, 0x58, 0xc3, 0x5a, 0x5f, 0x24, 0x66, 0x47,
+	0x79, 0x29, 0x39, 0x26, 0xda, 0x00, 0x7d, 0x07, 0xba, 0x0e, 0x8b, 0xe6, 0xf1, 0x85, 0x4d, 0x1d,
+	0x67, 0xc6, 0x38, 0x37, 0x56, 0xe5, 0x2a, 0xef, 0x2f, 0x12, 0x73, 0x33, 0x5d, 0x65, 0x5e, 0x8f,
+	0x49, 0x47, 0x09, 0xf6, 0xd4, 0x1c, 0xd9, 0xd0, 0x3c, 0x71, 0xcf, 0x99, 0x63, 0x9f, 0x30, 0x66,
+	0xd4, 0xa5, 0xb3, 0x75, 0xeb, 0x13, 0xea, 0x2b, 0xaa, 0x25, 0x10, 0x26, 0x0d, 0x39, 0xfe, 0x80,
+	0x31, 0x14, 0x41, 0xcf, 0x77, 0x03, 0x5b, 0x84, 0xbd, 0x4d, 0xfd, 0x70, 0x1e, 0xc4, 0xc6, 0x9a,
+	0xa4, 0x79, 0x7a, 0x6b, 0x9a, 0x7b, 0x8a, 0xa6, 0x04, 0x87, 0x49, 0xc7, 0x77, 0x83, 0xe3, 0x4f,
+	0x68, 0xb4, 0x27, 0xe7, 0x92, 0x91, 0x9e, 0x17, 0x18, 0x1b, 0xff, 0x75, 0x46, 0x7a, 0x9e, 0x63,
+	0xb4, 0xa0, 0x29, 0xd5, 0x22, 0x1e, 0x8d, 0xa6, 0x8c, 0x9e, 0xff, 0xbf, 0x4c, 0xcc, 0x8e, 0x30,
+	0x79, 0x9e, 0x66, 0x89, 0x6c, 0x9f, 0x96, 0xb6, 0x98, 0x34, 0xb8, 0x36, 0x41, 0xdf, 0x03, 0xb4,
+	0x94, 0xdb, 0x3c, 0xa2, 0x81, 0xed, 0xbb, 0x81, 0x01, 0x12, 0xec, 0x8d, 0x45, 0x62, 0xde, 0x2f,
+	0xf9, 0x2e, 0x6d, 0x30, 0xe9, 0xa5, 0x20, 0xc7, 0x11, 0x0d, 0x7e, 0xe0, 0x06, 0xe8, 0x39, 0x6c,
+	0x8a, 0x1d, 0xd0, 0x47, 0xef, 0x06, 0x32, 0x5a, 0xdc, 0xf8, 0xc2, 0x68, 0x49, 0xb8, 0x9d, 0x45,
+	0x62, 0xbe, 0xae, 0x57, 0xf6, 0x32, 0x33, 0x4c, 0x36, 0x7c, 0x7a, 0x7e, 0x20, 0xc5, 0x87, 0x4b,
+	0x29, 0xfa, 0x45, 0x05, 0x5a, 0xda, 0x76, 0x1c, 0x06, 0x8e, 0xd1, 0xde, 0xa9, 0xee, 0xb6, 0x1e,
+	0xdf, 0x1f, 0xaa, 0xbd, 0x1b, 0x8a, 0xab, 0x39, 0xd4, 0x69, 0x6f, 0x28, 0xee, 0x8d, 0xf5, 0x81,
+	0x0e, 0x79, 0x54, 0x88, 0x44, 0xe1, 0x8b, 0xff, 0xf8, 0x85, 0xb9, 0x7b, 0x83, 0x53, 0x10, 0x30,
+	0x9c, 0x80, 0xf2, 0xb4, 0xc2, 0xc0, 0x41, 0x1f, 0xc1, 0x96, 0xc6, 0x99, 0x07, 0x02, 0xc9, 0x0d,
+	0xa6, 0x69, 0x76, 0xea, 0xc8, 0xc5, 0xe1, 0x45, 0x62, 0x0e, 0x0a, 0x84, 0x65, 0x43, 0x4c, 0x36,
+	0x95, 0xe6, 0xc7, 0xa9, 0x42, 0x27, 0xad, 0xdf, 0x56, 0x60, 0x43, 0xfb, 0x70, 0x8f, 0xf2, 0xd3,
+	0x34, 0x7a, 0xba, 0xd7, 0x2d, 0xf4, 0x87, 0x7a, 0xa1, 0xdb, 0x05, 0xde, 0x3c, 0xc6, 0xed, 0x16,
+	0xbc, 0xae, 0x10, 0x8e, 0x05, 0xc0, 0x32, 0xc4, 0x7a, 0x0a, 0x2f, 0x0c, 0xec, 0x19, 0x3b, 0x99,
+	0x07, 0x8e, 0xd1, 0x93, 0xd9, 0x61, 0x3b, 0x0b, 0xd3, 0x92, 0x01, 0x26, 0x1d, 0x29, 0xf9, 0x51,
+	0x40, 0xe4, 0x1c, 0xed, 0x43, 0xef, 0x8c, 0xcd, 0xdc, 0x13, 0x71, 0xd6, 0x93, 0xd0, 0x77, 0x83,
+	0xa9, 0xd1, 0x2f, 0x63, 0x94, 0x0c, 0x30, 0xe9, 0x2a, 0xc9, 0xa1, 0x16, 0xa0, 0xf7, 0xa1, 0xe5,
+	0x8e, 0x27, 0xf6, 0xe4, 0x94, 0x06, 0x01, 0xf3, 0x8c, 0x75, 0x79, 0xb3, 0xde, 0xba, 0x4c, 0x4c,
+	0x38, 0xb4, 0xf6, 0xf7, 0x95, 0x34, 0x3b, 0xf3, 0x9c, 0x29, 0x26, 0xe0, 0x8e, 0x27, 0xda, 0x42,
+	0xe7, 0xea, 0x2f, 0x2b, 0x50, 0x97, 0x69, 0x9a, 0xa3, 0x67, 0xd0, 0xa6, 0x9c, 0xb3, 0xd8, 0x8e,
+	0xe4, 0xdc, 0xa8, 0xc8, 0x4d, 0xef, 0xab, 0x44, 0x9a, 0xe5, 0xf3, 0x72, 0x1e, 0xcd, 0xfb, 0x60,
+	0xd2, 0xa2, 0x4b, 0x43, 0x8e, 0x7e, 0x55, 0x81, 0x6d, 0x2f, 0x0c, 0xa6, 0x31, 0x9b, 0xf9, 0x36,
+	0x8f, 0xc3, 0x19, 0x9d, 0x32, 0x7b, 0xc6, 0x62, 0x16, 0xc4, 0x6e, 0x18, 0xc8, 0x2c, 0xdf, 0x7a,
+	0x3c, 0x50, 0x04, 0x47, 0xda, 0xee, 0x58, 0x99, 0x91, 0xd4, 0xca, 0x7a, 0xa8, 0xe9, 0xfe, 0x4f,
+	0x97, 0xd6, 0x57, 0xe2, 0x61, 0x62, 0x78, 0xaf, 0x00, 0xd1, 0x8b, 0xf5, 0xc1, 0x78, 0x15, 0x0d,
+	0x7a, 0x13, 0x6a, 0xf3, 0x60, 0x59, 0xf9, 0x7b, 0x8b, 0xc4, 0x6c, 0x29, 0x42, 0x21, 0xc5, 0x44,
+	0x2a, 0x45, 0x29, 0x3b, 0xa3, 0xde, 0x9c, 0xe9, 0x02, 0x95, 0x2b, 0x65, 0x52, 0x8c, 0x89, 0x52,
+	0x6b, 0xba, 0x7f, 0x56, 0xa1, 0x25, 0xf7, 0x4d, 0x55, 0x21, 0x34, 0x86, 0x5e, 0x7a, 0xaa, 0xb6,
+	0x2a, 0x37, 0x92, 0xed, 0xdf, 0x06, 0xf6, 0x40, 0xaf, 0x5e, 0x07, 0x47, 0xc9, 0x1f, 0x93, 0x6e,
+	0x2a, 0xc9, 0x38, 0xc2, 0x79, 0x3c, 0x0d, 0x73, 0x1c, 0x2b, 0xb7, 0xe4, 0x28, 0xf9, 0x63, 0xd2,
+	0x4d, 0x25, 0x9a, 0xc3, 0x86, 0xee, 0x64, 0x3e, 0x9b, 0xb1, 0x20, 0x4e, 0x29, 0xaa, 0xd7, 0x51,
+	0xbc, 0xa1, 0x29, 0x74, 0x49, 0x2c, 0xba, 0x63, 0xd2, 0xd1, 0x02, 0x4d, 0xf0, 0xcb, 0x0a, 0xbc,
+	0x96, 0xef, 0x89, 0xec, 0x12, 0x5d, 0xed, 0x3a, 0xba, 0xaf, 0x69, 0x3a, 0x7c, 0xb5, 0xbf, 0xb2,
+	0xcb, 0xdc, 0x46, 0xae, 0xdd, 0xda, 0x2f, 0xfc, 0x8d, 0xb4, 0x6f, 0x63, 0x1e, 0x8d, 0x38, 0x73,
+	0x64, 0x65, 0xaf, 0x5e, 0xe9, 0xdb, 0xb4, 0x56, 0xf7, 0x6d, 0xef, 0xab, 0x99, 0x8e, 0x80, 0x53,
+	0xe8, 0x64, 0x01, 0xe0, 0x32, 0x8e, 0x7e, 0x0a, 0x5d, 0x75, 0x5f, 0xb8, 0x96, 0xe8, 0x5b, 0xb6,
+	0x9e, 0xbb, 0x65, 0x8a, 0xbd, 0xbc, 0x65, 0x45, 0x37, 0x4c, 0x3a, 0x34, 0x0f, 0x8c, 0x7f, 0x5f,
+	0x83, 0xa6, 0x28, 0x76, 0xc7, 0x31, 0x8d, 0xf9, 0x8d, 0x5b, 0xae, 0xaf, 0xc3, 0xda, 0x64, 0xc6,
+	0x68, 0xda, 0x94, 0xd6, 0x2c, 0x94, 0x6b, 0xb4, 0x94, 0x02, 0x93, 0xd4, 0x44, 0x5a, 0x7b, 0xd4,
+	0xf5, 0x99, 0xea, 0x43, 0x8b, 0xd6, 0x4a, 0x21, 0xac, 0xd5, 0x08, 0x8d, 0xa0, 0xa1, 0xb2, 0x20,
+	0x73, 0xe4, 0x81, 0xd5, 0xac, 0x8d, 0x45, 0x62, 0xf6, 0x94, 0x79, 0xaa, 0xc1, 0x64, 0x69, 0x54,
+	0xb8, 0x1e, 0x67, 0xa1, 0x37, 0xf7, 0x99, 0xb1, 0x7a, 0xdd, 0x41, 0xbf, 0xea, 0x7a, 0x28, 0xff,
+	0xdc, 0xf5, 0xf8, 0x89, 0x14, 0x14, 0xae, 0x87, 0xe6, 0xa8, 0x7f, 0xd5, 0xeb, 0xb1, 0xe4, 0x48,
+	0x25, 0x9a, 0xc3, 0x82, 0xda, 0x09, 0x63, 0xdc, 0x58, 0xbb, 0x0e, 0x78, 0x43, 0x03, 0xeb, 0x44,
+	0x23, 0x9c, 0x30, 0x91, 0xbe, 0xe8, 0x18, 0x40, 0xee, 0xa3, 0x1d, 0xbb, 0x11, 0x37, 0x1a, 0xd7,
+	0x21, 0xdd, 0xd7, 0x48, 0xeb, 0xb9, 0xc3, 0x90, 0xae, 0x98, 0x34, 0xe5, 0xe4, 0xb9, 0x1b, 0x71,
+	0x1d, 0x93, 0x21, 0x74, 0x0f, 0xa8, 0xeb, 0x5d, 0x64, 0xd1, 0xb2, 0x03, 0x55, 0x87, 0xaa, 0x5c,
+	0x54, 0xb5, 0xba, 0x8b, 0xc4, 0x04, 0x1d, 0x2b, 0xf4, 0x02, 0x13, 0xa1, 0x42, 0xef, 0xc2, 0x2a,
+	0x17, 0xa6, 0x3a, 0x97, 0xf4, 0x74, 0x73, 0x9d, 0x22, 0x58, 0x77, 0x35, 0xbf, 0x0e, 0x32, 0x69,
+	0x8b, 0x89, 0xf2, 0xc1, 0xbf, 0x5e, 0x81, 0xae, 0x6a, 0x65, 0xf6, 0xd2, 0x46, 0xe6, 0x6a, 0xd7,
+	0x5c, 0xb9, 0x65, 0xd7, 0xfc, 0x7d, 0x40, 0x1e, 0xe5, 0xb1, 0xad, 0xda, 0x70, 0xfb, 0x94, 0xb9,
+	0xd3, 0xd3, 0xd8, 0x58, 0x29, 0x37, 0x6b, 0x57, 0x6d, 0x30, 0xe9, 0x0b, 0xa1, 0xfc, 0x2b, 0xec,
+	0xa9, 0x14, 0x21, 0x17, 0xfa, 0x79, 0x43, 0xd9, 0x44, 0xaa, 0x94, 0xb6, 0x3d, 0x54, 0x6f, 0xce,
+	0x61, 0xfa, 0xe6, 0x1c, 0x2e, 0xbb, 0x49, 0xeb, 0x4d, 0xbd, 0xe8, 0xad, 0xab, 0x54, 0x02, 0x01,
+	0x7f, 0xfa, 0x85, 0x59, 0x21, 0xdd, 0x8c, 0x4c, 0x78, 0x62, 0x07, 0x7a, 0xfa, 0x92, 0xf3, 0x88,
+	0x05, 0x5c, 0x54, 0x9e, 0x9b, 0x5e, 0xd6, 0x87, 0x50, 0x2f, 0x2c, 0x33, 0xf7, 0x2a, 0x49, 0x97,
+	0xa6, 0x0d, 0xf0, 0x5f, 0x2a, 0x00, 0x07, 0x59, 0xcb, 0xf6, 0x9f, 0x6f, 0x77, 0x0c, 0x75, 0xdd,
+	0x8a, 0xad, 0x5c, 0xd7, 0x8a, 0xed, 0xe9, 0x6d, 0x49, 0x1f, 0x4c, 0x5f, 0xa1, 0xfb, 0xd2, 0x5c,
+	0xf8, 0x4f, 0x2b, 0xd0, 0x3b, 0x28, 0x36, 0x8a, 0xff, 0xab, 0x6b, 0x41, 0x53, 0xe8, 0x4d, 0x42,
+	0x3f, 0xf2, 0x98, 0xe8, 0x36, 0x6e, 0x1a, 0x62, 0xb8, 0x98, 0x7a, 0x4a, 0x00, 0x3a, 0xc2, 0x32,
+	0xa9, 0x8c, 0xb0, 0x2f, 0x2b, 0xd0, 0x3f, 0x12, 0x51, 0xb0, 0xef, 0xb9, 0xa2, 0x96, 0xc5, 0x34,
+	0x66, 0x37, 0x8e, 0xb1, 0x6f, 0x41, 0x63, 0x72, 0x4a, 0xb3, 0x47, 0x78, 0xd3, 0x1a, 0x5c, 0x26,
+	0xe6, 0xda, 0xfe, 0x29, 0xd5, 0xaf, 0x70, 0x9d, 0xbf, 0x53, 0x23, 0x91, 0xef, 0xa5, 0xce, 0xc9,
+	0x85, 0x67, 0xf5, 0x9a, 0xf0, 0x14, 0xbd, 0xd6, 0x2c, 0x0c, 0xd5, 0xf7, 0x88, 0x76, 0xbe, 0xd7,
+	0x12, 0x52, 0x4c, 0xa4, 0x52, 0x18, 0x39, 0x34, 0xa6, 0xc6, 0x6a, 0xd9, 0x48, 0x48, 0x31, 0x91,
+	0x4a, 0xfc, 0xe7, 0x06, 0xb4, 0xbf, 0xab, 0x3e, 0x20, 0xa9, 0x85, 0xbe, 0x0b, 0xf5, 0x65, 0xfb,
+	0x2a, 0x76, 0xb7, 0xad, 0x52, 0x95, 0x6a, 0x48, 0xad, 0xcd, 0xe2, 0x79, 0xa6, 0x4d, 0x6b, 0x3d,
+	0xca, 0x3a, 0xe0, 0x38, 0xf4, 0xdd, 0x89, 0x7c, 0x6b, 0x72, 0x63, 0xa5, 0xd0, 0x01, 0x4b, 0x8d,
+	0xc8, 0x79, 0x57, 0x3a, 0xe0, 0x9c, 0x8f, 0xe8, 0x80, 0x97, 0x86, 0x1c, 0x3d, 0x85, 0xc6, 0xb2,
+	0xd2, 0xab, 0xe3, 0xde, 0x28, 0x57, 0x7a, 0x97, 0x71, 0x6b, 0x4b, 0x03, 0xf6, 0x72, 0x9f, 0x26,
+	0x64, 0x95, 0x5f, 0x7a, 0xa3, 0x19, 0x6c, 0x44, 0x33, 0x76, 0xe6, 0x86, 0x73, 0x6e, 0x8f, 0xbd,
+	0x70, 0xf2, 0xb1, 0x8a, 0xa1, 0xda, 0xb5, 0x31, 0xf4, 0xa0, 0xf8, 0x34, 0x7a, 0x09, 0x88, 0x8a,
+	0xa3, 0xf5, 0x54, 0x63, 0x09, 0x85, 0x7c, 0x11, 0x3f, 0x82, 0xa6, 0xc3, 0x02, 0xf1, 0x8d, 0x84,
+	0xc7, 0xc6, 0xea, 0x4e, 0x75, 0xb7, 0x69, 0xdd, 0xcd, 0x1e, 0xd1, 0x4b, 0x15, 0x26, 0x0d, 0x31,
+	0x3e, 0x72, 0x79, 0x9c, 0x55, 0x8a, 0xfa, 0x4e, 0xf5, 0xb6, 0x95, 0x02, 0x7d, 0x08, 0x2d, 0x47,
+	0x94, 0x26, 0x5b, 0x41, 0xac, 0x49, 0x88, 0xbb, 0x0a, 0xa2, 0x58, 0xb3, 0xac, 0xed, 0xd2, 0xcb,
+	0x36, 0x73, 0xc3, 0x04, 0xe4, 0x4c, 0xd5, 0xb6, 0x9f, 0x41, 0x2f, 0x4d, 0x07, 0xe9, 0x13, 0xbc,
+	0x51, 0x80, 0x2d, 0x14, 0xa6, 0x72, 0xad, 0x2f, 0xb9, 0x62, 0xd2, 0x75, 0x0a, 0xf6, 0x88, 0x42,
+	0x9f, 0xcb, 0x4c, 0xee, 0x30, 0xc7, 0x96, 0x1d, 0x19, 0x37, 0x9a, 0x12, 0x7f, 0xb3, 0x70, 0xce,
+	0x69, 0xb2, 0xb7, 0xcc, 0x62, 0xd1, 0x28, 0x3b, 0x8b, 0x4f, 0x09, 0xa9, 0x48, 0xba, 0xca, 0xa0,
+	0xcc, 0xbd, 0xdb, 0xb9, 0x01, 0xf9, 0xa0, 0xcc, 0x92, 0x7c, 0x39, 0x28, 0xf3, 0x3e, 0x98, 0xb4,
+	0xb2, 0x07, 0x3c, 0x47, 0x0e, 0xac, 0x97, 0x1f, 0xe6, 0xdc, 0x68, 0xe5, 0xff, 0x75, 0x29, 0xe9,
+	0x5a, 0x3b, 0x1a, 0xdb, 0x78, 0xf9, 0xb3, 0x9e, 0x63, 0xd2, 0x2f, 0x3d, 0xe8, 0x39, 0xfa, 0x36,
+	0x74, 0xd8, 0x79, 0x14, 0xce, 0xe2, 0xb4, 0x38, 0xb7, 0x65, 0x5a, 0x30, 0x16, 0x89, 0x79, 0x57,
+	0xc1, 0x14, 0xd4, 0x98, 0xb4, 0xd5, 0x5c, 0xd7, 0xe4, 0x9f, 0xc3, 0x86, 0x27, 0x06, 0xf6, 0x44,
+	0xa6, 0x31, 0x79, 0xb6, 0x8c, 0x1b, 0x1d, 0xf9, 0x37, 0xef, 0xe9, 0x37, 0x63, 0x29, 0xcd, 0x59,
+	0xb8, 0x18, 0xeb, 0x2f, 0x01, 0xc0, 0x64, 0xdd, 0x2b, 0x79, 0x71, 0xeb, 0xbd, 0xcf, 0x2e, 0x07,
+	0x95, 0xcf, 0x2f, 0x07, 0x95, 0x7f, 0x5c, 0x0e, 0x2a, 0x9f, 0xbe, 0x18, 0xdc, 0xf9, 0xfc, 0xc5,
+	0xe0, 0xce, 0xdf, 0x5e, 0x0c, 0xee, 0x7c, 0xf4, 0x56, 0x2e, 0xcf, 0xb3, 0xb7, 0xfd, 0x30, 0x60,
+	0x17, 0x23, 0xf9, 0x11, 0xd9, 0x0f, 0x9d, 0xb9, 0xc7, 0x54, 0xa6, 0x1f, 0xd7, 0xe5, 0xb5, 0x7b,
+	0xe7, 0x5f, 0x03, 0x00, 0xfb, 0x7c, 0x77, 0x76, 0xd1, 0x16, 0x00, 0x00,
+}
+
+func (m *SupplyLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TimeBasedLimit.Size()
+		i -= size
+		if _, err := m.TimeBasedLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TimePeriod != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimePeriod))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TimeLimited {
+		i--
+		if m.TimeLimited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IBCChannel) > 0 {
+		i -= len(m.IBCChannel)
+		copy(dAtA[i:], m.IBCChannel)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.IBCChannel)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.VerifyIncoming {
+		i--
+		if m.VerifyIncoming {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.SlashOnRefund {
+		i--
+		if m.SlashOnRefund {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.DeputySlashAmount) > 0 {
+		for iNdEx := len(m.DeputySlashAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeputySlashAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.DeputyUnbondingPeriod != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DeputyUnbondingPeriod))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.DeputyBond) > 0 {
+		for iNdEx := len(m.DeputyBond) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeputyBond[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.MaxDeputyInactivity != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxDeputyInactivity))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.SwapTimeSpanMin != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SwapTimeSpanMin))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.SwapTimestamp != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SwapTimestamp))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.MaxSwapAmount.Size()
+		i -= size
+		if _, err := m.MaxSwapAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MinSwapAmount.Size()
+		i -= size
+		if _, err := m.MinSwapAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.FixedFee.Size()
+		i -= size
+		if _, err := m.FixedFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.DeputyAddress) > 0 {
+		i -= len(m.DeputyAddress)
+		copy(dAtA[i:], m.DeputyAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DeputyAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.SupplyLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.CoinID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CoinID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LongtermStorageRetention.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.AssetParams) > 0 {
+		for iNdEx := len(m.AssetParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LongtermStorageRetention) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LongtermStorageRetention) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LongtermStorageRetention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Unit) > 0 {
+		i -= len(m.Unit)
+		copy(dAtA[i:], m.Unit)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Unit)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeElapsed != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeElapsed))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.TimeLimitedCurrentSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.CurrentSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.OutgoingSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.IncomingSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetSupplies) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetSupplies) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetSupplies) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssetSupplies) > 0 {
+		for iNdEx := len(m.AssetSupplies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetSupplies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ClaimTips.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.Fees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.OutgoingVolume.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.IncomingVolume.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Refunded != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Refunded))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Claimed != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Claimed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Created != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Created))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DailySwapStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DailySwapStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DailySwapStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Day != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Day))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeputyActivity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeputyActivity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeputyActivity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastActiveTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastActiveTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintGenesis(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x1a
+	if m.LastActiveHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastActiveHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DeputyAddress) > 0 {
+		i -= len(m.DeputyAddress)
+		copy(dAtA[i:], m.DeputyAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DeputyAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetSuspension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetSuspension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetSuspension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeputyBond) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeputyBond) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeputyBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DeputyAddress) > 0 {
+		i -= len(m.DeputyAddress)
+		copy(dAtA[i:], m.DeputyAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DeputyAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeputyUnbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeputyUnbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeputyUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintGenesis(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DeputyAddress) > 0 {
+		i -= len(m.DeputyAddress)
+		copy(dAtA[i:], m.DeputyAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DeputyAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LightClientState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightClientState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LightClientStates) > 0 {
+		for iNdEx := len(m.LightClientStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LightClientStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.ExportHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExportHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.DeputyUnbondings) > 0 {
+		for iNdEx := len(m.DeputyUnbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeputyUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.DeputyBonds) > 0 {
+		for iNdEx := len(m.DeputyBonds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeputyBonds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.SuspendedAssets) > 0 {
+		for iNdEx := len(m.SuspendedAssets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SuspendedAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.DeputyActivity) > 0 {
+		for iNdEx := len(m.DeputyActivity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeputyActivity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.DailyStats) > 0 {
+		for iNdEx := len(m.DailyStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DailyStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DenyList) > 0 {
+		for iNdEx := len(m.DenyList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DenyList[iNdEx])
+			copy(dAtA[i:], m.DenyList[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.DenyList[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PreviousBlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PreviousBlockTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintGenesis(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Supplies.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AtomicSwaps) > 0 {
+		for iNdEx := len(m.AtomicSwaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AtomicSwaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SupplyLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.TimeLimited {
+		n += 2
+	}
+	if m.TimePeriod != 0 {
+		n += 1 + sovGenesis(uint64(m.TimePeriod))
+	}
+	l = m.TimeBasedLimit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *AssetParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.CoinID != 0 {
+		n += 1 + sovGenesis(uint64(m.CoinID))
+	}
+	l = m.SupplyLimit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Active {
+		n += 2
+	}
+	l = len(m.DeputyAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.FixedFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MinSwapAmount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxSwapAmount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.SwapTimestamp != 0 {
+		n += 1 + sovGenesis(uint64(m.SwapTimestamp))
+	}
+	if m.SwapTimeSpanMin != 0 {
+		n += 1 + sovGenesis(uint64(m.SwapTimeSpanMin))
+	}
+	if m.MaxDeputyInactivity != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxDeputyInactivity))
+	}
+	if len(m.DeputyBond) > 0 {
+		for _, e := range m.DeputyBond {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.DeputyUnbondingPeriod != 0 {
+		n += 1 + sovGenesis(uint64(m.DeputyUnbondingPeriod))
+	}
+	if len(m.DeputySlashAmount) > 0 {
+		for _, e := range m.DeputySlashAmount {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.SlashOnRefund {
+		n += 2
+	}
+	if m.VerifyIncoming {
+		n += 3
+	}
+	l = len(m.IBCChannel)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AssetParams) > 0 {
+		for _, e := range m.AssetParams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.LongtermStorageRetention.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *LongtermStorageRetention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Unit)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovGenesis(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *AssetSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.IncomingSupply.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.OutgoingSupply.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.CurrentSupply.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.TimeLimitedCurrentSupply.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.TimeElapsed != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeElapsed))
+	}
+	return n
+}
+
+func (m *AssetSupplies) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AssetSupplies) > 0 {
+		for _, e := range m.AssetSupplies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SwapStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Created != 0 {
+		n += 1 + sovGenesis(uint64(m.Created))
+	}
+	if m.Claimed != 0 {
+		n += 1 + sovGenesis(uint64(m.Claimed))
+	}
+	if m.Refunded != 0 {
+		n += 1 + sovGenesis(uint64(m.Refunded))
+	}
+	l = m.IncomingVolume.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.OutgoingVolume.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Fees.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.ClaimTips.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *DailySwapStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Day != 0 {
+		n += 1 + sovGenesis(uint64(m.Day))
+	}
+	l = m.Stats.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *DeputyActivity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DeputyAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.LastActiveHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.LastActiveHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastActiveTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *AssetSuspension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *DeputyBond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DeputyAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeputyUnbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DeputyAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *LightClientState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AtomicSwaps) > 0 {
+		for _, e := range m.AtomicSwaps {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Supplies.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PreviousBlockTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DenyList) > 0 {
+		for _, s := range m.DenyList {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DailyStats) > 0 {
+		for _, e := range m.DailyStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeputyActivity) > 0 {
+		for _, e := range m.DeputyActivity {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SuspendedAssets) > 0 {
+		for _, e := range m.SuspendedAssets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeputyBonds) > 0 {
+		for _, e := range m.DeputyBonds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeputyUnbondings) > 0 {
+		for _, e := range m.DeputyUnbondings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ExportHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.ExportHeight))
+	}
+	if len(m.LightClientStates) > 0 {
+		for _, e := range m.LightClientStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SupplyLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeLimited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimeLimited = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimePeriod", wireType)
+			}
+			m.TimePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimePeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeBasedLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeBasedLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinID", wireType)
+			}
+			m.CoinID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoinID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupplyLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeputyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeputyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FixedFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSwapAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSwapAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapTimestamp", wireType)
+			}
+			m.SwapTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapTimeSpanMin", wireType)
+			}
+			m.SwapTimeSpanMin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapTimeSpanMin |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeputyInactivity", wireType)
+			}
+			m.MaxDeputyInactivity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDeputyInactivity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeputyBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeputyBond = append(m.DeputyBond, types.Coin{})
+			if err := m.DeputyBond[len(m.DeputyBond)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeputyUnbondingPeriod", wireType)
+			}
+			m.DeputyUnbondingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeputyUnbondingPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeputySlashAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeputySlashAmount = append(m.DeputySlashAmount, types.Coin{})
+			if err := m.DeputySlashAmount[len(m.DeputySlashAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashOnRefund", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SlashOnRefund = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyIncoming", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VerifyIncoming = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IBCChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetParams = append(m.AssetParams, AssetParam{})
+			if err := m.AssetParams[len(m.AssetParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LongtermStorageRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LongtermStorageRetention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LongtermStorageRetention) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LongtermStorageRetention: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LongtermStorageRetention: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetSupply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetSupply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncomingSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncomingSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutgoingSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeLimitedCurrentSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeLimitedCurrentSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeElapsed", wireType)
+			}
+			m.TimeElapsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeElapsed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetSupplies) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetSupplies: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetSupplies: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetSupplies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetSupplies = append(m.AssetSupplies, AssetSupply{})
+			if err := m.AssetSupplies[len(m.AssetSupplies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			m.Created = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Created |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			m.Claimed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Claimed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			m.Refunded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Refunded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncomingVolume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncomingVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingVolume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutgoingVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimTips", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimTips.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DailySwapStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DailySwapStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DailySwapStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+			}
+			m.Day = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Day |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeputyActivity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeputyActivity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeputyActivity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeputyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeputyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastActiveHeight", wireType)
+			}
+			m.LastActiveHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastActiveHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastActiveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastActiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetSuspension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetSuspension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetSuspension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.AtomicSwaps) > 0 {
-		for _, e := range m.AtomicSwaps {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Supplies.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PreviousBlockTime)
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *SupplyLimit) Unmarshal(dAtA []byte) error {
+func (m *DeputyBond) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1042,15 +4399,15 @@ func (m *SupplyLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SupplyLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeputyBond: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SupplyLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeputyBond: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeputyAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1078,54 +4435,13 @@ func (m *SupplyLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DeputyAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeLimited", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.TimeLimited = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimePeriod", wireType)
-			}
-			m.TimePeriod = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TimePeriod |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeBasedLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1135,23 +4451,23 @@ func (m *SupplyLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TimeBasedLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1176,7 +4492,7 @@ func (m *SupplyLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetParam) Unmarshal(dAtA []byte) error {
+func (m *DeputyUnbonding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1199,15 +4515,15 @@ func (m *AssetParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeputyUnbonding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeputyUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeputyAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1235,30 +4551,11 @@ func (m *AssetParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.DeputyAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoinID", wireType)
-			}
-			m.CoinID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CoinID |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupplyLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1285,35 +4582,16 @@ func (m *AssetParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SupplyLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Active = bool(v != 0)
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeputyAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1323,61 +4601,78 @@ func (m *AssetParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeputyAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FixedFee", wireType)
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LightClientState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FixedFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LightClientState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LightClientState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1405,13 +4700,11 @@ func (m *AssetParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSwapAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1439,15 +4732,13 @@ func (m *AssetParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxSwapAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.SwapTimestamp = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1457,16 +4748,16 @@ func (m *AssetParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SwapTimestamp |= int64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapTimeSpanMin", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
 			}
-			m.SwapTimeSpanMin = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1476,66 +4767,31 @@ func (m *AssetParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SwapTimeSpanMin |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1545,24 +4801,24 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetParams = append(m.AssetParams, AssetParam{})
-			if err := m.AssetParams[len(m.AssetParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1586,7 +4842,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetSupply) Unmarshal(dAtA []byte) error {
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1609,15 +4865,15 @@ func (m *AssetSupply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetSupply: wiretype end group for non-group")
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncomingSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1644,13 +4900,13 @@ func (m *AssetSupply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IncomingSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AtomicSwaps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1677,13 +4933,14 @@ func (m *AssetSupply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OutgoingSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AtomicSwaps = append(m.AtomicSwaps, AtomicSwap{})
+			if err := m.AtomicSwaps[len(m.AtomicSwaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Supplies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1710,13 +4967,13 @@ func (m *AssetSupply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Supplies.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeLimitedCurrentSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousBlockTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1743,15 +5000,15 @@ func (m *AssetSupply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TimeLimitedCurrentSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PreviousBlockTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeElapsed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenyList", wireType)
 			}
-			m.TimeElapsed = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1761,64 +5018,61 @@ func (m *AssetSupply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeElapsed |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AssetSupplies) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			m.DenyList = append(m.DenyList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Stats = append(m.Stats, SwapStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AssetSupplies: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetSupplies: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetSupplies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1845,64 +5099,48 @@ func (m *AssetSupplies) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetSupplies = append(m.AssetSupplies, AssetSupply{})
-			if err := m.AssetSupplies[len(m.AssetSupplies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DailyStats = append(m.DailyStats, DailySwapStats{})
+			if err := m.DailyStats[len(m.DailyStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeputyActivity", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DeputyActivity = append(m.DeputyActivity, DeputyActivity{})
+			if err := m.DeputyActivity[len(m.DeputyActivity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendedAssets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1929,13 +5167,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SuspendedAssets = append(m.SuspendedAssets, AssetSuspension{})
+			if err := m.SuspendedAssets[len(m.SuspendedAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AtomicSwaps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeputyBonds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1962,14 +5201,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AtomicSwaps = append(m.AtomicSwaps, AtomicSwap{})
-			if err := m.AtomicSwaps[len(m.AtomicSwaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DeputyBonds = append(m.DeputyBonds, DeputyBond{})
+			if err := m.DeputyBonds[len(m.DeputyBonds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supplies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeputyUnbondings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1996,13 +5235,33 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Supplies.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DeputyUnbondings = append(m.DeputyUnbondings, DeputyUnbonding{})
+			if err := m.DeputyUnbondings[len(m.DeputyUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExportHeight", wireType)
+			}
+			m.ExportHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExportHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousBlockTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LightClientStates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2029,7 +5288,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PreviousBlockTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.LightClientStates = append(m.LightClientStates, LightClientState{})
+			if err := m.LightClientStates[len(m.LightClientStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
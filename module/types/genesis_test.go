@@ -1,6 +1,8 @@
 package types_test
 
 import (
+	"encoding/hex"
+	"errors"
 	"testing"
 	"time"
 
@@ -8,10 +10,12 @@ import (
 	"github.com/e-money/bep3/module/types"
 	app "github.com/e-money/bep3/testapp"
 	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/crypto"
 )
 
 type GenesisTestSuite struct {
 	suite.Suite
+	params   types.Params
 	swaps    types.AtomicSwaps
 	supplies types.AssetSupplies
 }
@@ -20,18 +24,44 @@ func (suite *GenesisTestSuite) SetupTest() {
 	config := sdk.GetConfig()
 	app.SetBech32AddressPrefixes(config)
 
-	coin := sdk.NewCoin("kava", sdk.OneInt())
+	deputy := sdk.AccAddress(crypto.AddressHash([]byte("deputy")))
+	asset := types.AssetParam{
+		Denom:           "bnb",
+		CoinID:          714,
+		SupplyLimit:     types.SupplyLimit{Limit: sdk.NewInt(1000000), TimeBasedLimit: sdk.ZeroInt()},
+		Active:          true,
+		DeputyAddress:   deputy.String(),
+		FixedFee:        sdk.NewInt(1000),
+		MinSwapAmount:   sdk.OneInt(),
+		MaxSwapAmount:   sdk.NewInt(1000000),
+		SwapTimeSpanMin: types.DefaultSwapTimeSpanMinutes,
+	}
+	inactive := asset
+	inactive.Denom = "inc"
+	inactive.CoinID = 9999
+	inactive.Active = false
+	suite.params = types.NewParams(types.AssetParams{asset, inactive}, types.DefaultLongtermStorageRetention)
 	suite.swaps = atomicSwaps(10)
 
-	supply := types.NewAssetSupply(coin, coin, coin, coin, 0)
+	coin := sdk.NewCoin("bnb", sdk.ZeroInt())
+	supply := types.NewAssetSupply(coin, coin, c("bnb", 1000), coin, 0)
 	suite.supplies = types.AssetSupplies{AssetSupplies: []types.AssetSupply{supply}}
 }
 
 func (suite *GenesisTestSuite) TestValidate() {
+	deputy := sdk.AccAddress(crypto.AddressHash([]byte("deputy")))
 	type args struct {
 		swaps             types.AtomicSwaps
 		supplies          types.AssetSupplies
 		previousBlockTime time.Time
+		denyList          []string
+		stats             []types.SwapStats
+		dailyStats        []types.DailySwapStats
+		deputyActivity    []types.DeputyActivity
+		suspendedAssets   []types.AssetSuspension
+		deputyBonds       []types.DeputyBond
+		deputyUnbondings  []types.DeputyUnbonding
+		lightClientStates []types.LightClientState
 	}
 	testCases := []struct {
 		name       string
@@ -63,6 +93,72 @@ func (suite *GenesisTestSuite) TestValidate() {
 			},
 			true,
 		},
+		{
+			"swaps with matching supplies",
+			args{
+				swaps: suite.swaps,
+				supplies: types.AssetSupplies{AssetSupplies: []types.AssetSupply{
+					types.NewAssetSupply(c("bnb", 500000), c("bnb", 0), c("bnb", 0), c("bnb", 0), 0),
+				}},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+			},
+			true,
+		},
+		{
+			"incoming supply does not match incoming swaps",
+			args{
+				swaps:             suite.swaps,
+				supplies:          suite.supplies,
+				previousBlockTime: types.DefaultPreviousBlockTime,
+			},
+			false,
+		},
+		{
+			"outgoing supply does not match outgoing swaps",
+			args{
+				supplies: types.AssetSupplies{AssetSupplies: []types.AssetSupply{
+					types.NewAssetSupply(c("bnb", 0), c("bnb", 1), c("bnb", 1), c("bnb", 0), 0),
+				}},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+			},
+			false,
+		},
+		{
+			"current supply over the supply limit",
+			args{
+				supplies: types.AssetSupplies{AssetSupplies: []types.AssetSupply{
+					types.NewAssetSupply(c("bnb", 0), c("bnb", 0), c("bnb", 1000001), c("bnb", 0), 0),
+				}},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+			},
+			false,
+		},
+		{
+			"supply of an unsupported asset",
+			args{
+				supplies: types.AssetSupplies{AssetSupplies: []types.AssetSupply{
+					types.NewAssetSupply(c("xrp", 0), c("xrp", 0), c("xrp", 0), c("xrp", 0), 0),
+				}},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+			},
+			false,
+		},
+		{
+			"swap of an unsupported asset",
+			args{
+				swaps:             types.AtomicSwaps{withAmount(suite.swaps[0], cs(c("xrp", 50000)))},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+			},
+			false,
+		},
+		{
+			"swap of an inactive asset",
+			args{
+				swaps:             types.AtomicSwaps{withAmount(suite.swaps[0], cs(c("inc", 50000)))},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+			},
+			false,
+		},
 		{
 			"invalid supply",
 			args{
@@ -96,6 +192,225 @@ func (suite *GenesisTestSuite) TestValidate() {
 			},
 			false,
 		},
+		{
+			"with deny list",
+			args{
+				swaps:             types.AtomicSwaps{},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+				denyList:          []string{"bnb1uky3me9ggqypmrsvxk7ur6hqkzq7zmv4ed4ng7", "0x4d3a0d1f6e9c2b2e0c1b7a4a2b7cd7f7f3e0c9a1"},
+			},
+			true,
+		},
+		{
+			"duplicate deny list address",
+			args{
+				swaps:             types.AtomicSwaps{},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+				denyList:          []string{"bnb1uky3me9ggqypmrsvxk7ur6hqkzq7zmv4ed4ng7", "BNB1UKY3ME9GGQYPMRSVXK7UR6HQKZQ7ZMV4ED4NG7"},
+			},
+			false,
+		},
+		{
+			"blank deny list address",
+			args{
+				swaps:             types.AtomicSwaps{},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+				denyList:          []string{" "},
+			},
+			false,
+		},
+		{
+			"with stats",
+			args{
+				swaps:             types.AtomicSwaps{},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+				stats:             []types.SwapStats{types.NewSwapStats("bnb"), types.NewSwapStats("inc")},
+				dailyStats: []types.DailySwapStats{
+					{Day: 0, Stats: types.NewSwapStats("bnb")},
+					{Day: types.SecondsPerDay, Stats: types.NewSwapStats("bnb")},
+				},
+			},
+			true,
+		},
+		{
+			"duplicate stats denom",
+			args{
+				swaps:             types.AtomicSwaps{},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+				stats:             []types.SwapStats{types.NewSwapStats("bnb"), types.NewSwapStats("bnb")},
+			},
+			false,
+		},
+		{
+			"stats with mismatched volume denom",
+			args{
+				swaps:             types.AtomicSwaps{},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+				stats: []types.SwapStats{func() types.SwapStats {
+					stats := types.NewSwapStats("bnb")
+					stats.IncomingVolume = sdk.NewCoin("inc", sdk.OneInt())
+					return stats
+				}()},
+			},
+			false,
+		},
+		{
+			"duplicate daily stats day",
+			args{
+				swaps:             types.AtomicSwaps{},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+				dailyStats: []types.DailySwapStats{
+					{Day: 0, Stats: types.NewSwapStats("bnb")},
+					{Day: 0, Stats: types.NewSwapStats("bnb")},
+				},
+			},
+			false,
+		},
+		{
+			"daily stats not at start of day",
+			args{
+				swaps:             types.AtomicSwaps{},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+				dailyStats:        []types.DailySwapStats{{Day: 60, Stats: types.NewSwapStats("bnb")}},
+			},
+			false,
+		},
+		{
+			"deputy activity and suspended assets",
+			args{
+				swaps:             types.AtomicSwaps{},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+				deputyActivity:    []types.DeputyActivity{types.NewDeputyActivity(deputy.String(), 10, time.Unix(100, 0))},
+				suspendedAssets:   []types.AssetSuspension{types.NewAssetSuspension("bnb", 20)},
+			},
+			true,
+		},
+		{
+			"invalid deputy activity address",
+			args{
+				swaps:             types.AtomicSwaps{},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+				deputyActivity:    []types.DeputyActivity{types.NewDeputyActivity("invalid", 10, time.Unix(100, 0))},
+			},
+			false,
+		},
+		{
+			"duplicate deputy activity",
+			args{
+				swaps:             types.AtomicSwaps{},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+				deputyActivity: []types.DeputyActivity{
+					types.NewDeputyActivity(deputy.String(), 10, time.Unix(100, 0)),
+					types.NewDeputyActivity(deputy.String(), 11, time.Unix(101, 0)),
+				},
+			},
+			false,
+		},
+		{
+			"duplicate suspended asset",
+			args{
+				swaps:             types.AtomicSwaps{},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+				suspendedAssets: []types.AssetSuspension{
+					types.NewAssetSuspension("bnb", 20),
+					types.NewAssetSuspension("bnb", 21),
+				},
+			},
+			false,
+		},
+		{
+			"deputy bonds and unbondings",
+			args{
+				swaps:             types.AtomicSwaps{},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+				deputyBonds:       []types.DeputyBond{types.NewDeputyBond(deputy.String(), sdk.NewCoins(sdk.NewInt64Coin("ungm", 100)))},
+				deputyUnbondings: []types.DeputyUnbonding{
+					types.NewDeputyUnbonding(deputy.String(), sdk.NewCoins(sdk.NewInt64Coin("ungm", 50)), time.Unix(100, 0)),
+					types.NewDeputyUnbonding(deputy.String(), sdk.NewCoins(sdk.NewInt64Coin("ungm", 50)), time.Unix(200, 0)),
+				},
+			},
+			true,
+		},
+		{
+			"duplicate deputy bond",
+			args{
+				swaps:             types.AtomicSwaps{},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+				deputyBonds: []types.DeputyBond{
+					types.NewDeputyBond(deputy.String(), sdk.NewCoins(sdk.NewInt64Coin("ungm", 100))),
+					types.NewDeputyBond(deputy.String(), sdk.NewCoins(sdk.NewInt64Coin("ungm", 200))),
+				},
+			},
+			false,
+		},
+		{
+			"duplicate deputy unbonding",
+			args{
+				swaps:             types.AtomicSwaps{},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+				deputyUnbondings: []types.DeputyUnbonding{
+					types.NewDeputyUnbonding(deputy.String(), sdk.NewCoins(sdk.NewInt64Coin("ungm", 50)), time.Unix(100, 0)),
+					types.NewDeputyUnbonding(deputy.String(), sdk.NewCoins(sdk.NewInt64Coin("ungm", 60)), time.Unix(100, 0)),
+				},
+			},
+			false,
+		},
+		{
+			"invalid deputy unbonding amount",
+			args{
+				swaps:             types.AtomicSwaps{},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+				deputyUnbondings: []types.DeputyUnbonding{
+					types.NewDeputyUnbonding(deputy.String(), sdk.Coins{sdk.Coin{Denom: "ungm", Amount: sdk.NewInt(-1)}}, time.Unix(100, 0)),
+				},
+			},
+			false,
+		},
+		{
+			"light client state",
+			args{
+				swaps:             types.AtomicSwaps{},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+				lightClientStates: []types.LightClientState{
+					types.NewLightClientState("bnb", "Binance-Chain-Tigris", 100, []byte{1}, nil),
+				},
+			},
+			true,
+		},
+		{
+			"duplicate light client state",
+			args{
+				swaps:             types.AtomicSwaps{},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+				lightClientStates: []types.LightClientState{
+					types.NewLightClientState("bnb", "Binance-Chain-Tigris", 100, []byte{1}, nil),
+					types.NewLightClientState("bnb", "Binance-Chain-Tigris", 200, []byte{2}, nil),
+				},
+			},
+			false,
+		},
+		{
+			"light client state without chain ID",
+			args{
+				swaps:             types.AtomicSwaps{},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+				lightClientStates: []types.LightClientState{
+					types.NewLightClientState("bnb", "", 100, []byte{1}, nil),
+				},
+			},
+			false,
+		},
+		{
+			"light client state of unsupported asset",
+			args{
+				swaps:             types.AtomicSwaps{},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+				lightClientStates: []types.LightClientState{
+					types.NewLightClientState("xrp", "ripple", 100, []byte{1}, nil),
+				},
+			},
+			false,
+		},
 		{
 			"blocktime not set",
 			args{
@@ -111,7 +426,8 @@ func (suite *GenesisTestSuite) TestValidate() {
 			if tc.name == "default" {
 				gs = types.DefaultGenesisState()
 			} else {
-				gs = types.NewGenesisState(types.DefaultParams(), tc.args.swaps, tc.args.supplies, tc.args.previousBlockTime)
+				gs = types.NewGenesisState(suite.params, tc.args.swaps, tc.args.supplies, tc.args.previousBlockTime, tc.args.denyList, tc.args.stats, tc.args.dailyStats,
+					tc.args.deputyActivity, tc.args.suspendedAssets, tc.args.deputyBonds, tc.args.deputyUnbondings, tc.args.lightClientStates)
 			}
 
 			err := gs.Validate()
@@ -124,6 +440,95 @@ func (suite *GenesisTestSuite) TestValidate() {
 	}
 }
 
+func (suite *GenesisTestSuite) TestRebase() {
+	deputy := sdk.AccAddress(crypto.AddressHash([]byte("deputy")))
+	exportTime := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	genesisTime := exportTime.Add(48 * time.Hour)
+
+	open := suite.swaps[0]
+	closed := suite.swaps[1]
+	closed.Status = types.Completed
+	closed.ClosedBlock = 990
+	closed.ClosedTime = exportTime.Add(-time.Hour).Unix()
+
+	supplies := types.AssetSupplies{AssetSupplies: []types.AssetSupply{
+		types.NewAssetSupply(c("bnb", 50000), c("bnb", 0), c("bnb", 0), c("bnb", 0), 0),
+	}}
+	gs := types.NewGenesisState(suite.params, types.AtomicSwaps{open, closed}, supplies, exportTime,
+		nil, nil, nil,
+		[]types.DeputyActivity{types.NewDeputyActivity(deputy.String(), 995, exportTime.Add(-time.Minute))},
+		[]types.AssetSuspension{types.NewAssetSuspension("bnb", 10)},
+		nil,
+		[]types.DeputyUnbonding{types.NewDeputyUnbonding(deputy.String(), cs(c("ungm", 1)), exportTime.Add(time.Hour))},
+		nil)
+	gs.ExportHeight = 1000
+
+	rebased := gs.Rebase(0, genesisTime)
+	suite.Require().NoError(rebased.Validate())
+	suite.Equal(int64(0), rebased.ExportHeight)
+	suite.Equal(genesisTime, rebased.PreviousBlockTime)
+
+	// Heights are moved by the blocks between the export and genesis heights, times by the downtime
+	suite.Equal(open.ExpireTimestamp+48*60*60, rebased.AtomicSwaps[0].ExpireTimestamp)
+	suite.Equal(open.Timestamp, rebased.AtomicSwaps[0].Timestamp)
+	suite.Equal(int64(0), rebased.AtomicSwaps[1].ClosedBlock)
+	suite.Equal(genesisTime.Add(-time.Hour).Unix(), rebased.AtomicSwaps[1].ClosedTime)
+	suite.Equal(int64(0), rebased.DeputyActivity[0].LastActiveHeight)
+	suite.Equal(genesisTime.Add(-time.Minute), rebased.DeputyActivity[0].LastActiveTime)
+	suite.Equal(int64(0), rebased.SuspendedAssets[0].Height)
+	suite.Equal(genesisTime.Add(time.Hour), rebased.DeputyUnbondings[0].CompletionTime)
+
+	// The exported state is left untouched
+	suite.Equal(int64(990), gs.AtomicSwaps[1].ClosedBlock)
+	suite.Equal(int64(995), gs.DeputyActivity[0].LastActiveHeight)
+
+	rebased = gs.Rebase(2000, genesisTime)
+	suite.Equal(int64(1990), rebased.AtomicSwaps[1].ClosedBlock)
+	suite.Equal(int64(1995), rebased.DeputyActivity[0].LastActiveHeight)
+	suite.Equal(int64(1010), rebased.SuspendedAssets[0].Height)
+
+	// Genesis states with negative heights are invalid
+	closed.ClosedBlock = -10
+	negative := gs.Rebase(2000, genesisTime)
+	negative.AtomicSwaps = types.AtomicSwaps{negative.AtomicSwaps[0], closed}
+	suite.Require().Error(negative.Validate())
+	suite.Contains(negative.Validate().Error(), "closed block cannot be negative")
+}
+
+func (suite *GenesisTestSuite) TestValidateAll() {
+	unsupported := withAmount(suite.swaps[0], cs(c("xrp", 50000)))
+	invalid := suite.swaps[1]
+	invalid.Direction = types.INVALID
+	gs := types.NewGenesisState(suite.params, types.AtomicSwaps{unsupported, invalid, suite.swaps[2]}, suite.supplies,
+		types.DefaultPreviousBlockTime, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	errs := gs.ValidateAll()
+	suite.Require().Len(errs, 3)
+	suite.Contains(errs[0].Error(), hex.EncodeToString(unsupported.GetSwapID()))
+	suite.True(errors.Is(errs[0], types.ErrAssetNotSupported))
+	suite.Contains(errs[1].Error(), hex.EncodeToString(invalid.GetSwapID()))
+	suite.Contains(errs[2].Error(), "incoming supply")
+	suite.Equal(errs[0].Error(), gs.Validate().Error())
+}
+
+func (suite *GenesisTestSuite) TestValidateVerifiedAsset() {
+	gs := types.DefaultGenesisState()
+	gs.Params = suite.params
+	gs.Params.AssetParams[0].VerifyIncoming = true
+	suite.Require().True(errors.Is(gs.Validate(), types.ErrLightClientNotFound))
+
+	gs.LightClientStates = []types.LightClientState{
+		types.NewLightClientState("bnb", "Binance-Chain-Tigris", 100, []byte{1}, nil),
+	}
+	suite.Require().NoError(gs.Validate())
+}
+
+// withAmount returns a copy of a swap with a different amount
+func withAmount(swap types.AtomicSwap, amount sdk.Coins) types.AtomicSwap {
+	swap.Amount = amount
+	return swap
+}
+
 func TestGenesisTestSuite(t *testing.T) {
 	suite.Run(t, new(GenesisTestSuite))
 }
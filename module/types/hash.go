@@ -1,14 +1,24 @@
 package types
 
 import (
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/binary"
+	"fmt"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/go-bip39"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 )
 
+// MinSecretSeedLength is the minimum length of a seed that swap random numbers are derived from
+const MinSecretSeedLength = 16
+
+// secretDerivationDomain separates the random numbers derived from a seed from other uses of the seed
+var secretDerivationDomain = []byte("bep3 swap random number")
+
 // GenerateSecureRandomNumber generates cryptographically strong pseudo-random number
 func GenerateSecureRandomNumber() ([]byte, error) {
 	bytes := make([]byte, 32)
@@ -34,3 +44,45 @@ func CalculateSwapID(randomNumberHash []byte, sender sdk.AccAddress, senderOther
 	data = append(data, []byte(senderOtherChain)...)
 	return tmhash.Sum(data)
 }
+
+// localSwapIDMarker separates the IDs of local swaps from those of cross-chain swaps with the same random number
+// hash and sender. Other-chain addresses are lower-cased before hashing, so the marker never matches one.
+const localSwapIDMarker = "LOCAL"
+
+// CalculateLocalSwapID calculates the ID of a local swap from its RandomNumberHash and sender
+func CalculateLocalSwapID(randomNumberHash []byte, sender sdk.AccAddress) []byte {
+	data := append([]byte{}, randomNumberHash...)
+	data = append(data, sender.Bytes()...)
+	data = append(data, []byte(localSwapIDMarker)...)
+	return tmhash.Sum(data)
+}
+
+// SecretSeedFromMnemonic returns the BIP-39 seed of a mnemonic and optional passphrase, from which the random numbers
+// of swaps can be derived with DeriveRandomNumber.
+func SecretSeedFromMnemonic(mnemonic, passphrase string) ([]byte, error) {
+	return bip39.NewSeedWithErrorChecking(mnemonic, passphrase)
+}
+
+// DeriveRandomNumber deterministically derives the random number of a swap from a seed, the index of the swap and
+// its recipients, so that the secrets of all swaps created from a backed up seed can be regenerated. The number is
+// the HMAC-SHA256 keyed with the seed over the index and the recipient addresses of the swap on both chains. The
+// address on the other chain is normalized like deny list addresses, so only Bech32 addresses are not case sensitive.
+func DeriveRandomNumber(seed []byte, index uint64, recipient, recipientOtherChain string) ([]byte, error) {
+	if len(seed) < MinSecretSeedLength {
+		return nil, fmt.Errorf("seed must be at least %d bytes long, got %d", MinSecretSeedLength, len(seed))
+	}
+
+	mac := hmac.New(sha256.New, seed)
+	mac.Write(secretDerivationDomain)
+	var indexBz [Int64Size]byte
+	binary.BigEndian.PutUint64(indexBz[:], index)
+	mac.Write(indexBz[:])
+	// Length prefixes keep the encoding of different recipient pairs distinct
+	for _, address := range []string{recipient, NormalizeDenyListAddress(recipientOtherChain)} {
+		var lengthBz [Int64Size]byte
+		binary.BigEndian.PutUint64(lengthBz[:], uint64(len(address)))
+		mac.Write(lengthBz[:])
+		mac.Write([]byte(address))
+	}
+	return mac.Sum(nil), nil
+}
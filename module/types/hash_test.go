@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -55,6 +56,74 @@ func (suite *HashTestSuite) TestCalculateSwapID() {
 	suite.NotEqual(swapID, diffSwapID)
 }
 
+func (suite *HashTestSuite) TestCalculateLocalSwapID() {
+	randomNumber, _ := types.GenerateSecureRandomNumber()
+	hash := types.CalculateRandomHash(randomNumber[:], suite.timestamps[3])
+	swapID := types.CalculateLocalSwapID(hash, suite.addrs[3])
+	suite.Equal(32, len(swapID))
+
+	// Local swaps do not collide with interchain swaps, which have no other-chain sender, or with any cross-chain swap
+	suite.NotEqual(swapID, types.CalculateSwapID(hash, suite.addrs[3], ""))
+	suite.NotEqual(swapID, types.CalculateSwapID(hash, suite.addrs[3], "local"))
+	suite.NotEqual(swapID, types.CalculateSwapID(hash, suite.addrs[3], "LOCAL"))
+	suite.NotEqual(swapID, types.CalculateLocalSwapID(hash, suite.addrs[4]))
+}
+
+func (suite *HashTestSuite) TestDeriveRandomNumber() {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	seed, err := types.SecretSeedFromMnemonic(mnemonic, "")
+	suite.Require().NoError(err)
+
+	otherChain, err := sdk.Bech32ifyAddressBytes("bnb", suite.addrs[1])
+	suite.Require().NoError(err)
+	randomNumber, err := types.DeriveRandomNumber(seed, 7, suite.addrs[0].String(), otherChain)
+	suite.Require().NoError(err)
+	suite.Equal(types.RandomNumberLength, len(randomNumber))
+	suite.Equal(32, len(types.CalculateRandomHash(randomNumber, suite.timestamps[0])))
+
+	// The same seed, index and recipients always give the same number, whatever the case of a Bech32 address
+	again, err := types.DeriveRandomNumber(seed, 7, suite.addrs[0].String(), strings.ToUpper(otherChain))
+	suite.Require().NoError(err)
+	suite.Equal(randomNumber, again)
+
+	// Other addresses are case sensitive
+	hexNumber, err := types.DeriveRandomNumber(seed, 7, suite.addrs[0].String(), "0x9fB29AAc15b9A4B7F17c3385939b007540f4d791")
+	suite.Require().NoError(err)
+	lowerHexNumber, err := types.DeriveRandomNumber(seed, 7, suite.addrs[0].String(), "0x9fb29aac15b9a4b7f17c3385939b007540f4d791")
+	suite.Require().NoError(err)
+	suite.NotEqual(hexNumber, lowerHexNumber)
+
+	// Any other input gives a different number
+	others := [][]byte{}
+	for _, args := range []struct {
+		index               uint64
+		recipient           string
+		recipientOtherChain string
+	}{
+		{8, suite.addrs[0].String(), otherChain},
+		{7, suite.addrs[1].String(), otherChain},
+		{7, suite.addrs[0].String(), "bnb1other"},
+		{7, suite.addrs[0].String() + otherChain[:4], otherChain[4:]},
+	} {
+		other, err := types.DeriveRandomNumber(seed, args.index, args.recipient, args.recipientOtherChain)
+		suite.Require().NoError(err)
+		others = append(others, other)
+	}
+	otherSeed, err := types.SecretSeedFromMnemonic(mnemonic, "passphrase")
+	suite.Require().NoError(err)
+	other, err := types.DeriveRandomNumber(otherSeed, 7, suite.addrs[0].String(), otherChain)
+	suite.Require().NoError(err)
+	others = append(others, other)
+	for _, other := range others {
+		suite.NotEqual(randomNumber, other)
+	}
+
+	_, err = types.SecretSeedFromMnemonic("abandon abandon abandon", "")
+	suite.Error(err)
+	_, err = types.DeriveRandomNumber(seed[:types.MinSecretSeedLength-1], 7, suite.addrs[0].String(), "bnb1recipient")
+	suite.Error(err)
+}
+
 func TestHashTestSuite(t *testing.T) {
 	suite.Run(t, new(HashTestSuite))
 }
@@ -0,0 +1,144 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+	"github.com/pkg/errors"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+const (
+	// PortID is the port the bep3 module binds to for interchain swaps
+	PortID = ModuleName
+
+	// Version is the version of the interchain swap channels
+	Version = "bep3-1"
+
+	CreateInterchainSwap = "createInterchainSwap"
+)
+
+var _ sdk.Msg = &MsgCreateInterchainSwap{}
+
+// NewMsgCreateInterchainSwap initializes a new MsgCreateInterchainSwap
+func NewMsgCreateInterchainSwap(from, to string, randomNumberHash tmbytes.HexBytes, timestamp int64, amount sdk.Coins,
+	timeSpanMin int64, sourcePort, sourceChannel string) *MsgCreateInterchainSwap {
+	return &MsgCreateInterchainSwap{
+		From:             from,
+		To:               to,
+		RandomNumberHash: randomNumberHash,
+		Timestamp:        timestamp,
+		Amount:           amount,
+		TimeSpanMin:      timeSpanMin,
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+	}
+}
+
+// Route establishes the route for the MsgCreateInterchainSwap
+func (msg MsgCreateInterchainSwap) Route() string { return RouterKey }
+
+// Type is the name of MsgCreateInterchainSwap
+func (msg MsgCreateInterchainSwap) Type() string { return CreateInterchainSwap }
+
+// String prints the MsgCreateInterchainSwap
+func (msg MsgCreateInterchainSwap) String() string {
+	return fmt.Sprintf("interchainSwap{%v#%v#%v#%v#%v#%v#%v/%v#%v#%v}",
+		msg.From, msg.To, msg.RandomNumberHash, msg.Timestamp, msg.Amount, msg.TimeSpanMin,
+		msg.SourcePort, msg.SourceChannel, msg.TimeoutHeight, msg.TimeoutTimestamp)
+}
+
+// GetSigners gets the signers of a MsgCreateInterchainSwap
+func (msg MsgCreateInterchainSwap) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic validates the MsgCreateInterchainSwap
+func (msg MsgCreateInterchainSwap) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
+		return sdkerrors.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
+		return sdkerrors.Wrap(err, "invalid source channel ID")
+	}
+	if msg.TimeoutHeight.IsZero() && msg.TimeoutTimestamp == 0 {
+		return sdkerrors.Wrap(ErrInvalidInterchainSwap, "packet timeout height and timestamp cannot both be 0")
+	}
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "expected Bech32 interchain swap sender %s, error:%s", msg.From, err)
+	}
+	if len(from.Bytes()) != AddrByteCount {
+		return fmt.Errorf("the expected address length is %d, actual length is %d", AddrByteCount, len(from.Bytes()))
+	}
+	return InterchainSwapPacketData{
+		Sender:           msg.From,
+		Recipient:        msg.To,
+		RandomNumberHash: msg.RandomNumberHash,
+		Timestamp:        msg.Timestamp,
+		Amount:           msg.Amount,
+		TimeSpanMin:      msg.TimeSpanMin,
+	}.ValidateBasic()
+}
+
+// GetSignBytes gets the sign bytes of a MsgCreateInterchainSwap
+func (msg MsgCreateInterchainSwap) GetSignBytes() []byte {
+	bz := ModuleCdc.LegacyAmino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the InterchainSwapPacketData. The sender and recipient are addresses of different chains, so
+// both are only checked to be present. The sending chain checks the sender when the swap is created, and the receiving
+// chain checks the recipient once the packet is received.
+func (data InterchainSwapPacketData) ValidateBasic() error {
+	if strings.TrimSpace(data.Sender) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address on other chain")
+	}
+	if len(data.Sender) > MaxOtherChainAddrLength {
+		return fmt.Errorf("the length of sender address on other chain should be less than %d", MaxOtherChainAddrLength)
+	}
+	if strings.TrimSpace(data.Recipient) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address on other chain")
+	}
+	if len(data.Recipient) > MaxOtherChainAddrLength {
+		return fmt.Errorf("the length of recipient address on other chain should be less than %d", MaxOtherChainAddrLength)
+	}
+	if len(data.RandomNumberHash) != RandomNumberHashLength {
+		return fmt.Errorf("the length of random number hash should be %d", RandomNumberHashLength)
+	}
+	if data.Timestamp <= 0 {
+		return errors.New("timestamp must be positive")
+	}
+	if len(data.Amount) != 1 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount must contain exactly one coin")
+	}
+	if !data.Amount.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, data.Amount.String())
+	}
+	if data.TimeSpanMin < 1 || data.TimeSpanMin > ThreeDayMinutes {
+		return sdkerrors.Wrapf(ErrInvalidTimeSpan, "minutes span %d outside range [%d, %d]", data.TimeSpanMin, 1, ThreeDayMinutes)
+	}
+	return nil
+}
+
+// GetBytes returns the JSON encoding of the InterchainSwapPacketData sent in packets
+func (data InterchainSwapPacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&data))
+}
+
+// SwapID returns the ID of the outgoing swap that the packet data mirrors. It is only defined on the sending chain,
+// where the sender is a Bech32 address.
+func (data InterchainSwapPacketData) SwapID() ([]byte, error) {
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return nil, err
+	}
+	return CalculateSwapID(data.RandomNumberHash, sender, ""), nil
+}
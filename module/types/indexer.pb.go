@@ -0,0 +1,2844 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: bep3/indexer.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
+	github_com_tendermint_tendermint_libs_bytes "github.com/tendermint/tendermint/libs/bytes"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// IndexedSwap is the indexer's record of an atomic swap over its lifetime.
+type IndexedSwap struct {
+	SwapID              github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=swap_id,json=swapId,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"swap_id,omitempty" yaml:"swap_id"`
+	RandomNumberHash    github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=random_number_hash,json=randomNumberHash,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"random_number_hash,omitempty" yaml:"random_number_hash"`
+	Amount              github_com_cosmos_cosmos_sdk_types.Coins             `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+	ClaimTip            github_com_cosmos_cosmos_sdk_types.Coins             `protobuf:"bytes,4,rep,name=claim_tip,json=claimTip,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claim_tip" yaml:"claim_tip"`
+	Sender              string                                               `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Recipient           string                                               `protobuf:"bytes,6,opt,name=recipient,proto3" json:"recipient,omitempty" yaml:"recipient"`
+	SenderOtherChain    string                                               `protobuf:"bytes,7,opt,name=sender_other_chain,json=senderOtherChain,proto3" json:"sender_other_chain,omitempty" yaml:"sender_other_chain"`
+	RecipientOtherChain string                                               `protobuf:"bytes,8,opt,name=recipient_other_chain,json=recipientOtherChain,proto3" json:"recipient_other_chain,omitempty" yaml:"recipient_other_chain"`
+	Timestamp           int64                                                `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty" yaml:"timestamp"`
+	ExpireTimestamp     int64                                                `protobuf:"varint,10,opt,name=expire_timestamp,json=expireTimestamp,proto3" json:"expire_timestamp,omitempty" yaml:"expire_timestamp"`
+	Direction           SwapDirection                                        `protobuf:"varint,11,opt,name=direction,proto3,casttype=SwapDirection" json:"direction,omitempty" yaml:"direction"`
+	Status              SwapStatus                                           `protobuf:"varint,12,opt,name=status,proto3,casttype=SwapStatus" json:"status,omitempty" yaml:"status"`
+	CreatedHeight       int64                                                `protobuf:"varint,13,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty" yaml:"created_height"`
+	CreatedTime         time.Time                                            `protobuf:"bytes,14,opt,name=created_time,json=createdTime,proto3,stdtime" json:"created_time" yaml:"created_time"`
+	CreatedTxHash       string                                               `protobuf:"bytes,15,opt,name=created_tx_hash,json=createdTxHash,proto3" json:"created_tx_hash,omitempty" yaml:"created_tx_hash"`
+	ExpiredHeight       int64                                                `protobuf:"varint,16,opt,name=expired_height,json=expiredHeight,proto3" json:"expired_height,omitempty" yaml:"expired_height"`
+	ClosedHeight        int64                                                `protobuf:"varint,17,opt,name=closed_height,json=closedHeight,proto3" json:"closed_height,omitempty" yaml:"closed_height"`
+	ClosedTime          time.Time                                            `protobuf:"bytes,18,opt,name=closed_time,json=closedTime,proto3,stdtime" json:"closed_time" yaml:"closed_time"`
+	ClosedTxHash        string                                               `protobuf:"bytes,19,opt,name=closed_tx_hash,json=closedTxHash,proto3" json:"closed_tx_hash,omitempty" yaml:"closed_tx_hash"`
+	ClaimSender         string                                               `protobuf:"bytes,20,opt,name=claim_sender,json=claimSender,proto3" json:"claim_sender,omitempty" yaml:"claim_sender"`
+	RandomNumber        github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,21,opt,name=random_number,json=randomNumber,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"random_number,omitempty" yaml:"random_number"`
+	RefundSender        string                                               `protobuf:"bytes,22,opt,name=refund_sender,json=refundSender,proto3" json:"refund_sender,omitempty" yaml:"refund_sender"`
+	Memo                string                                               `protobuf:"bytes,23,opt,name=memo,proto3" json:"memo,omitempty" yaml:"memo"`
+	OtherChainTxHash    string                                               `protobuf:"bytes,24,opt,name=other_chain_tx_hash,json=otherChainTxHash,proto3" json:"other_chain_tx_hash,omitempty" yaml:"other_chain_tx_hash"`
+	// the part of the amount not claimed yet, for swaps with partial claims
+	RemainingAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,25,rep,name=remaining_amount,json=remainingAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining_amount" yaml:"remaining_amount"`
+}
+
+func (m *IndexedSwap) Reset()         { *m = IndexedSwap{} }
+func (m *IndexedSwap) String() string { return proto.CompactTextString(m) }
+func (*IndexedSwap) ProtoMessage()    {}
+func (*IndexedSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d025ff8cf2d3d93, []int{0}
+}
+func (m *IndexedSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexedSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexedSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexedSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedSwap.Merge(m, src)
+}
+func (m *IndexedSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexedSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedSwap proto.InternalMessageInfo
+
+func (m *IndexedSwap) GetSwapID() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.SwapID
+	}
+	return nil
+}
+
+func (m *IndexedSwap) GetRandomNumberHash() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.RandomNumberHash
+	}
+	return nil
+}
+
+func (m *IndexedSwap) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *IndexedSwap) GetClaimTip() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClaimTip
+	}
+	return nil
+}
+
+func (m *IndexedSwap) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *IndexedSwap) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *IndexedSwap) GetSenderOtherChain() string {
+	if m != nil {
+		return m.SenderOtherChain
+	}
+	return ""
+}
+
+func (m *IndexedSwap) GetRecipientOtherChain() string {
+	if m != nil {
+		return m.RecipientOtherChain
+	}
+	return ""
+}
+
+func (m *IndexedSwap) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *IndexedSwap) GetExpireTimestamp() int64 {
+	if m != nil {
+		return m.ExpireTimestamp
+	}
+	return 0
+}
+
+func (m *IndexedSwap) GetDirection() SwapDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+func (m *IndexedSwap) GetStatus() SwapStatus {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *IndexedSwap) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *IndexedSwap) GetCreatedTime() time.Time {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return time.Time{}
+}
+
+func (m *IndexedSwap) GetCreatedTxHash() string {
+	if m != nil {
+		return m.CreatedTxHash
+	}
+	return ""
+}
+
+func (m *IndexedSwap) GetExpiredHeight() int64 {
+	if m != nil {
+		return m.ExpiredHeight
+	}
+	return 0
+}
+
+func (m *IndexedSwap) GetClosedHeight() int64 {
+	if m != nil {
+		return m.ClosedHeight
+	}
+	return 0
+}
+
+func (m *IndexedSwap) GetClosedTime() time.Time {
+	if m != nil {
+		return m.ClosedTime
+	}
+	return time.Time{}
+}
+
+func (m *IndexedSwap) GetClosedTxHash() string {
+	if m != nil {
+		return m.ClosedTxHash
+	}
+	return ""
+}
+
+func (m *IndexedSwap) GetClaimSender() string {
+	if m != nil {
+		return m.ClaimSender
+	}
+	return ""
+}
+
+func (m *IndexedSwap) GetRandomNumber() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.RandomNumber
+	}
+	return nil
+}
+
+func (m *IndexedSwap) GetRefundSender() string {
+	if m != nil {
+		return m.RefundSender
+	}
+	return ""
+}
+
+func (m *IndexedSwap) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *IndexedSwap) GetOtherChainTxHash() string {
+	if m != nil {
+		return m.OtherChainTxHash
+	}
+	return ""
+}
+
+func (m *IndexedSwap) GetRemainingAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RemainingAmount
+	}
+	return nil
+}
+
+type QuerySwapHistoryRequest struct {
+	SwapID string `protobuf:"bytes,1,opt,name=swap_id,json=swapId,proto3" json:"swap_id,omitempty" yaml:"swap_id"`
+}
+
+func (m *QuerySwapHistoryRequest) Reset()         { *m = QuerySwapHistoryRequest{} }
+func (m *QuerySwapHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapHistoryRequest) ProtoMessage()    {}
+func (*QuerySwapHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d025ff8cf2d3d93, []int{1}
+}
+func (m *QuerySwapHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapHistoryRequest.Merge(m, src)
+}
+func (m *QuerySwapHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapHistoryRequest proto.InternalMessageInfo
+
+func (m *QuerySwapHistoryRequest) GetSwapID() string {
+	if m != nil {
+		return m.SwapID
+	}
+	return ""
+}
+
+type QuerySwapHistoryResponse struct {
+	Swap IndexedSwap `protobuf:"bytes,1,opt,name=swap,proto3" json:"swap" yaml:"swap"`
+}
+
+func (m *QuerySwapHistoryResponse) Reset()         { *m = QuerySwapHistoryResponse{} }
+func (m *QuerySwapHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapHistoryResponse) ProtoMessage()    {}
+func (*QuerySwapHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d025ff8cf2d3d93, []int{2}
+}
+func (m *QuerySwapHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapHistoryResponse.Merge(m, src)
+}
+func (m *QuerySwapHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapHistoryResponse proto.InternalMessageInfo
+
+func (m *QuerySwapHistoryResponse) GetSwap() IndexedSwap {
+	if m != nil {
+		return m.Swap
+	}
+	return IndexedSwap{}
+}
+
+// QuerySwapsHistoryRequest lists indexed swaps in the order they were created.
+// An empty address or status matches all swaps.
+type QuerySwapsHistoryRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Status     SwapStatus         `protobuf:"varint,2,opt,name=status,proto3,casttype=SwapStatus" json:"status,omitempty" yaml:"status"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySwapsHistoryRequest) Reset()         { *m = QuerySwapsHistoryRequest{} }
+func (m *QuerySwapsHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapsHistoryRequest) ProtoMessage()    {}
+func (*QuerySwapsHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d025ff8cf2d3d93, []int{3}
+}
+func (m *QuerySwapsHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapsHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapsHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapsHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapsHistoryRequest.Merge(m, src)
+}
+func (m *QuerySwapsHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapsHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapsHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapsHistoryRequest proto.InternalMessageInfo
+
+func (m *QuerySwapsHistoryRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QuerySwapsHistoryRequest) GetStatus() SwapStatus {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *QuerySwapsHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QuerySwapsHistoryResponse struct {
+	Swaps      []IndexedSwap       `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps" yaml:"swaps"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySwapsHistoryResponse) Reset()         { *m = QuerySwapsHistoryResponse{} }
+func (m *QuerySwapsHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapsHistoryResponse) ProtoMessage()    {}
+func (*QuerySwapsHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d025ff8cf2d3d93, []int{4}
+}
+func (m *QuerySwapsHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapsHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapsHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapsHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapsHistoryResponse.Merge(m, src)
+}
+func (m *QuerySwapsHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapsHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapsHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapsHistoryResponse proto.InternalMessageInfo
+
+func (m *QuerySwapsHistoryResponse) GetSwaps() []IndexedSwap {
+	if m != nil {
+		return m.Swaps
+	}
+	return nil
+}
+
+func (m *QuerySwapsHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryIndexerStatusRequest struct {
+}
+
+func (m *QueryIndexerStatusRequest) Reset()         { *m = QueryIndexerStatusRequest{} }
+func (m *QueryIndexerStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIndexerStatusRequest) ProtoMessage()    {}
+func (*QueryIndexerStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d025ff8cf2d3d93, []int{5}
+}
+func (m *QueryIndexerStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIndexerStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIndexerStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIndexerStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIndexerStatusRequest.Merge(m, src)
+}
+func (m *QueryIndexerStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIndexerStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIndexerStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIndexerStatusRequest proto.InternalMessageInfo
+
+type QueryIndexerStatusResponse struct {
+	LastIndexedHeight int64 `protobuf:"varint,1,opt,name=last_indexed_height,json=lastIndexedHeight,proto3" json:"last_indexed_height,omitempty" yaml:"last_indexed_height"`
+}
+
+func (m *QueryIndexerStatusResponse) Reset()         { *m = QueryIndexerStatusResponse{} }
+func (m *QueryIndexerStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIndexerStatusResponse) ProtoMessage()    {}
+func (*QueryIndexerStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d025ff8cf2d3d93, []int{6}
+}
+func (m *QueryIndexerStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIndexerStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIndexerStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIndexerStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIndexerStatusResponse.Merge(m, src)
+}
+func (m *QueryIndexerStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIndexerStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIndexerStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIndexerStatusResponse proto.InternalMessageInfo
+
+func (m *QueryIndexerStatusResponse) GetLastIndexedHeight() int64 {
+	if m != nil {
+		return m.LastIndexedHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*IndexedSwap)(nil), "bep3.IndexedSwap")
+	proto.RegisterType((*QuerySwapHistoryRequest)(nil), "bep3.QuerySwapHistoryRequest")
+	proto.RegisterType((*QuerySwapHistoryResponse)(nil), "bep3.QuerySwapHistoryResponse")
+	proto.RegisterType((*QuerySwapsHistoryRequest)(nil), "bep3.QuerySwapsHistoryRequest")
+	proto.RegisterType((*QuerySwapsHistoryResponse)(nil), "bep3.QuerySwapsHistoryResponse")
+	proto.RegisterType((*QueryIndexerStatusRequest)(nil), "bep3.QueryIndexerStatusRequest")
+	proto.RegisterType((*QueryIndexerStatusResponse)(nil), "bep3.QueryIndexerStatusResponse")
+}
+
+func init() { proto.RegisterFile("bep3/indexer.proto", fileDescriptor_1d025ff8cf2d3d93) }
+
+var fileDescriptor_1d025ff8cf2d3d93 = []byte{
+	// 1241 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xdf, 0x6e, 0x1b, 0xc5,
+	0x17, 0xce, 0x36, 0xa9, 0x53, 0x8f, 0xed, 0xd8, 0x19, 0xa7, 0xcd, 0xc6, 0x6d, 0xbd, 0xd6, 0xfc,
+	0x7e, 0x80, 0x41, 0x74, 0xad, 0xba, 0x20, 0xa4, 0x48, 0x85, 0xd6, 0x89, 0x4a, 0xa2, 0x42, 0x1b,
+	0x26, 0x81, 0x0b, 0x90, 0xb0, 0xd6, 0xde, 0xa9, 0xbd, 0xc2, 0xfb, 0xa7, 0x3b, 0x6b, 0x1a, 0x4b,
+	0xdc, 0xf1, 0x02, 0xe5, 0x19, 0xb8, 0xe3, 0x49, 0xca, 0x5d, 0x2f, 0xb9, 0xda, 0xa2, 0xe4, 0x09,
+	0xf0, 0x25, 0xe2, 0x02, 0xcd, 0x1f, 0xef, 0xce, 0x3a, 0x0e, 0x51, 0x7a, 0x15, 0xcf, 0x39, 0xe7,
+	0xfb, 0xce, 0x39, 0xdf, 0xcc, 0x9c, 0x9d, 0x00, 0xd8, 0x23, 0xc1, 0xbd, 0x96, 0xe3, 0xd9, 0xe4,
+	0x98, 0x84, 0x66, 0x10, 0xfa, 0x91, 0x0f, 0x57, 0x98, 0xad, 0xb6, 0x31, 0xf0, 0x07, 0x3e, 0x37,
+	0xb4, 0xd8, 0x2f, 0xe1, 0xab, 0x19, 0x03, 0xdf, 0x1f, 0x8c, 0x48, 0x8b, 0xaf, 0x7a, 0xe3, 0x67,
+	0xad, 0xc8, 0x71, 0x09, 0x8d, 0x2c, 0x37, 0x90, 0x01, 0xf5, 0xbe, 0x4f, 0x5d, 0x9f, 0xb6, 0x7a,
+	0x16, 0x25, 0xad, 0x1f, 0xef, 0xf6, 0x48, 0x64, 0xdd, 0x6d, 0xf5, 0x7d, 0xc7, 0x93, 0xfe, 0x0f,
+	0x54, 0xff, 0xf3, 0x31, 0x09, 0x27, 0x49, 0x54, 0x60, 0x0d, 0x1c, 0xcf, 0x8a, 0x1c, 0x5f, 0xc6,
+	0xa2, 0xbf, 0xca, 0xa0, 0xb0, 0xcf, 0x4b, 0xb3, 0x0f, 0x5f, 0x58, 0x01, 0x74, 0xc1, 0x2a, 0x7d,
+	0x61, 0x05, 0x5d, 0xc7, 0xd6, 0xb5, 0x86, 0xd6, 0x2c, 0x76, 0x8e, 0x4e, 0x62, 0x23, 0xc7, 0x5c,
+	0xfb, 0xbb, 0xd3, 0xd8, 0x58, 0x9b, 0x58, 0xee, 0x68, 0x1b, 0xc9, 0x10, 0xf4, 0x77, 0x6c, 0x7c,
+	0x34, 0x70, 0xa2, 0xe1, 0xb8, 0x67, 0xf6, 0x7d, 0xb7, 0x15, 0x11, 0xcf, 0x26, 0xa1, 0xeb, 0x78,
+	0x91, 0xfa, 0x73, 0xe4, 0xf4, 0x68, 0xab, 0x37, 0x89, 0x08, 0x35, 0xf7, 0xc8, 0x71, 0x87, 0xfd,
+	0xc0, 0x39, 0xc6, 0xb0, 0x6f, 0xc3, 0x9f, 0x35, 0x00, 0x43, 0xcb, 0xb3, 0x7d, 0xb7, 0xeb, 0x8d,
+	0xdd, 0x1e, 0x09, 0xbb, 0x43, 0x8b, 0x0e, 0xf5, 0x2b, 0x3c, 0xf5, 0xd7, 0xd3, 0xd8, 0xd8, 0x12,
+	0x09, 0xcf, 0xc6, 0xbc, 0x7d, 0xee, 0x8a, 0x20, 0x7b, 0xc2, 0xb9, 0xf6, 0x2c, 0x3a, 0x84, 0x11,
+	0xc8, 0x59, 0xae, 0x3f, 0xf6, 0x22, 0x7d, 0xb9, 0xb1, 0xdc, 0x2c, 0xb4, 0xb7, 0x4c, 0xa1, 0xa0,
+	0xc9, 0x14, 0x34, 0xa5, 0x76, 0xe6, 0x8e, 0xef, 0x78, 0x9d, 0x87, 0xaf, 0x62, 0x63, 0x69, 0x1a,
+	0x1b, 0x25, 0x51, 0x97, 0x80, 0xa1, 0xdf, 0xde, 0x18, 0x4d, 0xa5, 0x16, 0xa9, 0xbf, 0xf8, 0x73,
+	0x87, 0xda, 0x3f, 0xb4, 0xa2, 0x49, 0x40, 0x28, 0x67, 0xa0, 0x58, 0xe6, 0x82, 0x3f, 0x81, 0x7c,
+	0x7f, 0x64, 0x39, 0x6e, 0x37, 0x72, 0x02, 0x7d, 0xe5, 0xa2, 0xc4, 0xbb, 0x32, 0x71, 0x45, 0x24,
+	0x4e, 0x90, 0x97, 0xcb, 0x7d, 0x8d, 0xe3, 0x8e, 0x9c, 0x00, 0xbe, 0x0f, 0x72, 0x94, 0xeb, 0xa4,
+	0x5f, 0x6d, 0x68, 0xcd, 0x7c, 0x67, 0x3d, 0x6d, 0x4a, 0xd8, 0x11, 0x96, 0x01, 0xb0, 0x0d, 0xf2,
+	0x21, 0xe9, 0x3b, 0x81, 0x43, 0xbc, 0x48, 0xcf, 0xf1, 0xe8, 0x8d, 0xb4, 0x92, 0xc4, 0x85, 0x70,
+	0x1a, 0x06, 0x1f, 0x03, 0x28, 0xd0, 0x5d, 0x3f, 0x1a, 0x92, 0xb0, 0xdb, 0x1f, 0x5a, 0x8e, 0xa7,
+	0xaf, 0x72, 0xf0, 0xed, 0x74, 0x5f, 0xcf, 0xc6, 0x20, 0x5c, 0x11, 0xc6, 0xa7, 0xcc, 0xb6, 0xc3,
+	0x4c, 0xf0, 0x08, 0x5c, 0x4f, 0x98, 0x33, 0x7c, 0xd7, 0x38, 0x5f, 0x63, 0x1a, 0x1b, 0xb7, 0xe6,
+	0x8a, 0xc9, 0x52, 0x56, 0x13, 0xbb, 0xc2, 0xda, 0x06, 0xf9, 0xe4, 0x66, 0xe9, 0xf9, 0x86, 0xd6,
+	0x5c, 0x56, 0xdb, 0x4a, 0x5c, 0x08, 0xa7, 0x61, 0xf0, 0x11, 0xa8, 0x90, 0xe3, 0xc0, 0x09, 0x49,
+	0x37, 0x85, 0x02, 0x0e, 0xbd, 0x39, 0x8d, 0x8d, 0x4d, 0x01, 0x9d, 0x8f, 0x40, 0xb8, 0x2c, 0x4c,
+	0x47, 0x09, 0xcf, 0x0e, 0xc8, 0xdb, 0x4e, 0x48, 0xfa, 0xec, 0x26, 0xea, 0x85, 0x86, 0xd6, 0x2c,
+	0x75, 0xde, 0x49, 0x73, 0x27, 0x2e, 0x76, 0xc8, 0x4b, 0xec, 0xf2, 0xed, 0xce, 0x2c, 0x38, 0xc5,
+	0xc1, 0x4f, 0x40, 0x8e, 0x46, 0x56, 0x34, 0xa6, 0x7a, 0x91, 0x33, 0x18, 0xca, 0x16, 0x72, 0x3b,
+	0x83, 0x03, 0x06, 0x3f, 0xe4, 0x4b, 0x2c, 0xc3, 0xe1, 0x03, 0xb0, 0xd6, 0x0f, 0x89, 0x15, 0x11,
+	0xbb, 0x3b, 0x24, 0xce, 0x60, 0x18, 0xe9, 0x25, 0xde, 0xc3, 0xd6, 0x34, 0x36, 0xae, 0xcb, 0xf3,
+	0x95, 0xf1, 0x23, 0x5c, 0x92, 0x86, 0x3d, 0xbe, 0x86, 0xdf, 0x83, 0xe2, 0x2c, 0x82, 0xb5, 0xa9,
+	0xaf, 0x35, 0xb4, 0x66, 0xa1, 0x5d, 0x33, 0xc5, 0xe8, 0x32, 0x67, 0xa3, 0xcb, 0x4c, 0x3a, 0xee,
+	0x18, 0xf2, 0xfc, 0x56, 0xb3, 0xfc, 0x0c, 0x8d, 0x5e, 0xbe, 0x31, 0x34, 0x5c, 0x90, 0x26, 0x06,
+	0x81, 0x1d, 0x50, 0x4e, 0x22, 0x8e, 0xc5, 0x4c, 0x28, 0xf3, 0xbd, 0xae, 0x4d, 0x63, 0xe3, 0xc6,
+	0x1c, 0x85, 0x08, 0x48, 0x6b, 0x3c, 0x3a, 0xe6, 0xb7, 0xfa, 0x01, 0x58, 0x13, 0xb2, 0x27, 0x5d,
+	0x56, 0xe6, 0xbb, 0xcc, 0xfa, 0x11, 0x2e, 0x49, 0x83, 0xec, 0xf2, 0x3e, 0x28, 0xf5, 0x47, 0x3e,
+	0x4d, 0x09, 0xd6, 0x39, 0x81, 0x3e, 0x8d, 0x8d, 0x8d, 0xd9, 0x35, 0x54, 0xdc, 0x08, 0x17, 0xc5,
+	0x5a, 0xc2, 0xbf, 0x03, 0x05, 0xe9, 0xe7, 0x1a, 0xc1, 0x0b, 0x35, 0xaa, 0x4b, 0x8d, 0x60, 0x86,
+	0x3c, 0x95, 0x08, 0x08, 0x0b, 0x57, 0xe8, 0x33, 0xb0, 0x36, 0xf3, 0x4b, 0x81, 0xaa, 0x5c, 0x20,
+	0x75, 0x0f, 0x33, 0xfe, 0xa4, 0x3a, 0x29, 0xcf, 0x36, 0x28, 0x8a, 0x21, 0x22, 0xc7, 0xc0, 0x06,
+	0x87, 0x6f, 0x2a, 0x5b, 0xa4, 0x78, 0x11, 0x2e, 0xf0, 0xe5, 0x21, 0x5f, 0xc1, 0x31, 0x28, 0x65,
+	0x26, 0xb2, 0x7e, 0x9d, 0x0f, 0xec, 0x83, 0x54, 0x98, 0x8c, 0xfb, 0xed, 0x67, 0x75, 0x51, 0x9d,
+	0xd5, 0x6c, 0x3f, 0x42, 0xf2, 0x6c, 0xec, 0xd9, 0xb3, 0x9a, 0x6f, 0xf0, 0x9a, 0x95, 0xfd, 0xc8,
+	0xb8, 0x11, 0x2e, 0x8a, 0xb5, 0xac, 0xfa, 0x7f, 0x60, 0xc5, 0x25, 0xae, 0xaf, 0x6f, 0x72, 0x54,
+	0x79, 0x1a, 0x1b, 0x05, 0x81, 0x62, 0x56, 0x84, 0xb9, 0x13, 0x7e, 0x09, 0xaa, 0xca, 0xe8, 0x48,
+	0xc4, 0xd5, 0x39, 0xa6, 0x3e, 0x8d, 0x8d, 0x9a, 0xc0, 0x2c, 0x08, 0x42, 0xb8, 0xe2, 0x27, 0xe3,
+	0x45, 0xaa, 0xfc, 0x8b, 0x06, 0x2a, 0x21, 0x71, 0x2d, 0xc7, 0x73, 0xbc, 0x41, 0x57, 0x7e, 0x65,
+	0xb6, 0x2e, 0x1a, 0xf6, 0x8f, 0xe5, 0x41, 0xd8, 0x9c, 0x75, 0x95, 0x25, 0xb8, 0xdc, 0xcc, 0x2f,
+	0x27, 0xf0, 0x87, 0x02, 0x7d, 0x00, 0x36, 0xbf, 0x62, 0xaf, 0x02, 0x36, 0x19, 0xf6, 0x1c, 0x1a,
+	0xf9, 0xe1, 0x04, 0x93, 0xe7, 0x63, 0x42, 0x23, 0xf8, 0x71, 0xf6, 0xf3, 0x9f, 0xef, 0xdc, 0xfa,
+	0xaf, 0xcf, 0xff, 0xec, 0x33, 0x8e, 0xbe, 0x01, 0xfa, 0x59, 0x46, 0x1a, 0xf8, 0x1e, 0x25, 0x70,
+	0x1b, 0xac, 0xb0, 0x28, 0xce, 0x57, 0x68, 0xaf, 0x9b, 0xec, 0xe5, 0x63, 0x2a, 0x4f, 0x8e, 0x4e,
+	0x55, 0x36, 0x5b, 0x48, 0xc9, 0x11, 0xe6, 0x18, 0xf4, 0xbb, 0xa6, 0x10, 0xd3, 0xb9, 0x5a, 0x3f,
+	0x04, 0xab, 0x96, 0x6d, 0x87, 0x84, 0x52, 0x59, 0x2b, 0x4c, 0x2b, 0x94, 0x0e, 0x84, 0x67, 0x21,
+	0xca, 0xb0, 0xbc, 0x72, 0xb9, 0x61, 0xf9, 0x08, 0x80, 0xf4, 0xd5, 0xa4, 0x2f, 0xf3, 0x2e, 0xde,
+	0xcd, 0x6c, 0x1d, 0x7f, 0x62, 0x25, 0x1b, 0x78, 0x60, 0x0d, 0x88, 0x2c, 0x11, 0x2b, 0x48, 0xf4,
+	0xab, 0x06, 0xb6, 0x16, 0xf4, 0x22, 0x55, 0xba, 0x0f, 0xae, 0xb2, 0x8e, 0x59, 0x2b, 0xcb, 0x8b,
+	0x65, 0xda, 0x90, 0x32, 0x15, 0x53, 0x99, 0x28, 0xc2, 0x02, 0x05, 0x3f, 0xcf, 0x14, 0x79, 0x85,
+	0x17, 0xf9, 0xde, 0x85, 0x45, 0x8a, 0xdc, 0x99, 0x2a, 0x6f, 0xca, 0x22, 0x45, 0xe6, 0x50, 0x6a,
+	0x21, 0xda, 0x41, 0x23, 0x50, 0x5b, 0xe4, 0x94, 0x2d, 0x3c, 0x01, 0xd5, 0x91, 0x45, 0xa3, 0xae,
+	0x78, 0xe9, 0x26, 0x33, 0x53, 0xe3, 0x33, 0x53, 0xb9, 0x39, 0x0b, 0x82, 0x10, 0x5e, 0x67, 0x56,
+	0xd9, 0xae, 0x18, 0x9f, 0xed, 0x7f, 0x34, 0xb0, 0x2a, 0x33, 0xc1, 0x2f, 0x40, 0x41, 0x39, 0x5b,
+	0xf0, 0xb6, 0x90, 0xe7, 0x9c, 0x53, 0x5c, 0xab, 0x9f, 0xe7, 0x96, 0x95, 0x3e, 0x05, 0x45, 0x75,
+	0x13, 0xe0, 0x7c, 0xfc, 0xdc, 0x49, 0xab, 0x19, 0xe7, 0xfa, 0x25, 0x21, 0x06, 0xa5, 0x8c, 0x26,
+	0x50, 0x45, 0x2c, 0x92, 0xb2, 0xd6, 0x38, 0x3f, 0x40, 0x70, 0x76, 0x3e, 0x7d, 0x75, 0x52, 0xd7,
+	0x5e, 0x9f, 0xd4, 0xb5, 0x3f, 0x4f, 0xea, 0xda, 0xcb, 0xd3, 0xfa, 0xd2, 0xeb, 0xd3, 0xfa, 0xd2,
+	0x1f, 0xa7, 0xf5, 0xa5, 0x6f, 0xff, 0xaf, 0x5c, 0x7d, 0x72, 0xc7, 0xf5, 0x3d, 0x32, 0x69, 0xf1,
+	0xff, 0x31, 0x5c, 0xdf, 0x1e, 0x8f, 0x88, 0xb8, 0xfc, 0xbd, 0x1c, 0xff, 0xc0, 0xdc, 0xfb, 0x77,
+	0x00, 0x1b, 0x87, 0x08, 0x0c, 0x7f, 0x0c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// IndexerClient is the client API for Indexer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type IndexerClient interface {
+	SwapHistory(ctx context.Context, in *QuerySwapHistoryRequest, opts ...grpc.CallOption) (*QuerySwapHistoryResponse, error)
+	SwapsHistory(ctx context.Context, in *QuerySwapsHistoryRequest, opts ...grpc.CallOption) (*QuerySwapsHistoryResponse, error)
+	IndexerStatus(ctx context.Context, in *QueryIndexerStatusRequest, opts ...grpc.CallOption) (*QueryIndexerStatusResponse, error)
+}
+
+type indexerClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewIndexerClient(cc grpc1.ClientConn) IndexerClient {
+	return &indexerClient{cc}
+}
+
+func (c *indexerClient) SwapHistory(ctx context.Context, in *QuerySwapHistoryRequest, opts ...grpc.CallOption) (*QuerySwapHistoryResponse, error) {
+	out := new(QuerySwapHistoryResponse)
+	err := c.cc.Invoke(ctx, "/bep3.Indexer/SwapHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) SwapsHistory(ctx context.Context, in *QuerySwapsHistoryRequest, opts ...grpc.CallOption) (*QuerySwapsHistoryResponse, error) {
+	out := new(QuerySwapsHistoryResponse)
+	err := c.cc.Invoke(ctx, "/bep3.Indexer/SwapsHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) IndexerStatus(ctx context.Context, in *QueryIndexerStatusRequest, opts ...grpc.CallOption) (*QueryIndexerStatusResponse, error) {
+	out := new(QueryIndexerStatusResponse)
+	err := c.cc.Invoke(ctx, "/bep3.Indexer/IndexerStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IndexerServer is the server API for Indexer service.
+type IndexerServer interface {
+	SwapHistory(context.Context, *QuerySwapHistoryRequest) (*QuerySwapHistoryResponse, error)
+	SwapsHistory(context.Context, *QuerySwapsHistoryRequest) (*QuerySwapsHistoryResponse, error)
+	IndexerStatus(context.Context, *QueryIndexerStatusRequest) (*QueryIndexerStatusResponse, error)
+}
+
+// UnimplementedIndexerServer can be embedded to have forward compatible implementations.
+type UnimplementedIndexerServer struct {
+}
+
+func (*UnimplementedIndexerServer) SwapHistory(ctx context.Context, req *QuerySwapHistoryRequest) (*QuerySwapHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapHistory not implemented")
+}
+func (*UnimplementedIndexerServer) SwapsHistory(ctx context.Context, req *QuerySwapsHistoryRequest) (*QuerySwapsHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapsHistory not implemented")
+}
+func (*UnimplementedIndexerServer) IndexerStatus(ctx context.Context, req *QueryIndexerStatusRequest) (*QueryIndexerStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IndexerStatus not implemented")
+}
+
+func RegisterIndexerServer(s grpc1.Server, srv IndexerServer) {
+	s.RegisterService(&_Indexer_serviceDesc, srv)
+}
+
+func _Indexer_SwapHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).SwapHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bep3.Indexer/SwapHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).SwapHistory(ctx, req.(*QuerySwapHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_SwapsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapsHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).SwapsHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bep3.Indexer/SwapsHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).SwapsHistory(ctx, req.(*QuerySwapsHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_IndexerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIndexerStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).IndexerStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bep3.Indexer/IndexerStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).IndexerStatus(ctx, req.(*QueryIndexerStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Indexer_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "bep3.Indexer",
+	HandlerType: (*IndexerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SwapHistory",
+			Handler:    _Indexer_SwapHistory_Handler,
+		},
+		{
+			MethodName: "SwapsHistory",
+			Handler:    _Indexer_SwapsHistory_Handler,
+		},
+		{
+			MethodName: "IndexerStatus",
+			Handler:    _Indexer_IndexerStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bep3/indexer.proto",
+}
+
+func (m *IndexedSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemainingAmount) > 0 {
+		for iNdEx := len(m.RemainingAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemainingAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIndexer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.OtherChainTxHash) > 0 {
+		i -= len(m.OtherChainTxHash)
+		copy(dAtA[i:], m.OtherChainTxHash)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.OtherChainTxHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.RefundSender) > 0 {
+		i -= len(m.RefundSender)
+		copy(dAtA[i:], m.RefundSender)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.RefundSender)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.RandomNumber) > 0 {
+		i -= len(m.RandomNumber)
+		copy(dAtA[i:], m.RandomNumber)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.RandomNumber)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.ClaimSender) > 0 {
+		i -= len(m.ClaimSender)
+		copy(dAtA[i:], m.ClaimSender)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.ClaimSender)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.ClosedTxHash) > 0 {
+		i -= len(m.ClosedTxHash)
+		copy(dAtA[i:], m.ClosedTxHash)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.ClosedTxHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ClosedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ClosedTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintIndexer(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if m.ClosedHeight != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.ClosedHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.ExpiredHeight != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.ExpiredHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.CreatedTxHash) > 0 {
+		i -= len(m.CreatedTxHash)
+		copy(dAtA[i:], m.CreatedTxHash)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.CreatedTxHash)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintIndexer(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x72
+	if m.CreatedHeight != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Status != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Direction != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ExpireTimestamp != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.ExpireTimestamp))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.RecipientOtherChain) > 0 {
+		i -= len(m.RecipientOtherChain)
+		copy(dAtA[i:], m.RecipientOtherChain)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.RecipientOtherChain)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.SenderOtherChain) > 0 {
+		i -= len(m.SenderOtherChain)
+		copy(dAtA[i:], m.SenderOtherChain)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.SenderOtherChain)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ClaimTip) > 0 {
+		for iNdEx := len(m.ClaimTip) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimTip[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIndexer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIndexer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RandomNumberHash) > 0 {
+		i -= len(m.RandomNumberHash)
+		copy(dAtA[i:], m.RandomNumberHash)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.RandomNumberHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SwapID) > 0 {
+		i -= len(m.SwapID)
+		copy(dAtA[i:], m.SwapID)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.SwapID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SwapID) > 0 {
+		i -= len(m.SwapID)
+		copy(dAtA[i:], m.SwapID)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.SwapID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Swap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIndexer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapsHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapsHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapsHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIndexer(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapsHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapsHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapsHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIndexer(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Swaps) > 0 {
+		for iNdEx := len(m.Swaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Swaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIndexer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIndexerStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIndexerStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIndexerStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIndexerStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIndexerStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIndexerStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastIndexedHeight != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.LastIndexedHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIndexer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIndexer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IndexedSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SwapID)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	l = len(m.RandomNumberHash)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovIndexer(uint64(l))
+		}
+	}
+	if len(m.ClaimTip) > 0 {
+		for _, e := range m.ClaimTip {
+			l = e.Size()
+			n += 1 + l + sovIndexer(uint64(l))
+		}
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	l = len(m.SenderOtherChain)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	l = len(m.RecipientOtherChain)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovIndexer(uint64(m.Timestamp))
+	}
+	if m.ExpireTimestamp != 0 {
+		n += 1 + sovIndexer(uint64(m.ExpireTimestamp))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovIndexer(uint64(m.Direction))
+	}
+	if m.Status != 0 {
+		n += 1 + sovIndexer(uint64(m.Status))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovIndexer(uint64(m.CreatedHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedTime)
+	n += 1 + l + sovIndexer(uint64(l))
+	l = len(m.CreatedTxHash)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	if m.ExpiredHeight != 0 {
+		n += 2 + sovIndexer(uint64(m.ExpiredHeight))
+	}
+	if m.ClosedHeight != 0 {
+		n += 2 + sovIndexer(uint64(m.ClosedHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ClosedTime)
+	n += 2 + l + sovIndexer(uint64(l))
+	l = len(m.ClosedTxHash)
+	if l > 0 {
+		n += 2 + l + sovIndexer(uint64(l))
+	}
+	l = len(m.ClaimSender)
+	if l > 0 {
+		n += 2 + l + sovIndexer(uint64(l))
+	}
+	l = len(m.RandomNumber)
+	if l > 0 {
+		n += 2 + l + sovIndexer(uint64(l))
+	}
+	l = len(m.RefundSender)
+	if l > 0 {
+		n += 2 + l + sovIndexer(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 2 + l + sovIndexer(uint64(l))
+	}
+	l = len(m.OtherChainTxHash)
+	if l > 0 {
+		n += 2 + l + sovIndexer(uint64(l))
+	}
+	if len(m.RemainingAmount) > 0 {
+		for _, e := range m.RemainingAmount {
+			l = e.Size()
+			n += 2 + l + sovIndexer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySwapHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SwapID)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Swap.Size()
+	n += 1 + l + sovIndexer(uint64(l))
+	return n
+}
+
+func (m *QuerySwapsHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovIndexer(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapsHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Swaps) > 0 {
+		for _, e := range m.Swaps {
+			l = e.Size()
+			n += 1 + l + sovIndexer(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIndexerStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryIndexerStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastIndexedHeight != 0 {
+		n += 1 + sovIndexer(uint64(m.LastIndexedHeight))
+	}
+	return n
+}
+
+func sovIndexer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIndexer(x uint64) (n int) {
+	return sovIndexer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IndexedSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIndexer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapID = append(m.SwapID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SwapID == nil {
+				m.SwapID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandomNumberHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RandomNumberHash = append(m.RandomNumberHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RandomNumberHash == nil {
+				m.RandomNumberHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimTip", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimTip = append(m.ClaimTip, types.Coin{})
+			if err := m.ClaimTip[len(m.ClaimTip)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderOtherChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderOtherChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientOtherChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientOtherChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTimestamp", wireType)
+			}
+			m.ExpireTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= SwapDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SwapStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredHeight", wireType)
+			}
+			m.ExpiredHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiredHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedHeight", wireType)
+			}
+			m.ClosedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClosedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ClosedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClosedTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandomNumber", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RandomNumber = append(m.RandomNumber[:0], dAtA[iNdEx:postIndex]...)
+			if m.RandomNumber == nil {
+				m.RandomNumber = []byte{}
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherChainTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OtherChainTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingAmount = append(m.RemainingAmount, types.Coin{})
+			if err := m.RemainingAmount[len(m.RemainingAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIndexer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIndexer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIndexer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIndexer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Swap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIndexer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapsHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIndexer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapsHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapsHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SwapStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIndexer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapsHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIndexer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapsHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapsHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Swaps = append(m.Swaps, IndexedSwap{})
+			if err := m.Swaps[len(m.Swaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIndexer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIndexerStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIndexer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIndexerStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIndexerStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIndexer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIndexerStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIndexer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIndexerStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIndexerStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastIndexedHeight", wireType)
+			}
+			m.LastIndexedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastIndexedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIndexer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIndexer(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIndexer
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIndexer
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIndexer
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIndexer
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIndexer        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIndexer          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIndexer = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,9 +1,11 @@
 package types
 
 import (
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 )
 
 const (
@@ -22,8 +24,9 @@ const (
 	// DefaultParamspace default namestore
 	DefaultParamspace = ModuleName
 
-	// DefaultLongtermStorageDuration is 1 week
-	DefaultLongtermStorageDuration uint64 = 7 * 24 * 60 * 60
+	// LegacyLongtermStorageKeyLength is the key length of the height keyed longterm storage index used before
+	// closed swaps were indexed by time
+	LegacyLongtermStorageKeyLength = 8 + SwapIDLength
 )
 
 // Key prefixes
@@ -31,11 +34,21 @@ var (
 	// ModulePermissionsUpgradeTime is the block time after which the bep3 module account's permissions are synced with the supply module.
 	ModulePermissionsUpgradeTime time.Time = time.Date(2020, 11, 3, 10, 0, 0, 0, time.UTC)
 
-	AtomicSwapKeyPrefix             = []byte{0x00} // prefix for keys that store AtomicSwaps
-	AtomicSwapByBlockPrefix         = []byte{0x01} // prefix for keys of the AtomicSwapsByBlock index
-	AtomicSwapLongtermStoragePrefix = []byte{0x02} // prefix for keys of the AtomicSwapLongtermStorage index
-	AssetSupplyPrefix               = []byte{0x03}
-	PreviousBlockTimeKey            = []byte{0x04}
+	AtomicSwapKeyPrefix                = []byte{0x00} // prefix for keys that store AtomicSwaps
+	AtomicSwapByBlockPrefix            = []byte{0x01} // prefix for keys of the AtomicSwapsByBlock index
+	AtomicSwapLongtermStoragePrefix    = []byte{0x02} // prefix for keys of the AtomicSwapLongtermStorage index, keyed by close time
+	AssetSupplyPrefix                  = []byte{0x03}
+	PreviousBlockTimeKey               = []byte{0x04}
+	DenyListPrefix                     = []byte{0x05} // prefix for keys of denied local and other-chain addresses
+	AtomicSwapByRandomNumberHashPrefix = []byte{0x06} // prefix for keys of the AtomicSwapByRandomNumberHash index
+	SwapStatsPrefix                    = []byte{0x07} // prefix for keys of cumulative swap stats, keyed by denom
+	DailySwapStatsPrefix               = []byte{0x08} // prefix for keys of daily swap stats, keyed by denom and day
+	DeputyActivityPrefix               = []byte{0x09} // prefix for keys of deputy activity, keyed by deputy address
+	AssetSuspensionPrefix              = []byte{0x0A} // prefix for keys of assets suspended for inactive deputies, keyed by denom
+	DeputyBondPrefix                   = []byte{0x0B} // prefix for keys of bonded deputy collateral, keyed by deputy address
+	DeputyUnbondingQueuePrefix         = []byte{0x0C} // prefix for keys of the deputy unbonding queue, keyed by completion time and deputy address
+	LightClientStatePrefix             = []byte{0x0D} // prefix for keys of the light client states of counterparty chains, keyed by denom
+	OpenSwapCountKey                   = []byte{0x0E} // key for the number of swaps in the byTimestamp index
 )
 
 // GetAtomicSwapByHeightKey is used by the AtomicSwapByBlock index and AtomicSwapLongtermStorage index
@@ -57,3 +70,40 @@ func GetHeightSortableKey(height uint64) []byte {
 func GetAtomicSwapByTimestampKey(timestamp int64, swapID []byte) []byte {
 	return append(GetTimestampSortableKey(timestamp), swapID...)
 }
+
+// GetAtomicSwapByRandomNumberHashKey is used by the AtomicSwapByRandomNumberHash index
+func GetAtomicSwapByRandomNumberHashKey(randomNumberHash, swapID []byte) []byte {
+	return append(append([]byte{}, randomNumberHash...), swapID...)
+}
+
+// GetDailySwapStatsDenomPrefix is the prefix of all daily swap stats keys of a denom. Denoms cannot contain a
+// zero byte, so it separates the denom from the day.
+func GetDailySwapStatsDenomPrefix(denom string) []byte {
+	return append([]byte(denom), 0x00)
+}
+
+// GetDailySwapStatsKey is used by the daily swap stats to key an asset's stats by day
+func GetDailySwapStatsKey(denom string, day int64) []byte {
+	return append(GetDailySwapStatsDenomPrefix(denom), GetHeightSortableKey(uint64(day))...)
+}
+
+// GetDeputyUnbondingKey is used by the deputy unbonding queue to key unbondings by completion time and deputy
+func GetDeputyUnbondingKey(completionTime time.Time, deputy string) []byte {
+	return append(GetTimestampSortableKey(completionTime.Unix()), deputy...)
+}
+
+// NormalizeDenyListAddress returns the canonical form of a deny list entry without surrounding spaces. Bech32
+// addresses are case-insensitive and lowercased, while other addresses, e.g. base58 or EIP-55 ones, may be
+// case-sensitive and are kept as is.
+func NormalizeDenyListAddress(address string) string {
+	address = strings.TrimSpace(address)
+	if _, _, err := bech32.DecodeAndConvert(address); err == nil {
+		return strings.ToLower(address)
+	}
+	return address
+}
+
+// GetDenyListKey is used by the deny list to key an address by its normalized form.
+func GetDenyListKey(address string) []byte {
+	return []byte(NormalizeDenyListAddress(address))
+}
@@ -13,24 +13,40 @@ import (
 
 const (
 	CreateAtomicSwap = "createAtomicSwap"
+	CreateLocalSwap  = "createLocalSwap"
 	ClaimAtomicSwap  = "claimAtomicSwap"
 	RefundAtomicSwap = "refundAtomicSwap"
 	CalcSwapID       = "calcSwapID"
 
-	Int64Size               = 8
-	RandomNumberHashLength  = 32
-	RandomNumberLength      = 32
-	AddrByteCount           = 20
-	MaxOtherChainAddrLength = 64
-	SwapIDLength            = 32
-	MaxExpectedIncomeLength = 64
+	BatchClaimAtomicSwaps  = "batchClaimAtomicSwaps"
+	BatchRefundAtomicSwaps = "batchRefundAtomicSwaps"
+	AnnotateSwap           = "annotateSwap"
+	BondDeputy             = "deputyBond"
+	UnbondDeputy           = "deputyUnbond"
+
+	Int64Size                 = 8
+	RandomNumberHashLength    = 32
+	RandomNumberLength        = 32
+	AddrByteCount             = 20
+	MaxOtherChainAddrLength   = 64
+	SwapIDLength              = 32
+	MaxExpectedIncomeLength   = 64
+	MaxBatchSize              = 100
+	MaxMemoLength             = 256
+	MaxOtherChainTxHashLength = 128
 )
 
 // ensure Msg interface compliance at compile time
 var (
 	_                      sdk.Msg = &MsgCreateAtomicSwap{}
+	_                      sdk.Msg = &MsgCreateLocalSwap{}
 	_                      sdk.Msg = &MsgClaimAtomicSwap{}
 	_                      sdk.Msg = &MsgRefundAtomicSwap{}
+	_                      sdk.Msg = &MsgBatchClaimAtomicSwaps{}
+	_                      sdk.Msg = &MsgBatchRefundAtomicSwaps{}
+	_                      sdk.Msg = &MsgAnnotateSwap{}
+	_                      sdk.Msg = &MsgDeputyBond{}
+	_                      sdk.Msg = &MsgDeputyUnbond{}
 	AtomicSwapCoinsAccAddr         = sdk.AccAddress(crypto.AddressHash([]byte("emoneyAtomicSwapCoins")))
 	// chain prefix address:  [INSERT BEP3-DEPUTY ADDRESS]
 )
@@ -58,9 +74,10 @@ func (msg MsgCreateAtomicSwap) Type() string { return CreateAtomicSwap }
 
 // String prints the MsgCreateAtomicSwap
 func (msg MsgCreateAtomicSwap) String() string {
-	return fmt.Sprintf("AtomicSwap{%s#%s#%v#%v#%v#%v#%v#%v}",
+	return fmt.Sprintf("AtomicSwap{%s#%s#%v#%v#%v#%v#%v#%v#%v#%v#%v#%v}",
 		msg.From, msg.To, msg.RecipientOtherChain, msg.SenderOtherChain,
-		msg.RandomNumberHash, msg.Timestamp, msg.Amount, msg.TimeSpanMin)
+		msg.RandomNumberHash, msg.Timestamp, msg.Amount, msg.TimeSpanMin, msg.ClaimTip,
+		msg.Memo, msg.OtherChainTxHash, msg.PartialClaims)
 }
 
 // GetInvolvedAddresses gets the addresses involved in a MsgCreateAtomicSwap
@@ -126,7 +143,16 @@ func (msg MsgCreateAtomicSwap) ValidateBasic() error {
 	if msg.TimeSpanMin <= 0 {
 		return errors.New("height span must be positive")
 	}
-	return nil
+	if err := ValidateClaimTip(msg.Amount, msg.ClaimTip); err != nil {
+		return err
+	}
+	if msg.PartialClaims && !msg.ClaimTip.Empty() {
+		return sdkerrors.Wrap(ErrInvalidPartialClaim, "swap with partial claims cannot have a claim tip")
+	}
+	if err := ValidateSwapProof(msg.Proof); err != nil {
+		return err
+	}
+	return ValidateSwapMetadata(msg.Memo, msg.OtherChainTxHash)
 }
 
 // GetSignBytes gets the sign bytes of a MsgCreateAtomicSwap
@@ -135,6 +161,83 @@ func (msg MsgCreateAtomicSwap) GetSignBytes() []byte {
 	return sdk.MustSortJSON(bz)
 }
 
+// NewMsgCreateLocalSwap initializes a new MsgCreateLocalSwap
+func NewMsgCreateLocalSwap(from, to string, randomNumberHash tmbytes.HexBytes, timestamp int64, amount sdk.Coins,
+	timeSpanMin int64) *MsgCreateLocalSwap {
+	return &MsgCreateLocalSwap{
+		From:             from,
+		To:               to,
+		RandomNumberHash: randomNumberHash,
+		Timestamp:        timestamp,
+		Amount:           amount,
+		TimeSpanMin:      timeSpanMin,
+	}
+}
+
+// Route establishes the route for the MsgCreateLocalSwap
+func (msg MsgCreateLocalSwap) Route() string { return RouterKey }
+
+// Type is the name of MsgCreateLocalSwap
+func (msg MsgCreateLocalSwap) Type() string { return CreateLocalSwap }
+
+// String prints the MsgCreateLocalSwap
+func (msg MsgCreateLocalSwap) String() string {
+	return fmt.Sprintf("localSwap{%v#%v#%v#%v#%v#%v#%v}",
+		msg.From, msg.To, msg.RandomNumberHash, msg.Timestamp, msg.Amount, msg.TimeSpanMin, msg.Memo)
+}
+
+// GetSigners gets the signers of a MsgCreateLocalSwap
+func (msg MsgCreateLocalSwap) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic validates the MsgCreateLocalSwap
+func (msg MsgCreateLocalSwap) ValidateBasic() error {
+	fromAcc, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "expected Bech32 create swap 'From' address %s, error:%s", msg.From, err)
+	}
+	if len(fromAcc.Bytes()) != AddrByteCount {
+		return fmt.Errorf("the expected address length is %d, actual length is %d", AddrByteCount, len(fromAcc.Bytes()))
+	}
+	toAcc, err := sdk.AccAddressFromBech32(msg.To)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid Bech32 'To' address %s, %s", msg.To, err)
+	}
+	if len(toAcc.Bytes()) != AddrByteCount {
+		return fmt.Errorf("the expected address length is %d, actual length is %d", AddrByteCount, len(toAcc.Bytes()))
+	}
+	if fromAcc.Equals(toAcc) {
+		return sdkerrors.Wrap(ErrInvalidSwapAccount, "sender cannot be the recipient of a local swap")
+	}
+	if len(msg.RandomNumberHash) != RandomNumberHashLength {
+		return fmt.Errorf("the length of random number hash should be %d", RandomNumberHashLength)
+	}
+	if msg.Timestamp <= 0 {
+		return errors.New("timestamp must be positive")
+	}
+	if len(msg.Amount) != 1 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount must contain exactly one coin")
+	}
+	if !msg.Amount.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	if msg.TimeSpanMin < 1 || msg.TimeSpanMin > ThreeDayMinutes {
+		return sdkerrors.Wrapf(ErrInvalidTimeSpan, "minutes span %d outside range [%d, %d]", msg.TimeSpanMin, 1, ThreeDayMinutes)
+	}
+	return ValidateSwapMetadata(msg.Memo, "")
+}
+
+// GetSignBytes gets the sign bytes of a MsgCreateLocalSwap
+func (msg MsgCreateLocalSwap) GetSignBytes() []byte {
+	bz := ModuleCdc.LegacyAmino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
 // NewMsgClaimAtomicSwap initializes a new MsgClaimAtomicSwap
 func NewMsgClaimAtomicSwap(from sdk.AccAddress, swapID, randomNumber []byte) *MsgClaimAtomicSwap {
 	return &MsgClaimAtomicSwap{
@@ -152,7 +255,7 @@ func (msg MsgClaimAtomicSwap) Type() string { return ClaimAtomicSwap }
 
 // String prints the MsgClaimAtomicSwap
 func (msg MsgClaimAtomicSwap) String() string {
-	return fmt.Sprintf("claimAtomicSwap{%v#%v#%v}", msg.From, msg.SwapID, msg.RandomNumber)
+	return fmt.Sprintf("claimAtomicSwap{%v#%v#%v#%v}", msg.From, msg.SwapID, msg.RandomNumber, msg.Amount)
 }
 
 // GetInvolvedAddresses gets the addresses involved in a MsgClaimAtomicSwap
@@ -188,6 +291,9 @@ func (msg MsgClaimAtomicSwap) ValidateBasic() error {
 	if len(msg.RandomNumber) != RandomNumberLength {
 		return fmt.Errorf("the length of random number should be %d", RandomNumberLength)
 	}
+	if !msg.Amount.Empty() && (len(msg.Amount) != 1 || !msg.Amount.IsValid()) {
+		return sdkerrors.Wrapf(ErrInvalidPartialClaim, "claim amount %s must be a single positive coin", msg.Amount)
+	}
 	return nil
 }
 
@@ -253,3 +359,311 @@ func (msg MsgRefundAtomicSwap) GetSignBytes() []byte {
 	bz := ModuleCdc.LegacyAmino.MustMarshalJSON(msg)
 	return sdk.MustSortJSON(bz)
 }
+
+// NewBatchClaimItem initializes a new BatchClaimItem
+func NewBatchClaimItem(swapID, randomNumber []byte) BatchClaimItem {
+	return BatchClaimItem{
+		SwapID:       swapID,
+		RandomNumber: randomNumber,
+	}
+}
+
+// NewMsgBatchClaimAtomicSwaps initializes a new MsgBatchClaimAtomicSwaps
+func NewMsgBatchClaimAtomicSwaps(from sdk.AccAddress, claims []BatchClaimItem, atomic bool) *MsgBatchClaimAtomicSwaps {
+	return &MsgBatchClaimAtomicSwaps{
+		From:   from.String(),
+		Claims: claims,
+		Atomic: atomic,
+	}
+}
+
+// Route establishes the route for the MsgBatchClaimAtomicSwaps
+func (msg MsgBatchClaimAtomicSwaps) Route() string { return RouterKey }
+
+// Type is the name of MsgBatchClaimAtomicSwaps
+func (msg MsgBatchClaimAtomicSwaps) Type() string { return BatchClaimAtomicSwaps }
+
+// String prints the MsgBatchClaimAtomicSwaps
+func (msg MsgBatchClaimAtomicSwaps) String() string {
+	return fmt.Sprintf("batchClaimAtomicSwaps{%v#%d#%v}", msg.From, len(msg.Claims), msg.Atomic)
+}
+
+// GetInvolvedAddresses gets the addresses involved in a MsgBatchClaimAtomicSwaps
+func (msg MsgBatchClaimAtomicSwaps) GetInvolvedAddresses() []sdk.AccAddress {
+	return append(msg.GetSigners(), AtomicSwapCoinsAccAddr)
+}
+
+// GetSigners gets the signers of a MsgBatchClaimAtomicSwaps
+func (msg MsgBatchClaimAtomicSwaps) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic validates the MsgBatchClaimAtomicSwaps
+func (msg MsgBatchClaimAtomicSwaps) ValidateBasic() error {
+	if err := validateBatchFrom(msg.From); err != nil {
+		return err
+	}
+	if err := validateBatchSize(len(msg.Claims)); err != nil {
+		return err
+	}
+	seen := make(map[string]bool, len(msg.Claims))
+	for i, claim := range msg.Claims {
+		if len(claim.SwapID) != SwapIDLength {
+			return sdkerrors.Wrapf(ErrInvalidBatch, "claim %d: the length of swapID should be %d", i, SwapIDLength)
+		}
+		if len(claim.RandomNumber) != RandomNumberLength {
+			return sdkerrors.Wrapf(ErrInvalidBatch, "claim %d: the length of random number should be %d", i, RandomNumberLength)
+		}
+		if seen[claim.SwapID.String()] {
+			return sdkerrors.Wrapf(ErrInvalidBatch, "claim %d: duplicate swap %s", i, claim.SwapID)
+		}
+		seen[claim.SwapID.String()] = true
+	}
+	return nil
+}
+
+// GetSignBytes gets the sign bytes of a MsgBatchClaimAtomicSwaps
+func (msg MsgBatchClaimAtomicSwaps) GetSignBytes() []byte {
+	bz := ModuleCdc.LegacyAmino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// NewMsgBatchRefundAtomicSwaps initializes a new MsgBatchRefundAtomicSwaps
+func NewMsgBatchRefundAtomicSwaps(from sdk.AccAddress, swapIDs []tmbytes.HexBytes, atomic bool) *MsgBatchRefundAtomicSwaps {
+	return &MsgBatchRefundAtomicSwaps{
+		From:    from.String(),
+		SwapIDs: swapIDs,
+		Atomic:  atomic,
+	}
+}
+
+// Route establishes the route for the MsgBatchRefundAtomicSwaps
+func (msg MsgBatchRefundAtomicSwaps) Route() string { return RouterKey }
+
+// Type is the name of MsgBatchRefundAtomicSwaps
+func (msg MsgBatchRefundAtomicSwaps) Type() string { return BatchRefundAtomicSwaps }
+
+// String prints the MsgBatchRefundAtomicSwaps
+func (msg MsgBatchRefundAtomicSwaps) String() string {
+	return fmt.Sprintf("batchRefundAtomicSwaps{%v#%d#%v}", msg.From, len(msg.SwapIDs), msg.Atomic)
+}
+
+// GetInvolvedAddresses gets the addresses involved in a MsgBatchRefundAtomicSwaps
+func (msg MsgBatchRefundAtomicSwaps) GetInvolvedAddresses() []sdk.AccAddress {
+	return append(msg.GetSigners(), AtomicSwapCoinsAccAddr)
+}
+
+// GetSigners gets the signers of a MsgBatchRefundAtomicSwaps
+func (msg MsgBatchRefundAtomicSwaps) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic validates the MsgBatchRefundAtomicSwaps
+func (msg MsgBatchRefundAtomicSwaps) ValidateBasic() error {
+	if err := validateBatchFrom(msg.From); err != nil {
+		return err
+	}
+	if err := validateBatchSize(len(msg.SwapIDs)); err != nil {
+		return err
+	}
+	seen := make(map[string]bool, len(msg.SwapIDs))
+	for i, swapID := range msg.SwapIDs {
+		if len(swapID) != SwapIDLength {
+			return sdkerrors.Wrapf(ErrInvalidBatch, "refund %d: the length of swapID should be %d", i, SwapIDLength)
+		}
+		if seen[swapID.String()] {
+			return sdkerrors.Wrapf(ErrInvalidBatch, "refund %d: duplicate swap %s", i, swapID)
+		}
+		seen[swapID.String()] = true
+	}
+	return nil
+}
+
+// GetSignBytes gets the sign bytes of a MsgBatchRefundAtomicSwaps
+func (msg MsgBatchRefundAtomicSwaps) GetSignBytes() []byte {
+	bz := ModuleCdc.LegacyAmino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// NewMsgAnnotateSwap initializes a new MsgAnnotateSwap
+func NewMsgAnnotateSwap(from sdk.AccAddress, swapID []byte, otherChainTxHash string) *MsgAnnotateSwap {
+	return &MsgAnnotateSwap{
+		From:             from.String(),
+		SwapID:           swapID,
+		OtherChainTxHash: otherChainTxHash,
+	}
+}
+
+// Route establishes the route for the MsgAnnotateSwap
+func (msg MsgAnnotateSwap) Route() string { return RouterKey }
+
+// Type is the name of MsgAnnotateSwap
+func (msg MsgAnnotateSwap) Type() string { return AnnotateSwap }
+
+// String prints the MsgAnnotateSwap
+func (msg MsgAnnotateSwap) String() string {
+	return fmt.Sprintf("annotateSwap{%v#%v#%v}", msg.From, msg.SwapID, msg.OtherChainTxHash)
+}
+
+// GetSigners gets the signers of a MsgAnnotateSwap
+func (msg MsgAnnotateSwap) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic validates the MsgAnnotateSwap
+func (msg MsgAnnotateSwap) ValidateBasic() error {
+	if len(msg.From) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	fromAcc, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "expected Bech32 annotate 'From' address %s, error:%s", msg.From, err)
+	}
+	if len(fromAcc.Bytes()) != AddrByteCount {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "actual address length ≠ expected length (%d ≠ %d)", len(fromAcc.Bytes()), AddrByteCount)
+	}
+	if len(msg.SwapID) != SwapIDLength {
+		return fmt.Errorf("the length of swapID should be %d", SwapIDLength)
+	}
+	if strings.TrimSpace(msg.OtherChainTxHash) == "" {
+		return sdkerrors.Wrap(ErrInvalidSwapMetadata, "other chain tx hash cannot be blank")
+	}
+	return ValidateSwapMetadata("", msg.OtherChainTxHash)
+}
+
+// GetSignBytes gets the sign bytes of a MsgAnnotateSwap
+func (msg MsgAnnotateSwap) GetSignBytes() []byte {
+	bz := ModuleCdc.LegacyAmino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// NewMsgDeputyBond initializes a new MsgDeputyBond
+func NewMsgDeputyBond(from sdk.AccAddress, amount sdk.Coins) *MsgDeputyBond {
+	return &MsgDeputyBond{
+		From:   from.String(),
+		Amount: amount,
+	}
+}
+
+// Route establishes the route for the MsgDeputyBond
+func (msg MsgDeputyBond) Route() string { return RouterKey }
+
+// Type is the name of MsgDeputyBond
+func (msg MsgDeputyBond) Type() string { return BondDeputy }
+
+// String prints the MsgDeputyBond
+func (msg MsgDeputyBond) String() string {
+	return fmt.Sprintf("deputyBond{%v#%v}", msg.From, msg.Amount)
+}
+
+// GetSigners gets the signers of a MsgDeputyBond
+func (msg MsgDeputyBond) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic validates the MsgDeputyBond
+func (msg MsgDeputyBond) ValidateBasic() error {
+	return validateBondMsg(msg.From, msg.Amount)
+}
+
+// GetSignBytes gets the sign bytes of a MsgDeputyBond
+func (msg MsgDeputyBond) GetSignBytes() []byte {
+	bz := ModuleCdc.LegacyAmino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// NewMsgDeputyUnbond initializes a new MsgDeputyUnbond
+func NewMsgDeputyUnbond(from sdk.AccAddress, amount sdk.Coins) *MsgDeputyUnbond {
+	return &MsgDeputyUnbond{
+		From:   from.String(),
+		Amount: amount,
+	}
+}
+
+// Route establishes the route for the MsgDeputyUnbond
+func (msg MsgDeputyUnbond) Route() string { return RouterKey }
+
+// Type is the name of MsgDeputyUnbond
+func (msg MsgDeputyUnbond) Type() string { return UnbondDeputy }
+
+// String prints the MsgDeputyUnbond
+func (msg MsgDeputyUnbond) String() string {
+	return fmt.Sprintf("deputyUnbond{%v#%v}", msg.From, msg.Amount)
+}
+
+// GetSigners gets the signers of a MsgDeputyUnbond
+func (msg MsgDeputyUnbond) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic validates the MsgDeputyUnbond
+func (msg MsgDeputyUnbond) ValidateBasic() error {
+	return validateBondMsg(msg.From, msg.Amount)
+}
+
+// GetSignBytes gets the sign bytes of a MsgDeputyUnbond
+func (msg MsgDeputyUnbond) GetSignBytes() []byte {
+	bz := ModuleCdc.LegacyAmino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func validateBondMsg(from string, amount sdk.Coins) error {
+	if len(from) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	fromAcc, err := sdk.AccAddressFromBech32(from)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "expected Bech32 deputy 'From' address %s, error:%s", from, err)
+	}
+	if len(fromAcc.Bytes()) != AddrByteCount {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "actual address length ≠ expected length (%d ≠ %d)", len(fromAcc.Bytes()), AddrByteCount)
+	}
+	if amount.Empty() || !amount.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "bond amount %s must be positive", amount)
+	}
+	return nil
+}
+
+func validateBatchFrom(from string) error {
+	if len(from) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	fromAcc, err := sdk.AccAddressFromBech32(from)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "expected Bech32 batch 'From' address %s, error:%s", from, err)
+	}
+	if len(fromAcc.Bytes()) != AddrByteCount {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "actual address length ≠ expected length (%d ≠ %d)", len(fromAcc.Bytes()), AddrByteCount)
+	}
+	return nil
+}
+
+func validateBatchSize(size int) error {
+	if size == 0 {
+		return sdkerrors.Wrap(ErrInvalidBatch, "batch cannot be empty")
+	}
+	if size > MaxBatchSize {
+		return sdkerrors.Wrapf(ErrInvalidBatch, "batch size %d exceeds max %d", size, MaxBatchSize)
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -37,11 +38,15 @@ func TestMsgCreateAtomicSwap(t *testing.T) {
 		timestamp           int64
 		amount              sdk.Coins
 		timeSpan            int64
+		claimTip            sdk.Coins
 		expectPass          bool
 	}{
-		{"normal cross-chain", binanceAddrs[0], kavaAddrs[0], kavaAddrs[0].String(), binanceAddrs[0].String(), randomNumberHash, timestampInt64, coinsSingle, 500, true},
-		{"without other chain fields", binanceAddrs[0], kavaAddrs[0], "", "", randomNumberHash, timestampInt64, coinsSingle, 500, false},
-		{"invalid amount", binanceAddrs[0], kavaAddrs[0], "", "", randomNumberHash, timestampInt64, coinsZero, 500, false},
+		{"normal cross-chain", binanceAddrs[0], kavaAddrs[0], kavaAddrs[0].String(), binanceAddrs[0].String(), randomNumberHash, timestampInt64, coinsSingle, 500, nil, true},
+		{"without other chain fields", binanceAddrs[0], kavaAddrs[0], "", "", randomNumberHash, timestampInt64, coinsSingle, 500, nil, false},
+		{"invalid amount", binanceAddrs[0], kavaAddrs[0], "", "", randomNumberHash, timestampInt64, coinsZero, 500, nil, false},
+		{"with claim tip", binanceAddrs[0], kavaAddrs[0], kavaAddrs[0].String(), binanceAddrs[0].String(), randomNumberHash, timestampInt64, coinsSingle, 500, sdk.NewCoins(sdk.NewInt64Coin("bnb", 100)), true},
+		{"claim tip equal to amount", binanceAddrs[0], kavaAddrs[0], kavaAddrs[0].String(), binanceAddrs[0].String(), randomNumberHash, timestampInt64, coinsSingle, 500, coinsSingle, false},
+		{"claim tip in other denom", binanceAddrs[0], kavaAddrs[0], kavaAddrs[0].String(), binanceAddrs[0].String(), randomNumberHash, timestampInt64, coinsSingle, 500, sdk.NewCoins(sdk.NewInt64Coin("ungm", 100)), false},
 	}
 
 	for i, tc := range tests {
@@ -55,6 +60,7 @@ func TestMsgCreateAtomicSwap(t *testing.T) {
 			tc.amount,
 			tc.timeSpan,
 		)
+		msg.ClaimTip = tc.claimTip
 		if tc.expectPass {
 			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
 		} else {
@@ -63,6 +69,97 @@ func TestMsgCreateAtomicSwap(t *testing.T) {
 	}
 }
 
+func TestMsgCreateAtomicSwapMetadata(t *testing.T) {
+	tests := []struct {
+		description      string
+		memo             string
+		otherChainTxHash string
+		expectPass       bool
+	}{
+		{"empty", "", "", true},
+		{"memo and tx hash", "invoice 42", "0xabc123", true},
+		{"memo at max length", strings.Repeat("m", types.MaxMemoLength), "", true},
+		{"memo too long", strings.Repeat("m", types.MaxMemoLength+1), "", false},
+		{"tx hash too long", "", strings.Repeat("a", types.MaxOtherChainTxHashLength+1), false},
+		{"tx hash with whitespace", "", "0xabc 123", false},
+	}
+
+	for i, tc := range tests {
+		msg := types.NewMsgCreateAtomicSwap(binanceAddrs[0].String(), kavaAddrs[0].String(), kavaAddrs[0].String(),
+			binanceAddrs[0].String(), randomNumberHash, timestampInt64, coinsSingle, 500)
+		msg.Memo = tc.memo
+		msg.OtherChainTxHash = tc.otherChainTxHash
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgCreateAtomicSwapPartialClaims(t *testing.T) {
+	msg := types.NewMsgCreateAtomicSwap(binanceAddrs[0].String(), kavaAddrs[0].String(), kavaAddrs[0].String(),
+		binanceAddrs[0].String(), randomNumberHash, timestampInt64, coinsSingle, 500)
+	msg.PartialClaims = true
+	require.NoError(t, msg.ValidateBasic())
+
+	// The claim tip of a swap is paid on its claim, which is ambiguous for swaps claimed in parts
+	msg.ClaimTip = sdk.NewCoins(sdk.NewInt64Coin("bnb", 100))
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidPartialClaim)
+}
+
+func TestMsgCreateAtomicSwapProof(t *testing.T) {
+	tests := []struct {
+		description string
+		proof       *types.SwapProof
+		expectPass  bool
+	}{
+		{"no proof", nil, true},
+		{"header and proof", &types.SwapProof{Header: []byte{1}, Proof: []byte{2}}, true},
+		{"no header", &types.SwapProof{Proof: []byte{2}}, false},
+		{"no inclusion proof", &types.SwapProof{Header: []byte{1}}, false},
+	}
+
+	for i, tc := range tests {
+		msg := types.NewMsgCreateAtomicSwap(binanceAddrs[0].String(), kavaAddrs[0].String(), kavaAddrs[0].String(),
+			binanceAddrs[0].String(), randomNumberHash, timestampInt64, coinsSingle, 500)
+		msg.Proof = tc.proof
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidSwapProof, "test: %v", i)
+		}
+	}
+}
+
+func TestMsgCreateLocalSwap(t *testing.T) {
+	tests := []struct {
+		description      string
+		from             sdk.AccAddress
+		to               sdk.AccAddress
+		randomNumberHash tmbytes.HexBytes
+		amount           sdk.Coins
+		timeSpan         int64
+		expectPass       bool
+	}{
+		{"normal", kavaAddrs[0], kavaAddrs[1], randomNumberHash, coinsSingle, 500, true},
+		{"swap with self", kavaAddrs[0], kavaAddrs[0], randomNumberHash, coinsSingle, 500, false},
+		{"invalid random number hash", kavaAddrs[0], kavaAddrs[1], tmbytes.HexBytes{1}, coinsSingle, 500, false},
+		{"invalid amount", kavaAddrs[0], kavaAddrs[1], randomNumberHash, coinsZero, 500, false},
+		{"several denoms", kavaAddrs[0], kavaAddrs[1], randomNumberHash, coinsSingle.Add(sdk.NewInt64Coin("ungm", 1)), 500, false},
+		{"time span too long", kavaAddrs[0], kavaAddrs[1], randomNumberHash, coinsSingle, types.ThreeDayMinutes + 1, false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgCreateLocalSwap(tc.from.String(), tc.to.String(), tc.randomNumberHash, timestampInt64, tc.amount, tc.timeSpan)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), tc.description)
+		}
+	}
+}
+
 func TestMsgClaimAtomicSwap(t *testing.T) {
 	swapID := types.CalculateSwapID(randomNumberHash, binanceAddrs[0], "")
 
@@ -71,9 +168,13 @@ func TestMsgClaimAtomicSwap(t *testing.T) {
 		from         sdk.AccAddress
 		swapID       tmbytes.HexBytes
 		randomNumber tmbytes.HexBytes
+		amount       sdk.Coins
 		expectPass   bool
 	}{
-		{"normal", binanceAddrs[0], swapID, randomNumberHash, true},
+		{"normal", binanceAddrs[0], swapID, randomNumberHash, nil, true},
+		{"partial amount", binanceAddrs[0], swapID, randomNumberHash, sdk.NewCoins(sdk.NewInt64Coin("bnb", 100)), true},
+		{"invalid partial amount", binanceAddrs[0], swapID, randomNumberHash, coinsZero, false},
+		{"partial amount in two denoms", binanceAddrs[0], swapID, randomNumberHash, sdk.NewCoins(sdk.NewInt64Coin("bnb", 100), sdk.NewInt64Coin("ungm", 100)), false},
 	}
 
 	for i, tc := range tests {
@@ -82,6 +183,7 @@ func TestMsgClaimAtomicSwap(t *testing.T) {
 			tc.swapID,
 			tc.randomNumber,
 		)
+		msg.Amount = tc.amount
 		if tc.expectPass {
 			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
 		} else {
@@ -114,3 +216,116 @@ func TestMsgRefundAtomicSwap(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgBatchClaimAtomicSwaps(t *testing.T) {
+	swapID := types.CalculateSwapID(randomNumberHash, binanceAddrs[0], "")
+	otherSwapID := types.CalculateSwapID(randomNumberHash, binanceAddrs[1], "")
+	claim := types.NewBatchClaimItem(swapID, randomNumberHash)
+
+	tooMany := make([]types.BatchClaimItem, types.MaxBatchSize+1)
+	for i := range tooMany {
+		tooMany[i] = types.NewBatchClaimItem(types.CalculateSwapID(randomNumberHash, binanceAddrs[0], string(rune('a'+i))), randomNumberHash)
+	}
+
+	tests := []struct {
+		description string
+		from        sdk.AccAddress
+		claims      []types.BatchClaimItem
+		expectPass  bool
+	}{
+		{"normal", binanceAddrs[0], []types.BatchClaimItem{claim, types.NewBatchClaimItem(otherSwapID, randomNumberHash)}, true},
+		{"empty batch", binanceAddrs[0], nil, false},
+		{"too many claims", binanceAddrs[0], tooMany, false},
+		{"duplicate swap", binanceAddrs[0], []types.BatchClaimItem{claim, claim}, false},
+		{"short random number", binanceAddrs[0], []types.BatchClaimItem{types.NewBatchClaimItem(swapID, randomNumberBytes)}, false},
+		{"empty sender", sdk.AccAddress{}, []types.BatchClaimItem{claim}, false},
+	}
+
+	for i, tc := range tests {
+		msg := types.NewMsgBatchClaimAtomicSwaps(tc.from, tc.claims, false)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgBatchRefundAtomicSwaps(t *testing.T) {
+	swapID := types.CalculateSwapID(randomNumberHash, binanceAddrs[0], "")
+	otherSwapID := types.CalculateSwapID(randomNumberHash, binanceAddrs[1], "")
+
+	tests := []struct {
+		description string
+		from        sdk.AccAddress
+		swapIDs     []tmbytes.HexBytes
+		expectPass  bool
+	}{
+		{"normal", binanceAddrs[0], []tmbytes.HexBytes{swapID, otherSwapID}, true},
+		{"empty batch", binanceAddrs[0], nil, false},
+		{"duplicate swap", binanceAddrs[0], []tmbytes.HexBytes{swapID, swapID}, false},
+		{"short swap id", binanceAddrs[0], []tmbytes.HexBytes{swapID[:16]}, false},
+	}
+
+	for i, tc := range tests {
+		msg := types.NewMsgBatchRefundAtomicSwaps(tc.from, tc.swapIDs, true)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgAnnotateSwap(t *testing.T) {
+	swapID := types.CalculateSwapID(randomNumberHash, binanceAddrs[0], "")
+
+	tests := []struct {
+		description      string
+		from             sdk.AccAddress
+		swapID           tmbytes.HexBytes
+		otherChainTxHash string
+		expectPass       bool
+	}{
+		{"normal", binanceAddrs[0], swapID, "0xabc123", true},
+		{"empty from", sdk.AccAddress{}, swapID, "0xabc123", false},
+		{"invalid swap id", binanceAddrs[0], swapID[:31], "0xabc123", false},
+		{"blank tx hash", binanceAddrs[0], swapID, " ", false},
+		{"tx hash too long", binanceAddrs[0], swapID, strings.Repeat("a", types.MaxOtherChainTxHashLength+1), false},
+	}
+
+	for i, tc := range tests {
+		msg := types.NewMsgAnnotateSwap(tc.from, tc.swapID, tc.otherChainTxHash)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgDeputyBond(t *testing.T) {
+	tests := []struct {
+		description string
+		from        sdk.AccAddress
+		amount      sdk.Coins
+		expectPass  bool
+	}{
+		{"normal", binanceAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("ungm", 1000)), true},
+		{"empty from", sdk.AccAddress{}, sdk.NewCoins(sdk.NewInt64Coin("ungm", 1000)), false},
+		{"empty amount", binanceAddrs[0], sdk.NewCoins(), false},
+		{"negative amount", binanceAddrs[0], sdk.Coins{sdk.Coin{Denom: "ungm", Amount: sdk.NewInt(-1)}}, false},
+	}
+
+	for i, tc := range tests {
+		bond := types.NewMsgDeputyBond(tc.from, tc.amount)
+		unbond := types.NewMsgDeputyUnbond(tc.from, tc.amount)
+		if tc.expectPass {
+			require.NoError(t, bond.ValidateBasic(), "test: %v", i)
+			require.NoError(t, unbond.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, bond.ValidateBasic(), "test: %v", i)
+			require.Error(t, unbond.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
 )
@@ -19,33 +20,58 @@ const (
 
 // Parameter keys
 var (
-	KeyAssetParams = []byte("AssetParams")
+	KeyAssetParams              = []byte("AssetParams")
+	KeyLongtermStorageRetention = []byte("LongtermStorageRetention")
 
 	DefaultMinAmount           sdk.Int = sdk.ZeroInt()
 	DefaultMaxAmount           sdk.Int = sdk.NewInt(1000000000000) // 10,000 BNB
 	DefaultPreviousBlockTime           = tmtime.Canonical(time.Unix(0, 0))
 	DefaultSwapBlockTimestamp  int64   = 10 // At 10th second.
 	DefaultSwapTimeSpanMinutes int64   = 5  // 5 minutes
+
+	// DefaultLongtermStorageRetention keeps closed swaps for 1 week
+	DefaultLongtermStorageRetention = NewLongtermStorageRetention(RetentionUnitSeconds, 7*24*60*60)
+)
+
+// Units of a LongtermStorageRetention
+const (
+	RetentionUnitSeconds = "seconds"
+	RetentionUnitBlocks  = "blocks"
 )
 
 // String implements fmt.Stringer
 func (p Params) String() string {
 	return fmt.Sprintf(`Params:
-	AssetParams: %s`,
-		p.AssetParams)
+	AssetParams: %s
+	LongtermStorageRetention: %s`,
+		p.AssetParams, p.LongtermStorageRetention)
 }
 
 // NewParams returns a new params object
-func NewParams(ap AssetParams,
+func NewParams(ap AssetParams, retention LongtermStorageRetention,
 ) Params {
 	return Params{
-		AssetParams: ap,
+		AssetParams:              ap,
+		LongtermStorageRetention: retention,
 	}
 }
 
 // DefaultParams returns default params for bep3 module
 func DefaultParams() Params {
-	return NewParams(AssetParams{})
+	return NewParams(AssetParams{}, DefaultLongtermStorageRetention)
+}
+
+// NewLongtermStorageRetention returns a new LongtermStorageRetention
+func NewLongtermStorageRetention(unit string, value int64) LongtermStorageRetention {
+	return LongtermStorageRetention{
+		Unit:  unit,
+		Value: value,
+	}
+}
+
+// String implements fmt.Stringer
+func (r LongtermStorageRetention) String() string {
+	return fmt.Sprintf("%d %s", r.Value, r.Unit)
 }
 
 // NewAssetParam returns a new AssetParam
@@ -83,9 +109,18 @@ func (ap AssetParam) String() string {
 	Min Swap Amount: %s
 	Max Swap Amount: %s
 	Swap Time in Seconds: %d
-	Time Span in Minutes: %d`,
+	Time Span in Minutes: %d
+	Max Deputy Inactivity in Blocks: %d
+	Deputy Bond: %s
+	Deputy Unbonding Period in Seconds: %d
+	Deputy Slash Amount: %s
+	Slash on Refund: %t
+	Verify Incoming: %t
+	IBC Channel: %s`,
 		ap.Denom, ap.CoinID, ap.SupplyLimit, ap.Active, ap.DeputyAddress, ap.FixedFee,
-		ap.MinSwapAmount, ap.MaxSwapAmount, ap.SwapTimestamp, ap.SwapTimeSpanMin)
+		ap.MinSwapAmount, ap.MaxSwapAmount, ap.SwapTimestamp, ap.SwapTimeSpanMin, ap.MaxDeputyInactivity,
+		ap.DeputyBond, ap.DeputyUnbondingPeriod, ap.DeputySlashAmount, ap.SlashOnRefund, ap.VerifyIncoming,
+		ap.IBCChannel)
 }
 
 // AssetParams array of AssetParam
@@ -124,12 +159,31 @@ func ParamKeyTable() paramtypes.KeyTable {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyAssetParams, &p.AssetParams, validateAssetParams),
+		paramtypes.NewParamSetPair(KeyLongtermStorageRetention, &p.LongtermStorageRetention, validateLongtermStorageRetention),
 	}
 }
 
 // Validate ensure that params have valid values
 func (p Params) Validate() error {
-	return validateAssetParams(p.AssetParams)
+	if err := validateAssetParams(p.AssetParams); err != nil {
+		return err
+	}
+	return validateLongtermStorageRetention(p.LongtermStorageRetention)
+}
+
+func validateLongtermStorageRetention(i interface{}) error {
+	retention, ok := i.(LongtermStorageRetention)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if retention.Unit != RetentionUnitSeconds && retention.Unit != RetentionUnitBlocks {
+		return fmt.Errorf("longterm storage retention unit must be %s or %s, got %q", RetentionUnitSeconds, RetentionUnitBlocks, retention.Unit)
+	}
+	if retention.Value <= 0 {
+		return fmt.Errorf("longterm storage retention must be positive, got %d", retention.Value)
+	}
+	return nil
 }
 
 func validateAssetParams(i interface{}) error {
@@ -198,6 +252,37 @@ func validateAssetParams(i interface{}) error {
 		if asset.MinSwapAmount.GT(asset.MaxSwapAmount) {
 			return fmt.Errorf("asset %s has minimum swap amount > maximum swap amount %s > %s", asset.Denom, asset.MinSwapAmount, asset.MaxSwapAmount)
 		}
+
+		if asset.MaxDeputyInactivity < 0 {
+			return fmt.Errorf("asset %s cannot have a negative max deputy inactivity %d", asset.Denom, asset.MaxDeputyInactivity)
+		}
+
+		if !asset.DeputyBond.IsValid() {
+			return fmt.Errorf("asset %s has an invalid deputy bond %s", asset.Denom, asset.DeputyBond)
+		}
+
+		if asset.DeputyUnbondingPeriod < 0 {
+			return fmt.Errorf("asset %s cannot have a negative deputy unbonding period %d", asset.Denom, asset.DeputyUnbondingPeriod)
+		}
+
+		if !asset.DeputySlashAmount.IsValid() {
+			return fmt.Errorf("asset %s has an invalid deputy slash amount %s", asset.Denom, asset.DeputySlashAmount)
+		}
+
+		if asset.IBCChannel != "" {
+			if err := host.ChannelIdentifierValidator(asset.IBCChannel); err != nil {
+				return fmt.Errorf("asset %s has an invalid IBC channel %s: %w", asset.Denom, asset.IBCChannel, err)
+			}
+		}
+	}
+
+	// Bonds are burned when slashed, which must not touch the supply of a bep3 asset
+	for _, asset := range assetParams {
+		for _, coin := range asset.DeputyBond.Add(asset.DeputySlashAmount...) {
+			if coinDenoms[coin.Denom] {
+				return fmt.Errorf("asset %s deputy bond and slash amount cannot be in bep3 asset %s", asset.Denom, coin.Denom)
+			}
+		}
 	}
 
 	return nil
@@ -193,6 +193,105 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 			expectPass:  false,
 			expectedErr: "coin id must be a non negative",
 		},
+		{
+			name: "negative max deputy inactivity",
+			args: args{
+				assetParams: types.AssetParams{func() types.AssetParam {
+					asset := types.NewAssetParam(
+						"bnb", 714, suite.supply[0], true,
+						suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
+						types.DefaultSwapBlockTimestamp, types.DefaultSwapTimeSpanMinutes,
+					)
+					asset.MaxDeputyInactivity = -1
+					return asset
+				}()},
+			},
+			expectPass:  false,
+			expectedErr: "negative max deputy inactivity",
+		},
+		{
+			name: "deputy bond",
+			args: args{
+				assetParams: types.AssetParams{func() types.AssetParam {
+					asset := types.NewAssetParam(
+						"bnb", 714, suite.supply[0], true,
+						suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
+						types.DefaultSwapBlockTimestamp, types.DefaultSwapTimeSpanMinutes,
+					)
+					asset.DeputyBond = sdk.NewCoins(sdk.NewInt64Coin("ungm", 1000))
+					asset.DeputyUnbondingPeriod = 3600
+					asset.DeputySlashAmount = sdk.NewCoins(sdk.NewInt64Coin("ungm", 500))
+					asset.SlashOnRefund = true
+					return asset
+				}()},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "negative deputy unbonding period",
+			args: args{
+				assetParams: types.AssetParams{func() types.AssetParam {
+					asset := types.NewAssetParam(
+						"bnb", 714, suite.supply[0], true,
+						suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
+						types.DefaultSwapBlockTimestamp, types.DefaultSwapTimeSpanMinutes,
+					)
+					asset.DeputyUnbondingPeriod = -1
+					return asset
+				}()},
+			},
+			expectPass:  false,
+			expectedErr: "negative deputy unbonding period",
+		},
+		{
+			name: "ibc channel",
+			args: args{
+				assetParams: types.AssetParams{func() types.AssetParam {
+					asset := types.NewAssetParam(
+						"bnb", 714, suite.supply[0], true,
+						suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
+						types.DefaultSwapBlockTimestamp, types.DefaultSwapTimeSpanMinutes,
+					)
+					asset.IBCChannel = "channel-0"
+					return asset
+				}()},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid ibc channel",
+			args: args{
+				assetParams: types.AssetParams{func() types.AssetParam {
+					asset := types.NewAssetParam(
+						"bnb", 714, suite.supply[0], true,
+						suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
+						types.DefaultSwapBlockTimestamp, types.DefaultSwapTimeSpanMinutes,
+					)
+					asset.IBCChannel = "channel/0"
+					return asset
+				}()},
+			},
+			expectPass:  false,
+			expectedErr: "invalid IBC channel",
+		},
+		{
+			name: "deputy bond in bep3 asset",
+			args: args{
+				assetParams: types.AssetParams{func() types.AssetParam {
+					asset := types.NewAssetParam(
+						"bnb", 714, suite.supply[0], true,
+						suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
+						types.DefaultSwapBlockTimestamp, types.DefaultSwapTimeSpanMinutes,
+					)
+					asset.DeputySlashAmount = sdk.NewCoins(sdk.NewInt64Coin("bnb", 500))
+					return asset
+				}()},
+			},
+			expectPass:  false,
+			expectedErr: "cannot be in bep3 asset",
+		},
 		{
 			name: "negative asset limit",
 			args: args{
@@ -256,7 +355,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
-			params := types.NewParams(tc.args.assetParams)
+			params := types.NewParams(tc.args.assetParams, types.DefaultLongtermStorageRetention)
 			err := params.Validate()
 			if tc.expectPass {
 				suite.Require().NoError(err, tc.name)
@@ -268,6 +367,32 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 	}
 }
 
+func (suite *ParamsTestSuite) TestLongtermStorageRetentionValidation() {
+	testCases := []struct {
+		name        string
+		retention   types.LongtermStorageRetention
+		expectedErr string
+	}{
+		{"seconds", types.NewLongtermStorageRetention(types.RetentionUnitSeconds, 3600), ""},
+		{"blocks", types.NewLongtermStorageRetention(types.RetentionUnitBlocks, 100800), ""},
+		{"missing unit", types.NewLongtermStorageRetention("", 3600), "unit must be"},
+		{"unknown unit", types.NewLongtermStorageRetention("days", 7), "unit must be"},
+		{"zero value", types.NewLongtermStorageRetention(types.RetentionUnitBlocks, 0), "must be positive"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := types.NewParams(types.AssetParams{}, tc.retention).Validate()
+			if tc.expectedErr == "" {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expectedErr)
+			}
+		})
+	}
+}
+
 func TestParamsTestSuite(t *testing.T) {
 	suite.Run(t, new(ParamsTestSuite))
 }
@@ -0,0 +1,185 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeUpdateDenyList defines the type for an UpdateDenyListProposal
+	ProposalTypeUpdateDenyList = "UpdateDenyList"
+	// ProposalTypeDeputySlash defines the type for a DeputySlashProposal
+	ProposalTypeDeputySlash = "DeputySlash"
+	// ProposalTypeSetLightClientState defines the type for a SetLightClientStateProposal
+	ProposalTypeSetLightClientState = "SetLightClientState"
+)
+
+// Assert proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &UpdateDenyListProposal{}
+	_ govtypes.Content = &DeputySlashProposal{}
+	_ govtypes.Content = &SetLightClientStateProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUpdateDenyList)
+	govtypes.RegisterProposalTypeCodec(&UpdateDenyListProposal{}, "bep3/UpdateDenyListProposal")
+	govtypes.RegisterProposalType(ProposalTypeDeputySlash)
+	govtypes.RegisterProposalTypeCodec(&DeputySlashProposal{}, "bep3/DeputySlashProposal")
+	govtypes.RegisterProposalType(ProposalTypeSetLightClientState)
+	govtypes.RegisterProposalTypeCodec(&SetLightClientStateProposal{}, "bep3/SetLightClientStateProposal")
+}
+
+// NewUpdateDenyListProposal creates a new deny list update proposal.
+func NewUpdateDenyListProposal(title, description string, add, remove []string) *UpdateDenyListProposal {
+	return &UpdateDenyListProposal{
+		Title:       title,
+		Description: description,
+		Add:         add,
+		Remove:      remove,
+	}
+}
+
+// GetTitle returns the title of a deny list proposal.
+func (p *UpdateDenyListProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a deny list proposal.
+func (p *UpdateDenyListProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a deny list proposal.
+func (p *UpdateDenyListProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a deny list proposal.
+func (p *UpdateDenyListProposal) ProposalType() string { return ProposalTypeUpdateDenyList }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *UpdateDenyListProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if len(p.Add) == 0 && len(p.Remove) == 0 {
+		return sdkerrors.Wrap(ErrInvalidDenyListProposal, "no addresses to add or remove")
+	}
+
+	seen := map[string]bool{}
+	for _, address := range append(append([]string{}, p.Add...), p.Remove...) {
+		if err := ValidateDenyListAddress(address); err != nil {
+			return sdkerrors.Wrap(ErrInvalidDenyListProposal, err.Error())
+		}
+		normalized := NormalizeDenyListAddress(address)
+		if seen[normalized] {
+			return sdkerrors.Wrapf(ErrInvalidDenyListProposal, "duplicate address %s", address)
+		}
+		seen[normalized] = true
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p UpdateDenyListProposal) String() string {
+	return fmt.Sprintf(`Update Deny List Proposal:
+  Title:       %s
+  Description: %s
+  Add:         %s
+  Remove:      %s
+`, p.Title, p.Description, strings.Join(p.Add, ", "), strings.Join(p.Remove, ", "))
+}
+
+// NewDeputySlashProposal creates a new deputy slash proposal.
+func NewDeputySlashProposal(title, description string, swapID []byte) *DeputySlashProposal {
+	return &DeputySlashProposal{
+		Title:       title,
+		Description: description,
+		SwapID:      swapID,
+	}
+}
+
+// GetTitle returns the title of a deputy slash proposal.
+func (p *DeputySlashProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a deputy slash proposal.
+func (p *DeputySlashProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a deputy slash proposal.
+func (p *DeputySlashProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a deputy slash proposal.
+func (p *DeputySlashProposal) ProposalType() string { return ProposalTypeDeputySlash }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *DeputySlashProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if len(p.SwapID) != SwapIDLength {
+		return sdkerrors.Wrapf(ErrInvalidDeputySlash, "the length of swapID should be %d", SwapIDLength)
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p DeputySlashProposal) String() string {
+	return fmt.Sprintf(`Deputy Slash Proposal:
+  Title:       %s
+  Description: %s
+  Swap ID:     %s
+`, p.Title, p.Description, p.SwapID)
+}
+
+// NewSetLightClientStateProposal creates a new light client state proposal.
+func NewSetLightClientStateProposal(title, description string, state LightClientState) *SetLightClientStateProposal {
+	return &SetLightClientStateProposal{
+		Title:       title,
+		Description: description,
+		State:       state,
+	}
+}
+
+// GetTitle returns the title of a light client state proposal.
+func (p *SetLightClientStateProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a light client state proposal.
+func (p *SetLightClientStateProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a light client state proposal.
+func (p *SetLightClientStateProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a light client state proposal.
+func (p *SetLightClientStateProposal) ProposalType() string { return ProposalTypeSetLightClientState }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *SetLightClientStateProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := p.State.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidLightClientState, err.Error())
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p SetLightClientStateProposal) String() string {
+	return fmt.Sprintf(`Set Light Client State Proposal:
+  Title:       %s
+  Description: %s
+  Denom:       %s
+  Chain ID:    %s
+  Height:      %d
+`, p.Title, p.Description, p.State.Denom, p.State.ChainID, p.State.Height)
+}
+
+// ValidateDenyListAddress checks that a deny list entry is a usable local or other-chain address.
+func ValidateDenyListAddress(address string) error {
+	normalized := NormalizeDenyListAddress(address)
+	if normalized == "" {
+		return fmt.Errorf("deny list address cannot be blank")
+	}
+	if len(normalized) > MaxOtherChainAddrLength {
+		return fmt.Errorf("deny list address %s exceeds max length %d", address, MaxOtherChainAddrLength)
+	}
+	return nil
+}
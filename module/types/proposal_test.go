@@ -0,0 +1,85 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/e-money/bep3/module/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateDenyListProposal(t *testing.T) {
+	tests := []struct {
+		description string
+		title       string
+		add         []string
+		remove      []string
+		expectPass  bool
+	}{
+		{"add and remove", "title", []string{kavaAddrs[0].String()}, []string{"bnb1uky3me9ggqypmrsvxk7ur6hqkzq7zmv4ed4ng7"}, true},
+		{"add only", "title", []string{"bnb1uky3me9ggqypmrsvxk7ur6hqkzq7zmv4ed4ng7"}, nil, true},
+		{"missing title", "", []string{kavaAddrs[0].String()}, nil, false},
+		{"no addresses", "title", nil, nil, false},
+		{"blank address", "title", []string{" "}, nil, false},
+		{"address too long", "title", []string{string(make([]byte, types.MaxOtherChainAddrLength+1))}, nil, false},
+		{"added and removed", "title", []string{"bnb1uky3me9ggqypmrsvxk7ur6hqkzq7zmv4ed4ng7"}, []string{"BNB1UKY3ME9GGQYPMRSVXK7UR6HQKZQ7ZMV4ED4NG7"}, false},
+	}
+
+	for _, tc := range tests {
+		proposal := types.NewUpdateDenyListProposal(tc.title, "description", tc.add, tc.remove)
+		if tc.expectPass {
+			require.NoError(t, proposal.ValidateBasic(), tc.description)
+		} else {
+			require.Error(t, proposal.ValidateBasic(), tc.description)
+		}
+	}
+}
+
+func TestDeputySlashProposal(t *testing.T) {
+	swapID := types.CalculateSwapID(randomNumberHash, binanceAddrs[0], "")
+
+	tests := []struct {
+		description string
+		title       string
+		swapID      []byte
+		expectPass  bool
+	}{
+		{"normal", "title", swapID, true},
+		{"missing title", "", swapID, false},
+		{"invalid swap id", "title", swapID[:31], false},
+	}
+
+	for _, tc := range tests {
+		proposal := types.NewDeputySlashProposal(tc.title, "description", tc.swapID)
+		if tc.expectPass {
+			require.NoError(t, proposal.ValidateBasic(), tc.description)
+		} else {
+			require.Error(t, proposal.ValidateBasic(), tc.description)
+		}
+	}
+}
+
+func TestSetLightClientStateProposal(t *testing.T) {
+	state := types.NewLightClientState("bnb", "Binance-Chain-Tigris", 100, []byte{1}, nil)
+	noRoot := state
+	noRoot.Root = nil
+
+	tests := []struct {
+		description string
+		title       string
+		state       types.LightClientState
+		expectPass  bool
+	}{
+		{"normal", "title", state, true},
+		{"missing title", "", state, false},
+		{"invalid state", "title", noRoot, false},
+	}
+
+	for _, tc := range tests {
+		proposal := types.NewSetLightClientStateProposal(tc.title, "description", tc.state)
+		if tc.expectPass {
+			require.NoError(t, proposal.ValidateBasic(), tc.description)
+		} else {
+			require.Error(t, proposal.ValidateBasic(), tc.description)
+		}
+	}
+}
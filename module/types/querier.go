@@ -16,6 +16,8 @@ const (
 	QueryGetAtomicSwaps = "swaps"
 	// QueryGetParams command for getting module params
 	QueryGetParams = "parameters"
+	// QueryGetDenyList command for getting the addresses on the deny list
+	QueryGetDenyList = "deny-list"
 )
 
 // NewQueryAssetSupply creates a new QueryAssetSupply
@@ -6,10 +6,12 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	github_com_tendermint_tendermint_libs_bytes "github.com/tendermint/tendermint/libs/bytes"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -18,12 +20,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -383,23 +387,23 @@ func (m *QuerySwapsResponse) GetSwaps() AugmentedAtomicSwaps {
 	return AugmentedAtomicSwaps{}
 }
 
-// QueryAssetSupply contains the params for query 'custom/bep3/supply'
-type QueryAssetSupply struct {
-	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+// gRPC swaps by random number hash req
+type QuerySwapsByRandomNumberHashRequest struct {
+	RandomNumberHash github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=random_number_hash,json=randomNumberHash,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"random_number_hash,omitempty" yaml:"random_number_hash"`
 }
 
-func (m *QueryAssetSupply) Reset()         { *m = QueryAssetSupply{} }
-func (m *QueryAssetSupply) String() string { return proto.CompactTextString(m) }
-func (*QueryAssetSupply) ProtoMessage()    {}
-func (*QueryAssetSupply) Descriptor() ([]byte, []int) {
+func (m *QuerySwapsByRandomNumberHashRequest) Reset()         { *m = QuerySwapsByRandomNumberHashRequest{} }
+func (m *QuerySwapsByRandomNumberHashRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapsByRandomNumberHashRequest) ProtoMessage()    {}
+func (*QuerySwapsByRandomNumberHashRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f793549314fa9524, []int{8}
 }
-func (m *QueryAssetSupply) XXX_Unmarshal(b []byte) error {
+func (m *QuerySwapsByRandomNumberHashRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAssetSupply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySwapsByRandomNumberHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAssetSupply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySwapsByRandomNumberHashRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -409,42 +413,42 @@ func (m *QueryAssetSupply) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *QueryAssetSupply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAssetSupply.Merge(m, src)
+func (m *QuerySwapsByRandomNumberHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapsByRandomNumberHashRequest.Merge(m, src)
 }
-func (m *QueryAssetSupply) XXX_Size() int {
+func (m *QuerySwapsByRandomNumberHashRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAssetSupply) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAssetSupply.DiscardUnknown(m)
+func (m *QuerySwapsByRandomNumberHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapsByRandomNumberHashRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAssetSupply proto.InternalMessageInfo
+var xxx_messageInfo_QuerySwapsByRandomNumberHashRequest proto.InternalMessageInfo
 
-func (m *QueryAssetSupply) GetDenom() string {
+func (m *QuerySwapsByRandomNumberHashRequest) GetRandomNumberHash() github_com_tendermint_tendermint_libs_bytes.HexBytes {
 	if m != nil {
-		return m.Denom
+		return m.RandomNumberHash
 	}
-	return ""
+	return nil
 }
 
-// QueryAtomicSwapByID contains the params for query 'custom/bep3/swap'
-type QueryAtomicSwapByID struct {
-	SwapID github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=swap_id,json=swapId,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"swap_id,omitempty" yaml:"swap_id"`
+// gRPC swaps by random number hash response
+type QuerySwapsByRandomNumberHashResponse struct {
+	Swaps AugmentedAtomicSwaps `protobuf:"bytes,1,opt,name=swaps,proto3" json:"swaps" yaml:"swaps"`
 }
 
-func (m *QueryAtomicSwapByID) Reset()         { *m = QueryAtomicSwapByID{} }
-func (m *QueryAtomicSwapByID) String() string { return proto.CompactTextString(m) }
-func (*QueryAtomicSwapByID) ProtoMessage()    {}
-func (*QueryAtomicSwapByID) Descriptor() ([]byte, []int) {
+func (m *QuerySwapsByRandomNumberHashResponse) Reset()         { *m = QuerySwapsByRandomNumberHashResponse{} }
+func (m *QuerySwapsByRandomNumberHashResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapsByRandomNumberHashResponse) ProtoMessage()    {}
+func (*QuerySwapsByRandomNumberHashResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f793549314fa9524, []int{9}
 }
-func (m *QueryAtomicSwapByID) XXX_Unmarshal(b []byte) error {
+func (m *QuerySwapsByRandomNumberHashResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAtomicSwapByID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySwapsByRandomNumberHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAtomicSwapByID.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySwapsByRandomNumberHashResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -454,48 +458,41 @@ func (m *QueryAtomicSwapByID) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryAtomicSwapByID) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAtomicSwapByID.Merge(m, src)
+func (m *QuerySwapsByRandomNumberHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapsByRandomNumberHashResponse.Merge(m, src)
 }
-func (m *QueryAtomicSwapByID) XXX_Size() int {
+func (m *QuerySwapsByRandomNumberHashResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAtomicSwapByID) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAtomicSwapByID.DiscardUnknown(m)
+func (m *QuerySwapsByRandomNumberHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapsByRandomNumberHashResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAtomicSwapByID proto.InternalMessageInfo
+var xxx_messageInfo_QuerySwapsByRandomNumberHashResponse proto.InternalMessageInfo
 
-func (m *QueryAtomicSwapByID) GetSwapID() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+func (m *QuerySwapsByRandomNumberHashResponse) GetSwaps() AugmentedAtomicSwaps {
 	if m != nil {
-		return m.SwapID
+		return m.Swaps
 	}
-	return nil
+	return AugmentedAtomicSwaps{}
 }
 
-//
-//// QueryAssetSupplies contains the params for an AssetSupplies query
-//type QueryAssetSupplies struct {
-// Page  int `json:"page" yaml:"page"`
-// Limit int `json:"limit" yaml:"limit"`
-//}
-type QueryAssetSupplies struct {
-	Page  int `protobuf:"varint,1,opt,name=page,proto3,casttype=int" json:"page,omitempty" yaml:"page"`
-	Limit int `protobuf:"varint,2,opt,name=limit,proto3,casttype=int" json:"limit,omitempty" yaml:"limit"`
+// gRPC deny list req
+type QueryDenyListRequest struct {
 }
 
-func (m *QueryAssetSupplies) Reset()         { *m = QueryAssetSupplies{} }
-func (m *QueryAssetSupplies) String() string { return proto.CompactTextString(m) }
-func (*QueryAssetSupplies) ProtoMessage()    {}
-func (*QueryAssetSupplies) Descriptor() ([]byte, []int) {
+func (m *QueryDenyListRequest) Reset()         { *m = QueryDenyListRequest{} }
+func (m *QueryDenyListRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenyListRequest) ProtoMessage()    {}
+func (*QueryDenyListRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f793549314fa9524, []int{10}
 }
-func (m *QueryAssetSupplies) XXX_Unmarshal(b []byte) error {
+func (m *QueryDenyListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAssetSupplies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDenyListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAssetSupplies.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDenyListRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -505,54 +502,85 @@ func (m *QueryAssetSupplies) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryAssetSupplies) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAssetSupplies.Merge(m, src)
+func (m *QueryDenyListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenyListRequest.Merge(m, src)
 }
-func (m *QueryAssetSupplies) XXX_Size() int {
+func (m *QueryDenyListRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAssetSupplies) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAssetSupplies.DiscardUnknown(m)
+func (m *QueryDenyListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenyListRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAssetSupplies proto.InternalMessageInfo
+var xxx_messageInfo_QueryDenyListRequest proto.InternalMessageInfo
 
-func (m *QueryAssetSupplies) GetPage() int {
-	if m != nil {
-		return m.Page
+// gRPC deny list response
+type QueryDenyListResponse struct {
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty" yaml:"addresses"`
+}
+
+func (m *QueryDenyListResponse) Reset()         { *m = QueryDenyListResponse{} }
+func (m *QueryDenyListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenyListResponse) ProtoMessage()    {}
+func (*QueryDenyListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f793549314fa9524, []int{11}
+}
+func (m *QueryDenyListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenyListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenyListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *QueryDenyListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenyListResponse.Merge(m, src)
+}
+func (m *QueryDenyListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenyListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenyListResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAssetSupplies) GetLimit() int {
+var xxx_messageInfo_QueryDenyListResponse proto.InternalMessageInfo
+
+func (m *QueryDenyListResponse) GetAddresses() []string {
 	if m != nil {
-		return m.Limit
+		return m.Addresses
 	}
-	return 0
+	return nil
 }
 
-// QueryAtomicSwaps contains the params for an AtomicSwaps query
-type QueryAtomicSwaps struct {
-	Page       int           `protobuf:"varint,1,opt,name=page,proto3,casttype=int" json:"page,omitempty" yaml:"page"`
-	Limit      int           `protobuf:"varint,2,opt,name=limit,proto3,casttype=int" json:"limit,omitempty" yaml:"limit"`
-	Involve    string        `protobuf:"bytes,3,opt,name=involve,proto3" json:"involve,omitempty" yaml:"involve"`
-	Expiration int64         `protobuf:"varint,4,opt,name=expiration,proto3" json:"expiration,omitempty" yaml:"involve"`
-	Status     SwapStatus    `protobuf:"varint,5,opt,name=status,proto3,casttype=SwapStatus" json:"status,omitempty" yaml:"status"`
-	Direction  SwapDirection `protobuf:"varint,6,opt,name=direction,proto3,casttype=SwapDirection" json:"direction,omitempty" yaml:"direction"`
+// gRPC swap stats req. An empty denom returns the stats of all assets.
+// Daily buckets are only returned when daily is set, optionally limited to
+// the days starting within [start_time, end_time] in unix seconds.
+type QueryStatsRequest struct {
+	Denom     string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Daily     bool   `protobuf:"varint,2,opt,name=daily,proto3" json:"daily,omitempty" yaml:"daily"`
+	StartTime int64  `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty" yaml:"start_time"`
+	EndTime   int64  `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty" yaml:"end_time"`
 }
 
-func (m *QueryAtomicSwaps) Reset()         { *m = QueryAtomicSwaps{} }
-func (m *QueryAtomicSwaps) String() string { return proto.CompactTextString(m) }
-func (*QueryAtomicSwaps) ProtoMessage()    {}
-func (*QueryAtomicSwaps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f793549314fa9524, []int{11}
+func (m *QueryStatsRequest) Reset()         { *m = QueryStatsRequest{} }
+func (m *QueryStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStatsRequest) ProtoMessage()    {}
+func (*QueryStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f793549314fa9524, []int{12}
 }
-func (m *QueryAtomicSwaps) XXX_Unmarshal(b []byte) error {
+func (m *QueryStatsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAtomicSwaps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAtomicSwaps.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStatsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -562,897 +590,5052 @@ func (m *QueryAtomicSwaps) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *QueryAtomicSwaps) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAtomicSwaps.Merge(m, src)
+func (m *QueryStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStatsRequest.Merge(m, src)
 }
-func (m *QueryAtomicSwaps) XXX_Size() int {
+func (m *QueryStatsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAtomicSwaps) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAtomicSwaps.DiscardUnknown(m)
+func (m *QueryStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStatsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAtomicSwaps proto.InternalMessageInfo
+var xxx_messageInfo_QueryStatsRequest proto.InternalMessageInfo
 
-func (m *QueryAtomicSwaps) GetPage() int {
+func (m *QueryStatsRequest) GetDenom() string {
 	if m != nil {
-		return m.Page
+		return m.Denom
 	}
-	return 0
+	return ""
 }
 
-func (m *QueryAtomicSwaps) GetLimit() int {
+func (m *QueryStatsRequest) GetDaily() bool {
 	if m != nil {
-		return m.Limit
+		return m.Daily
 	}
-	return 0
+	return false
 }
 
-func (m *QueryAtomicSwaps) GetInvolve() string {
+func (m *QueryStatsRequest) GetStartTime() int64 {
 	if m != nil {
-		return m.Involve
+		return m.StartTime
 	}
-	return ""
+	return 0
 }
 
-func (m *QueryAtomicSwaps) GetExpiration() int64 {
+func (m *QueryStatsRequest) GetEndTime() int64 {
 	if m != nil {
-		return m.Expiration
+		return m.EndTime
 	}
 	return 0
 }
 
-func (m *QueryAtomicSwaps) GetStatus() SwapStatus {
-	if m != nil {
-		return m.Status
+// gRPC swap stats response
+type QueryStatsResponse struct {
+	Stats      []SwapStats      `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats" yaml:"stats"`
+	DailyStats []DailySwapStats `protobuf:"bytes,2,rep,name=daily_stats,json=dailyStats,proto3" json:"daily_stats" yaml:"daily_stats"`
+}
+
+func (m *QueryStatsResponse) Reset()         { *m = QueryStatsResponse{} }
+func (m *QueryStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStatsResponse) ProtoMessage()    {}
+func (*QueryStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f793549314fa9524, []int{13}
+}
+func (m *QueryStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *QueryStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStatsResponse.Merge(m, src)
+}
+func (m *QueryStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStatsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAtomicSwaps) GetDirection() SwapDirection {
+var xxx_messageInfo_QueryStatsResponse proto.InternalMessageInfo
+
+func (m *QueryStatsResponse) GetStats() []SwapStats {
 	if m != nil {
-		return m.Direction
+		return m.Stats
 	}
-	return 0
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryAssetSupplyRequest)(nil), "bep3.QueryAssetSupplyRequest")
-	proto.RegisterType((*QueryAssetSupplyResponse)(nil), "bep3.QueryAssetSupplyResponse")
-	proto.RegisterType((*QueryAssetSuppliesRequest)(nil), "bep3.QueryAssetSuppliesRequest")
-	proto.RegisterType((*QueryAssetSuppliesResponse)(nil), "bep3.QueryAssetSuppliesResponse")
-	proto.RegisterType((*QuerySwapRequest)(nil), "bep3.QuerySwapRequest")
-	proto.RegisterType((*QuerySwapResponse)(nil), "bep3.QuerySwapResponse")
-	proto.RegisterType((*QuerySwapsRequest)(nil), "bep3.QuerySwapsRequest")
-	proto.RegisterType((*QuerySwapsResponse)(nil), "bep3.QuerySwapsResponse")
-	proto.RegisterType((*QueryAssetSupply)(nil), "bep3.QueryAssetSupply")
-	proto.RegisterType((*QueryAtomicSwapByID)(nil), "bep3.QueryAtomicSwapByID")
-	proto.RegisterType((*QueryAssetSupplies)(nil), "bep3.QueryAssetSupplies")
-	proto.RegisterType((*QueryAtomicSwaps)(nil), "bep3.QueryAtomicSwaps")
+func (m *QueryStatsResponse) GetDailyStats() []DailySwapStats {
+	if m != nil {
+		return m.DailyStats
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("bep3/query.proto", fileDescriptor_f793549314fa9524) }
-
-var fileDescriptor_f793549314fa9524 = []byte{
-	// 811 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x6f, 0xdb, 0x36,
-	0x14, 0xb6, 0xe2, 0x1f, 0x59, 0x98, 0x78, 0x71, 0x18, 0xcf, 0xd6, 0xb4, 0x4c, 0x0a, 0x88, 0x6d,
-	0x08, 0x86, 0xc5, 0x42, 0x92, 0x01, 0xc3, 0x72, 0x18, 0x16, 0x2d, 0x18, 0x92, 0x4b, 0x81, 0xca,
-	0x45, 0x0e, 0x45, 0x80, 0x42, 0xb6, 0x59, 0x95, 0x85, 0x25, 0x2a, 0x26, 0x9d, 0xc4, 0xc7, 0x16,
-	0xfd, 0x03, 0x0a, 0xb4, 0x7f, 0x54, 0x2e, 0x05, 0x02, 0xf4, 0xd2, 0x93, 0x51, 0x38, 0xfd, 0x0b,
-	0x7c, 0xec, 0xa9, 0x20, 0x45, 0x59, 0x8a, 0xed, 0xa0, 0xbd, 0xb4, 0x37, 0xf9, 0xbd, 0xef, 0x7d,
-	0xdf, 0xc7, 0xc7, 0xc7, 0x67, 0x50, 0x69, 0xe1, 0x68, 0xcf, 0x3e, 0xeb, 0xe3, 0xde, 0xa0, 0x11,
-	0xf5, 0x28, 0xa7, 0xb0, 0x20, 0x22, 0x46, 0xd5, 0xa7, 0x3e, 0x95, 0x01, 0x5b, 0x7c, 0xc5, 0x39,
-	0x63, 0xc3, 0xa7, 0xd4, 0xef, 0x62, 0xdb, 0x8b, 0x88, 0xed, 0x85, 0x21, 0xe5, 0x1e, 0x27, 0x34,
-	0x64, 0x2a, 0x6b, 0xb6, 0x29, 0x0b, 0x28, 0xb3, 0x5b, 0x1e, 0xc3, 0xf6, 0xf9, 0x4e, 0x0b, 0x73,
-	0x6f, 0xc7, 0x6e, 0x53, 0x12, 0xaa, 0x3c, 0x94, 0x5a, 0x3e, 0x0e, 0x31, 0x23, 0x49, 0xcd, 0xaa,
-	0x8c, 0xb1, 0x0b, 0x2f, 0x8a, 0x03, 0xe8, 0x00, 0xd4, 0xef, 0x0b, 0x37, 0x07, 0x8c, 0x61, 0xde,
-	0xec, 0x47, 0x51, 0x77, 0xe0, 0xe2, 0xb3, 0x3e, 0x66, 0x1c, 0xfe, 0x06, 0x8a, 0x1d, 0x1c, 0xd2,
-	0x40, 0xd7, 0x36, 0xb5, 0xad, 0x25, 0xa7, 0x32, 0x1e, 0x5a, 0x2b, 0x03, 0x2f, 0xe8, 0xee, 0x23,
-	0x19, 0x46, 0x6e, 0x9c, 0x46, 0xa7, 0x40, 0x9f, 0xa5, 0x60, 0x11, 0x0d, 0x19, 0x86, 0xff, 0x82,
-	0x12, 0x93, 0x11, 0x49, 0xb2, 0xbc, 0xbb, 0xd6, 0x10, 0x06, 0x1a, 0x19, 0xa8, 0xf3, 0xc3, 0xd5,
-	0xd0, 0xca, 0x8d, 0x87, 0x56, 0x39, 0xe6, 0x8e, 0xe1, 0xc8, 0x55, 0x75, 0xe8, 0x27, 0xf0, 0xe3,
-	0x14, 0x3b, 0xc1, 0x4c, 0x59, 0x44, 0x8f, 0x81, 0x31, 0x2f, 0xa9, 0xc4, 0x8f, 0xc0, 0x77, 0x4c,
-	0xc5, 0x94, 0xfc, 0xfa, 0xb4, 0x3c, 0xc1, 0xcc, 0xa9, 0x2b, 0x03, 0xab, 0x19, 0x03, 0x04, 0x33,
-	0xe4, 0x4e, 0xaa, 0xd1, 0x33, 0x0d, 0x54, 0xa4, 0x50, 0xf3, 0xc2, 0x8b, 0x92, 0xfe, 0x04, 0x60,
-	0x51, 0x34, 0xf2, 0x11, 0xe9, 0x48, 0xf6, 0x15, 0xe7, 0xc1, 0x68, 0x68, 0x95, 0x04, 0xe2, 0xf8,
-	0x70, 0x3c, 0xb4, 0xbe, 0x57, 0x74, 0x31, 0x04, 0x7d, 0x1c, 0x5a, 0x7f, 0xfa, 0x84, 0x3f, 0xe9,
-	0xb7, 0x1a, 0x6d, 0x1a, 0xd8, 0x1c, 0x87, 0x1d, 0xdc, 0x0b, 0x48, 0xc8, 0xb3, 0x9f, 0x5d, 0xd2,
-	0x62, 0x76, 0x6b, 0xc0, 0x31, 0x6b, 0x1c, 0xe1, 0x4b, 0x47, 0x7c, 0xb8, 0x25, 0xc1, 0x70, 0xdc,
-	0x41, 0xf7, 0xc0, 0x5a, 0xc6, 0x82, 0x3a, 0xe2, 0xdf, 0xa0, 0x20, 0xd2, 0xea, 0x78, 0x15, 0x75,
-	0x3c, 0x4e, 0x03, 0xd2, 0x16, 0x38, 0x67, 0x5d, 0x9d, 0x6d, 0x39, 0x35, 0x83, 0x5c, 0x59, 0x82,
-	0x4e, 0x32, 0x7c, 0x49, 0x43, 0xe1, 0x01, 0x28, 0x45, 0x5e, 0xcf, 0x0b, 0x92, 0x86, 0xd5, 0x62,
-	0xc6, 0xb8, 0xc9, 0x13, 0x5a, 0xe6, 0xac, 0xa5, 0x17, 0x16, 0xe3, 0x91, 0xab, 0x0a, 0xd1, 0x29,
-	0x80, 0x59, 0x5e, 0x65, 0xf4, 0x7f, 0x50, 0x14, 0xaa, 0x09, 0xaf, 0xa1, 0x9c, 0xf6, 0xfd, 0x00,
-	0x87, 0x1c, 0x77, 0xb2, 0xdc, 0x55, 0xe5, 0x79, 0x25, 0xf5, 0xcc, 0x90, 0x1b, 0x97, 0xa3, 0x7d,
-	0x75, 0x11, 0x99, 0x09, 0xfa, 0xe2, 0x41, 0x7d, 0xa1, 0x81, 0xf5, 0xa9, 0x93, 0x38, 0x83, 0xe3,
-	0xc3, 0x6f, 0x7d, 0x91, 0x54, 0x35, 0xe8, 0xd6, 0x14, 0xc2, 0xdf, 0x41, 0x21, 0xf2, 0x7c, 0x2c,
-	0x1d, 0xe4, 0x9d, 0x5a, 0x7a, 0x67, 0x22, 0x2a, 0x44, 0xf3, 0x24, 0xe4, 0xae, 0xc4, 0xc0, 0x6d,
-	0x50, 0xec, 0x92, 0x80, 0x70, 0x7d, 0x41, 0x82, 0xeb, 0xe9, 0x81, 0x65, 0x78, 0x82, 0x8e, 0x51,
-	0xe8, 0xcd, 0x42, 0xd2, 0xb4, 0xb4, 0xcb, 0x5f, 0x51, 0x0f, 0xfe, 0x01, 0x16, 0x49, 0x78, 0x4e,
-	0xbb, 0xe7, 0x58, 0xcf, 0xcb, 0x1b, 0x81, 0x69, 0x17, 0x55, 0x02, 0xb9, 0x09, 0x04, 0xee, 0x02,
-	0x80, 0x2f, 0x23, 0xd2, 0x93, 0xbb, 0x4d, 0x2f, 0x48, 0x85, 0x79, 0x05, 0x19, 0x14, 0xfc, 0x0b,
-	0x94, 0x18, 0xf7, 0x78, 0x9f, 0xe9, 0xc5, 0x4d, 0x6d, 0xab, 0xec, 0x58, 0x99, 0xfd, 0x21, 0xe3,
-	0xc2, 0x12, 0x10, 0x07, 0x6d, 0xca, 0x9f, 0xae, 0x82, 0xc3, 0xff, 0xc0, 0x52, 0x87, 0xf4, 0x70,
-	0x5b, 0x6a, 0x95, 0x64, 0xed, 0xaf, 0xe3, 0xa1, 0x55, 0x51, 0xe3, 0x92, 0xa4, 0x44, 0x79, 0x59,
-	0x94, 0x1f, 0x26, 0x11, 0x37, 0xad, 0xdb, 0x7d, 0x9d, 0x07, 0x45, 0xd9, 0x4f, 0xf8, 0x14, 0x2c,
-	0x67, 0x07, 0xf1, 0xe7, 0xec, 0x6b, 0x99, 0x59, 0xa8, 0x86, 0x79, 0x57, 0x3a, 0x7e, 0x23, 0x68,
-	0xe3, 0xf9, 0xdb, 0x0f, 0xaf, 0x16, 0x6a, 0xb0, 0x6a, 0xe3, 0xed, 0x80, 0x86, 0x78, 0x60, 0xc7,
-	0xdb, 0x3a, 0x26, 0xef, 0x81, 0xf2, 0xed, 0x89, 0xb1, 0xe6, 0xd2, 0xa5, 0xdb, 0xd1, 0xd8, 0xbc,
-	0x1b, 0xa0, 0x14, 0x4d, 0xa9, 0xa8, 0xc3, 0xda, 0x1c, 0x45, 0x21, 0xd1, 0x04, 0x05, 0xd1, 0x05,
-	0x98, 0x5d, 0x03, 0x99, 0x15, 0x68, 0xd4, 0x67, 0xe2, 0x8a, 0xd8, 0x90, 0xc4, 0x55, 0x08, 0xa7,
-	0x88, 0x05, 0xd9, 0x09, 0x28, 0xc6, 0x23, 0x38, 0x5d, 0x3d, 0x31, 0xae, 0xcf, 0x26, 0x3e, 0xcf,
-	0xeb, 0xfc, 0x73, 0x35, 0x32, 0xb5, 0xeb, 0x91, 0xa9, 0xbd, 0x1f, 0x99, 0xda, 0xcb, 0x1b, 0x33,
-	0x77, 0x7d, 0x63, 0xe6, 0xde, 0xdd, 0x98, 0xb9, 0x87, 0xbf, 0x64, 0xde, 0xeb, 0xad, 0xba, 0x80,
-	0x76, 0xfa, 0x5d, 0x6c, 0xf3, 0x41, 0x84, 0x59, 0xab, 0x24, 0xff, 0x11, 0xf7, 0x3e, 0x05, 0x00,
-	0x00, 0xff, 0xff, 0x8a, 0x11, 0xd9, 0xba, 0xa4, 0x07, 0x00, 0x00,
+// DeputyHealth describes the liveness of an asset's deputy
+type DeputyHealth struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	DeputyAddress string `protobuf:"bytes,2,opt,name=deputy_address,json=deputyAddress,proto3" json:"deputy_address,omitempty" yaml:"deputy_address"`
+	// zero if the deputy has not acted since liveness tracking started
+	LastActiveHeight int64     `protobuf:"varint,3,opt,name=last_active_height,json=lastActiveHeight,proto3" json:"last_active_height,omitempty" yaml:"last_active_height"`
+	LastActiveTime   time.Time `protobuf:"bytes,4,opt,name=last_active_time,json=lastActiveTime,proto3,stdtime" json:"last_active_time" yaml:"last_active_time"`
+	// blocks since the deputy last acted
+	InactiveBlocks      int64 `protobuf:"varint,5,opt,name=inactive_blocks,json=inactiveBlocks,proto3" json:"inactive_blocks,omitempty" yaml:"inactive_blocks"`
+	MaxDeputyInactivity int64 `protobuf:"varint,6,opt,name=max_deputy_inactivity,json=maxDeputyInactivity,proto3" json:"max_deputy_inactivity,omitempty" yaml:"max_deputy_inactivity"`
+	// whether new outgoing swaps of the asset are suspended
+	Suspended       bool  `protobuf:"varint,7,opt,name=suspended,proto3" json:"suspended,omitempty" yaml:"suspended"`
+	SuspendedHeight int64 `protobuf:"varint,8,opt,name=suspended_height,json=suspendedHeight,proto3" json:"suspended_height,omitempty" yaml:"suspended_height"`
+}
+
+func (m *DeputyHealth) Reset()         { *m = DeputyHealth{} }
+func (m *DeputyHealth) String() string { return proto.CompactTextString(m) }
+func (*DeputyHealth) ProtoMessage()    {}
+func (*DeputyHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f793549314fa9524, []int{14}
+}
+func (m *DeputyHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeputyHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeputyHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeputyHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeputyHealth.Merge(m, src)
+}
+func (m *DeputyHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeputyHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeputyHealth.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_DeputyHealth proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	AssetSupply(ctx context.Context, in *QueryAssetSupplyRequest, opts ...grpc.CallOption) (*QueryAssetSupplyResponse, error)
-	AssetSupplies(ctx context.Context, in *QueryAssetSuppliesRequest, opts ...grpc.CallOption) (*QueryAssetSuppliesResponse, error)
-	Swap(ctx context.Context, in *QuerySwapRequest, opts ...grpc.CallOption) (*QuerySwapResponse, error)
-	Swaps(ctx context.Context, in *QuerySwapsRequest, opts ...grpc.CallOption) (*QuerySwapsResponse, error)
+func (m *DeputyHealth) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *DeputyHealth) GetDeputyAddress() string {
+	if m != nil {
+		return m.DeputyAddress
+	}
+	return ""
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *DeputyHealth) GetLastActiveHeight() int64 {
+	if m != nil {
+		return m.LastActiveHeight
+	}
+	return 0
 }
 
-func (c *queryClient) AssetSupply(ctx context.Context, in *QueryAssetSupplyRequest, opts ...grpc.CallOption) (*QueryAssetSupplyResponse, error) {
-	out := new(QueryAssetSupplyResponse)
-	err := c.cc.Invoke(ctx, "/bep3.Query/AssetSupply", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DeputyHealth) GetLastActiveTime() time.Time {
+	if m != nil {
+		return m.LastActiveTime
 	}
-	return out, nil
+	return time.Time{}
 }
 
-func (c *queryClient) AssetSupplies(ctx context.Context, in *QueryAssetSuppliesRequest, opts ...grpc.CallOption) (*QueryAssetSuppliesResponse, error) {
-	out := new(QueryAssetSuppliesResponse)
-	err := c.cc.Invoke(ctx, "/bep3.Query/AssetSupplies", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DeputyHealth) GetInactiveBlocks() int64 {
+	if m != nil {
+		return m.InactiveBlocks
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Swap(ctx context.Context, in *QuerySwapRequest, opts ...grpc.CallOption) (*QuerySwapResponse, error) {
-	out := new(QuerySwapResponse)
-	err := c.cc.Invoke(ctx, "/bep3.Query/Swap", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DeputyHealth) GetMaxDeputyInactivity() int64 {
+	if m != nil {
+		return m.MaxDeputyInactivity
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Swaps(ctx context.Context, in *QuerySwapsRequest, opts ...grpc.CallOption) (*QuerySwapsResponse, error) {
-	out := new(QuerySwapsResponse)
-	err := c.cc.Invoke(ctx, "/bep3.Query/Swaps", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DeputyHealth) GetSuspended() bool {
+	if m != nil {
+		return m.Suspended
 	}
-	return out, nil
+	return false
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	AssetSupply(context.Context, *QueryAssetSupplyRequest) (*QueryAssetSupplyResponse, error)
-	AssetSupplies(context.Context, *QueryAssetSuppliesRequest) (*QueryAssetSuppliesResponse, error)
-	Swap(context.Context, *QuerySwapRequest) (*QuerySwapResponse, error)
-	Swaps(context.Context, *QuerySwapsRequest) (*QuerySwapsResponse, error)
+func (m *DeputyHealth) GetSuspendedHeight() int64 {
+	if m != nil {
+		return m.SuspendedHeight
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// gRPC deputy health req
+type QueryDeputyHealthRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 }
 
-func (*UnimplementedQueryServer) AssetSupply(ctx context.Context, req *QueryAssetSupplyRequest) (*QueryAssetSupplyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssetSupply not implemented")
+func (m *QueryDeputyHealthRequest) Reset()         { *m = QueryDeputyHealthRequest{} }
+func (m *QueryDeputyHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeputyHealthRequest) ProtoMessage()    {}
+func (*QueryDeputyHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f793549314fa9524, []int{15}
 }
-func (*UnimplementedQueryServer) AssetSupplies(ctx context.Context, req *QueryAssetSuppliesRequest) (*QueryAssetSuppliesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssetSupplies not implemented")
+func (m *QueryDeputyHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Swap(ctx context.Context, req *QuerySwapRequest) (*QuerySwapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Swap not implemented")
+func (m *QueryDeputyHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeputyHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Swaps(ctx context.Context, req *QuerySwapsRequest) (*QuerySwapsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Swaps not implemented")
+func (m *QueryDeputyHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeputyHealthRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryDeputyHealthRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_AssetSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAssetSupplyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AssetSupply(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bep3.Query/AssetSupply",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AssetSupply(ctx, req.(*QueryAssetSupplyRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryDeputyHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeputyHealthRequest.DiscardUnknown(m)
 }
 
-func _Query_AssetSupplies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAssetSuppliesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AssetSupplies(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bep3.Query/AssetSupplies",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AssetSupplies(ctx, req.(*QueryAssetSuppliesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryDeputyHealthRequest proto.InternalMessageInfo
 
-func _Query_Swap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySwapRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Swap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bep3.Query/Swap",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Swap(ctx, req.(*QuerySwapRequest))
+func (m *QueryDeputyHealthRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Swaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySwapsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Swaps(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bep3.Query/Swaps",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Swaps(ctx, req.(*QuerySwapsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// gRPC deputy health response
+type QueryDeputyHealthResponse struct {
+	Health DeputyHealth `protobuf:"bytes,1,opt,name=health,proto3" json:"health" yaml:"health"`
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "bep3.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AssetSupply",
-			Handler:    _Query_AssetSupply_Handler,
-		},
-		{
-			MethodName: "AssetSupplies",
-			Handler:    _Query_AssetSupplies_Handler,
-		},
-		{
-			MethodName: "Swap",
-			Handler:    _Query_Swap_Handler,
-		},
-		{
-			MethodName: "Swaps",
-			Handler:    _Query_Swaps_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "bep3/query.proto",
+func (m *QueryDeputyHealthResponse) Reset()         { *m = QueryDeputyHealthResponse{} }
+func (m *QueryDeputyHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeputyHealthResponse) ProtoMessage()    {}
+func (*QueryDeputyHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f793549314fa9524, []int{16}
 }
-
-func (m *QueryAssetSupplyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDeputyHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeputyHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeputyHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryAssetSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDeputyHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeputyHealthResponse.Merge(m, src)
 }
-
-func (m *QueryAssetSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryDeputyHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeputyHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeputyHealthResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAssetSupplyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDeputyHealthResponse proto.InternalMessageInfo
+
+func (m *QueryDeputyHealthResponse) GetHealth() DeputyHealth {
+	if m != nil {
+		return m.Health
 	}
-	return dAtA[:n], nil
+	return DeputyHealth{}
 }
 
-func (m *QueryAssetSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// gRPC deputies health req
+type QueryDeputiesHealthRequest struct {
 }
 
-func (m *QueryAssetSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Supply.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryDeputiesHealthRequest) Reset()         { *m = QueryDeputiesHealthRequest{} }
+func (m *QueryDeputiesHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeputiesHealthRequest) ProtoMessage()    {}
+func (*QueryDeputiesHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f793549314fa9524, []int{17}
+}
+func (m *QueryDeputiesHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeputiesHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeputiesHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAssetSuppliesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDeputiesHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeputiesHealthRequest.Merge(m, src)
 }
-
-func (m *QueryAssetSuppliesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDeputiesHealthRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryAssetSuppliesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryDeputiesHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeputiesHealthRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAssetSuppliesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_QueryDeputiesHealthRequest proto.InternalMessageInfo
 
-func (m *QueryAssetSuppliesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// gRPC deputies health response
+type QueryDeputiesHealthResponse struct {
+	Health []DeputyHealth `protobuf:"bytes,1,rep,name=health,proto3" json:"health" yaml:"health"`
 }
 
-func (m *QueryAssetSuppliesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Supplies.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryDeputiesHealthResponse) Reset()         { *m = QueryDeputiesHealthResponse{} }
+func (m *QueryDeputiesHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeputiesHealthResponse) ProtoMessage()    {}
+func (*QueryDeputiesHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f793549314fa9524, []int{18}
+}
+func (m *QueryDeputiesHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeputiesHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeputiesHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryDeputiesHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeputiesHealthResponse.Merge(m, src)
+}
+func (m *QueryDeputiesHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeputiesHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeputiesHealthResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySwapRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDeputiesHealthResponse proto.InternalMessageInfo
+
+func (m *QueryDeputiesHealthResponse) GetHealth() []DeputyHealth {
+	if m != nil {
+		return m.Health
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySwapRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// gRPC deputy bond req
+type QueryDeputyBondRequest struct {
+	DeputyAddress string `protobuf:"bytes,1,opt,name=deputy_address,json=deputyAddress,proto3" json:"deputy_address,omitempty" yaml:"deputy_address"`
 }
 
-func (m *QuerySwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SwapID) > 0 {
-		i -= len(m.SwapID)
-		copy(dAtA[i:], m.SwapID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SwapID)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryDeputyBondRequest) Reset()         { *m = QueryDeputyBondRequest{} }
+func (m *QueryDeputyBondRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeputyBondRequest) ProtoMessage()    {}
+func (*QueryDeputyBondRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f793549314fa9524, []int{19}
+}
+func (m *QueryDeputyBondRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeputyBondRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeputyBondRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDeputyBondRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeputyBondRequest.Merge(m, src)
+}
+func (m *QueryDeputyBondRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeputyBondRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeputyBondRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySwapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDeputyBondRequest proto.InternalMessageInfo
+
+func (m *QueryDeputyBondRequest) GetDeputyAddress() string {
+	if m != nil {
+		return m.DeputyAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QuerySwapResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// gRPC deputy bond response
+type QueryDeputyBondResponse struct {
+	Bond       DeputyBond        `protobuf:"bytes,1,opt,name=bond,proto3" json:"bond" yaml:"bond"`
+	Unbondings []DeputyUnbonding `protobuf:"bytes,2,rep,name=unbondings,proto3" json:"unbondings" yaml:"unbondings"`
 }
 
-func (m *QuerySwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Swap.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryDeputyBondResponse) Reset()         { *m = QueryDeputyBondResponse{} }
+func (m *QueryDeputyBondResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeputyBondResponse) ProtoMessage()    {}
+func (*QueryDeputyBondResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f793549314fa9524, []int{20}
+}
+func (m *QueryDeputyBondResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeputyBondResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeputyBondResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QuerySwapsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDeputyBondResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeputyBondResponse.Merge(m, src)
 }
-
-func (m *QuerySwapsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDeputyBondResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeputyBondResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeputyBondResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySwapsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryDeputyBondResponse proto.InternalMessageInfo
+
+func (m *QueryDeputyBondResponse) GetBond() DeputyBond {
+	if m != nil {
+		return m.Bond
 	}
-	return len(dAtA) - i, nil
+	return DeputyBond{}
 }
 
-func (m *QuerySwapsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDeputyBondResponse) GetUnbondings() []DeputyUnbonding {
+	if m != nil {
+		return m.Unbondings
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySwapsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AssetCapacity describes how much of an asset can still be swapped under its supply limits
+type AssetCapacity struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// remaining incoming capacity under both the absolute and the time-based limit
+	IncomingCapacity types.Coin `protobuf:"bytes,2,opt,name=incoming_capacity,json=incomingCapacity,proto3" json:"incoming_capacity" yaml:"incoming_capacity"`
+	// remaining incoming capacity under the absolute supply limit
+	AbsoluteIncomingCapacity types.Coin `protobuf:"bytes,3,opt,name=absolute_incoming_capacity,json=absoluteIncomingCapacity,proto3" json:"absolute_incoming_capacity" yaml:"absolute_incoming_capacity"`
+	TimeLimited              bool       `protobuf:"varint,4,opt,name=time_limited,json=timeLimited,proto3" json:"time_limited,omitempty" yaml:"time_limited"`
+	// remaining incoming capacity under the time-based supply limit in the current window, if time limited
+	TimeBasedIncomingCapacity types.Coin `protobuf:"bytes,5,opt,name=time_based_incoming_capacity,json=timeBasedIncomingCapacity,proto3" json:"time_based_incoming_capacity" yaml:"time_based_incoming_capacity"`
+	// remaining outgoing capacity, the current supply not already locked in outgoing swaps
+	OutgoingCapacity types.Coin `protobuf:"bytes,6,opt,name=outgoing_capacity,json=outgoingCapacity,proto3" json:"outgoing_capacity" yaml:"outgoing_capacity"`
+	// time of the first block in which the time-based window resets, if time limited
+	WindowResetTime time.Time `protobuf:"bytes,7,opt,name=window_reset_time,json=windowResetTime,proto3,stdtime" json:"window_reset_time" yaml:"window_reset_time"`
+	// nanoseconds until the time-based window resets, if time limited
+	TimeUntilWindowReset int64 `protobuf:"varint,8,opt,name=time_until_window_reset,json=timeUntilWindowReset,proto3" json:"time_until_window_reset,omitempty" yaml:"time_until_window_reset"`
+}
+
+func (m *AssetCapacity) Reset()         { *m = AssetCapacity{} }
+func (m *AssetCapacity) String() string { return proto.CompactTextString(m) }
+func (*AssetCapacity) ProtoMessage()    {}
+func (*AssetCapacity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f793549314fa9524, []int{21}
+}
+func (m *AssetCapacity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QuerySwapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Swaps.MarshalToSizedBuffer(dAtA[:i])
+func (m *AssetCapacity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetCapacity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *AssetCapacity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetCapacity.Merge(m, src)
+}
+func (m *AssetCapacity) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetCapacity) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetCapacity.DiscardUnknown(m)
 }
 
-func (m *QueryAssetSupply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AssetCapacity proto.InternalMessageInfo
+
+func (m *AssetCapacity) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAssetSupply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AssetCapacity) GetIncomingCapacity() types.Coin {
+	if m != nil {
+		return m.IncomingCapacity
+	}
+	return types.Coin{}
 }
 
-func (m *QueryAssetSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *AssetCapacity) GetAbsoluteIncomingCapacity() types.Coin {
+	if m != nil {
+		return m.AbsoluteIncomingCapacity
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *QueryAtomicSwapByID) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AssetCapacity) GetTimeLimited() bool {
+	if m != nil {
+		return m.TimeLimited
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryAtomicSwapByID) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AssetCapacity) GetTimeBasedIncomingCapacity() types.Coin {
+	if m != nil {
+		return m.TimeBasedIncomingCapacity
+	}
+	return types.Coin{}
 }
 
-func (m *QueryAtomicSwapByID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SwapID) > 0 {
-		i -= len(m.SwapID)
-		copy(dAtA[i:], m.SwapID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SwapID)))
-		i--
-		dAtA[i] = 0xa
+func (m *AssetCapacity) GetOutgoingCapacity() types.Coin {
+	if m != nil {
+		return m.OutgoingCapacity
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *QueryAssetSupplies) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AssetCapacity) GetWindowResetTime() time.Time {
+	if m != nil {
+		return m.WindowResetTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *QueryAssetSupplies) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AssetCapacity) GetTimeUntilWindowReset() int64 {
+	if m != nil {
+		return m.TimeUntilWindowReset
+	}
+	return 0
 }
 
-func (m *QueryAssetSupplies) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Limit != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Page != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Page))
-		i--
-		dAtA[i] = 0x8
+// gRPC asset capacity req
+type QueryAssetCapacityRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *QueryAssetCapacityRequest) Reset()         { *m = QueryAssetCapacityRequest{} }
+func (m *QueryAssetCapacityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAssetCapacityRequest) ProtoMessage()    {}
+func (*QueryAssetCapacityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f793549314fa9524, []int{22}
+}
+func (m *QueryAssetCapacityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAssetCapacityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAssetCapacityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAssetCapacityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAssetCapacityRequest.Merge(m, src)
+}
+func (m *QueryAssetCapacityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAssetCapacityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAssetCapacityRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAtomicSwaps) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAssetCapacityRequest proto.InternalMessageInfo
+
+func (m *QueryAssetCapacityRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAtomicSwaps) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// gRPC asset capacity response
+type QueryAssetCapacityResponse struct {
+	Capacity AssetCapacity `protobuf:"bytes,1,opt,name=capacity,proto3" json:"capacity" yaml:"capacity"`
 }
 
-func (m *QueryAtomicSwaps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Direction != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Direction))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Expiration != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Expiration))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Involve) > 0 {
-		i -= len(m.Involve)
-		copy(dAtA[i:], m.Involve)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Involve)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Limit != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Page != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Page))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryAssetCapacityResponse) Reset()         { *m = QueryAssetCapacityResponse{} }
+func (m *QueryAssetCapacityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAssetCapacityResponse) ProtoMessage()    {}
+func (*QueryAssetCapacityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f793549314fa9524, []int{23}
+}
+func (m *QueryAssetCapacityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAssetCapacityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAssetCapacityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAssetCapacityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAssetCapacityResponse.Merge(m, src)
+}
+func (m *QueryAssetCapacityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAssetCapacityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAssetCapacityResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryAssetCapacityResponse proto.InternalMessageInfo
+
+func (m *QueryAssetCapacityResponse) GetCapacity() AssetCapacity {
+	if m != nil {
+		return m.Capacity
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return AssetCapacity{}
 }
-func (m *QueryAssetSupplyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+// gRPC simulate create swap req
+type QuerySimulateCreateSwapRequest struct {
+	Msg *MsgCreateAtomicSwap `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty" yaml:"msg"`
 }
 
-func (m *QueryAssetSupplyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateCreateSwapRequest) Reset()         { *m = QuerySimulateCreateSwapRequest{} }
+func (m *QuerySimulateCreateSwapRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateCreateSwapRequest) ProtoMessage()    {}
+func (*QuerySimulateCreateSwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f793549314fa9524, []int{24}
+}
+func (m *QuerySimulateCreateSwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateCreateSwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateCreateSwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Supply.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QuerySimulateCreateSwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateCreateSwapRequest.Merge(m, src)
+}
+func (m *QuerySimulateCreateSwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateCreateSwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateCreateSwapRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAssetSuppliesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QuerySimulateCreateSwapRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateCreateSwapRequest) GetMsg() *MsgCreateAtomicSwap {
+	if m != nil {
+		return m.Msg
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryAssetSuppliesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Supplies.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// gRPC simulate create swap response. Error is empty if the swap would be created.
+type QuerySimulateCreateSwapResponse struct {
+	SwapID          github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=swap_id,json=swapId,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"swap_id,omitempty" yaml:"swap_id"`
+	ExpireTimestamp int64                                                `protobuf:"varint,2,opt,name=expire_timestamp,json=expireTimestamp,proto3" json:"expire_timestamp,omitempty" yaml:"expire_timestamp"`
+	Direction       SwapDirection                                        `protobuf:"varint,3,opt,name=direction,proto3,casttype=SwapDirection" json:"direction,omitempty" yaml:"direction"`
+	Fee             types.Coin                                           `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee" yaml:"fee"`
+	Error           string                                               `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty" yaml:"error"`
+	Codespace       string                                               `protobuf:"bytes,6,opt,name=codespace,proto3" json:"codespace,omitempty" yaml:"codespace"`
+	Code            uint32                                               `protobuf:"varint,7,opt,name=code,proto3" json:"code,omitempty" yaml:"code"`
 }
 
-func (m *QuerySwapRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SwapID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySimulateCreateSwapResponse) Reset()         { *m = QuerySimulateCreateSwapResponse{} }
+func (m *QuerySimulateCreateSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateCreateSwapResponse) ProtoMessage()    {}
+func (*QuerySimulateCreateSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f793549314fa9524, []int{25}
+}
+func (m *QuerySimulateCreateSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateCreateSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateCreateSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QuerySimulateCreateSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateCreateSwapResponse.Merge(m, src)
+}
+func (m *QuerySimulateCreateSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateCreateSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateCreateSwapResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySwapResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QuerySimulateCreateSwapResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateCreateSwapResponse) GetSwapID() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.SwapID
 	}
-	var l int
-	_ = l
-	l = m.Swap.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QuerySwapsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySimulateCreateSwapResponse) GetExpireTimestamp() int64 {
+	if m != nil {
+		return m.ExpireTimestamp
 	}
-	return n
+	return 0
 }
 
-func (m *QuerySwapsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateCreateSwapResponse) GetDirection() SwapDirection {
+	if m != nil {
+		return m.Direction
 	}
-	var l int
-	_ = l
-	l = m.Swaps.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryAssetSupply) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateCreateSwapResponse) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return types.Coin{}
+}
+
+func (m *QuerySimulateCreateSwapResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return n
+	return ""
 }
 
-func (m *QueryAtomicSwapByID) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateCreateSwapResponse) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
 	}
-	var l int
-	_ = l
-	l = len(m.SwapID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *QuerySimulateCreateSwapResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
 	}
-	return n
+	return 0
 }
 
-func (m *QueryAssetSupplies) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryAssetSupply contains the params for query 'custom/bep3/supply'
+type QueryAssetSupply struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *QueryAssetSupply) Reset()         { *m = QueryAssetSupply{} }
+func (m *QueryAssetSupply) String() string { return proto.CompactTextString(m) }
+func (*QueryAssetSupply) ProtoMessage()    {}
+func (*QueryAssetSupply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f793549314fa9524, []int{26}
+}
+func (m *QueryAssetSupply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAssetSupply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAssetSupply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Page != 0 {
-		n += 1 + sovQuery(uint64(m.Page))
+}
+func (m *QueryAssetSupply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAssetSupply.Merge(m, src)
+}
+func (m *QueryAssetSupply) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAssetSupply) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAssetSupply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAssetSupply proto.InternalMessageInfo
+
+func (m *QueryAssetSupply) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if m.Limit != 0 {
-		n += 1 + sovQuery(uint64(m.Limit))
+	return ""
+}
+
+// QueryAtomicSwapByID contains the params for query 'custom/bep3/swap'
+type QueryAtomicSwapByID struct {
+	SwapID github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=swap_id,json=swapId,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"swap_id,omitempty" yaml:"swap_id"`
+}
+
+func (m *QueryAtomicSwapByID) Reset()         { *m = QueryAtomicSwapByID{} }
+func (m *QueryAtomicSwapByID) String() string { return proto.CompactTextString(m) }
+func (*QueryAtomicSwapByID) ProtoMessage()    {}
+func (*QueryAtomicSwapByID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f793549314fa9524, []int{27}
+}
+func (m *QueryAtomicSwapByID) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAtomicSwapByID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAtomicSwapByID.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAtomicSwapByID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAtomicSwapByID.Merge(m, src)
+}
+func (m *QueryAtomicSwapByID) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAtomicSwapByID) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAtomicSwapByID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAtomicSwapByID proto.InternalMessageInfo
+
+func (m *QueryAtomicSwapByID) GetSwapID() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.SwapID
+	}
+	return nil
+}
+
+// // QueryAssetSupplies contains the params for an AssetSupplies query
+// type QueryAssetSupplies struct {
+// Page  int `json:"page" yaml:"page"`
+// Limit int `json:"limit" yaml:"limit"`
+// }
+type QueryAssetSupplies struct {
+	Page  int `protobuf:"varint,1,opt,name=page,proto3,casttype=int" json:"page,omitempty" yaml:"page"`
+	Limit int `protobuf:"varint,2,opt,name=limit,proto3,casttype=int" json:"limit,omitempty" yaml:"limit"`
+}
+
+func (m *QueryAssetSupplies) Reset()         { *m = QueryAssetSupplies{} }
+func (m *QueryAssetSupplies) String() string { return proto.CompactTextString(m) }
+func (*QueryAssetSupplies) ProtoMessage()    {}
+func (*QueryAssetSupplies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f793549314fa9524, []int{28}
+}
+func (m *QueryAssetSupplies) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAssetSupplies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAssetSupplies.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAssetSupplies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAssetSupplies.Merge(m, src)
+}
+func (m *QueryAssetSupplies) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAssetSupplies) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAssetSupplies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAssetSupplies proto.InternalMessageInfo
+
+func (m *QueryAssetSupplies) GetPage() int {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *QueryAssetSupplies) GetLimit() int {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// QueryAtomicSwaps contains the params for an AtomicSwaps query
+type QueryAtomicSwaps struct {
+	Page       int           `protobuf:"varint,1,opt,name=page,proto3,casttype=int" json:"page,omitempty" yaml:"page"`
+	Limit      int           `protobuf:"varint,2,opt,name=limit,proto3,casttype=int" json:"limit,omitempty" yaml:"limit"`
+	Involve    string        `protobuf:"bytes,3,opt,name=involve,proto3" json:"involve,omitempty" yaml:"involve"`
+	Expiration int64         `protobuf:"varint,4,opt,name=expiration,proto3" json:"expiration,omitempty" yaml:"involve"`
+	Status     SwapStatus    `protobuf:"varint,5,opt,name=status,proto3,casttype=SwapStatus" json:"status,omitempty" yaml:"status"`
+	Direction  SwapDirection `protobuf:"varint,6,opt,name=direction,proto3,casttype=SwapDirection" json:"direction,omitempty" yaml:"direction"`
+}
+
+func (m *QueryAtomicSwaps) Reset()         { *m = QueryAtomicSwaps{} }
+func (m *QueryAtomicSwaps) String() string { return proto.CompactTextString(m) }
+func (*QueryAtomicSwaps) ProtoMessage()    {}
+func (*QueryAtomicSwaps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f793549314fa9524, []int{29}
+}
+func (m *QueryAtomicSwaps) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAtomicSwaps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAtomicSwaps.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAtomicSwaps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAtomicSwaps.Merge(m, src)
+}
+func (m *QueryAtomicSwaps) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAtomicSwaps) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAtomicSwaps.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAtomicSwaps proto.InternalMessageInfo
+
+func (m *QueryAtomicSwaps) GetPage() int {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *QueryAtomicSwaps) GetLimit() int {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *QueryAtomicSwaps) GetInvolve() string {
+	if m != nil {
+		return m.Involve
+	}
+	return ""
+}
+
+func (m *QueryAtomicSwaps) GetExpiration() int64 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+func (m *QueryAtomicSwaps) GetStatus() SwapStatus {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *QueryAtomicSwaps) GetDirection() SwapDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryAssetSupplyRequest)(nil), "bep3.QueryAssetSupplyRequest")
+	proto.RegisterType((*QueryAssetSupplyResponse)(nil), "bep3.QueryAssetSupplyResponse")
+	proto.RegisterType((*QueryAssetSuppliesRequest)(nil), "bep3.QueryAssetSuppliesRequest")
+	proto.RegisterType((*QueryAssetSuppliesResponse)(nil), "bep3.QueryAssetSuppliesResponse")
+	proto.RegisterType((*QuerySwapRequest)(nil), "bep3.QuerySwapRequest")
+	proto.RegisterType((*QuerySwapResponse)(nil), "bep3.QuerySwapResponse")
+	proto.RegisterType((*QuerySwapsRequest)(nil), "bep3.QuerySwapsRequest")
+	proto.RegisterType((*QuerySwapsResponse)(nil), "bep3.QuerySwapsResponse")
+	proto.RegisterType((*QuerySwapsByRandomNumberHashRequest)(nil), "bep3.QuerySwapsByRandomNumberHashRequest")
+	proto.RegisterType((*QuerySwapsByRandomNumberHashResponse)(nil), "bep3.QuerySwapsByRandomNumberHashResponse")
+	proto.RegisterType((*QueryDenyListRequest)(nil), "bep3.QueryDenyListRequest")
+	proto.RegisterType((*QueryDenyListResponse)(nil), "bep3.QueryDenyListResponse")
+	proto.RegisterType((*QueryStatsRequest)(nil), "bep3.QueryStatsRequest")
+	proto.RegisterType((*QueryStatsResponse)(nil), "bep3.QueryStatsResponse")
+	proto.RegisterType((*DeputyHealth)(nil), "bep3.DeputyHealth")
+	proto.RegisterType((*QueryDeputyHealthRequest)(nil), "bep3.QueryDeputyHealthRequest")
+	proto.RegisterType((*QueryDeputyHealthResponse)(nil), "bep3.QueryDeputyHealthResponse")
+	proto.RegisterType((*QueryDeputiesHealthRequest)(nil), "bep3.QueryDeputiesHealthRequest")
+	proto.RegisterType((*QueryDeputiesHealthResponse)(nil), "bep3.QueryDeputiesHealthResponse")
+	proto.RegisterType((*QueryDeputyBondRequest)(nil), "bep3.QueryDeputyBondRequest")
+	proto.RegisterType((*QueryDeputyBondResponse)(nil), "bep3.QueryDeputyBondResponse")
+	proto.RegisterType((*AssetCapacity)(nil), "bep3.AssetCapacity")
+	proto.RegisterType((*QueryAssetCapacityRequest)(nil), "bep3.QueryAssetCapacityRequest")
+	proto.RegisterType((*QueryAssetCapacityResponse)(nil), "bep3.QueryAssetCapacityResponse")
+	proto.RegisterType((*QuerySimulateCreateSwapRequest)(nil), "bep3.QuerySimulateCreateSwapRequest")
+	proto.RegisterType((*QuerySimulateCreateSwapResponse)(nil), "bep3.QuerySimulateCreateSwapResponse")
+	proto.RegisterType((*QueryAssetSupply)(nil), "bep3.QueryAssetSupply")
+	proto.RegisterType((*QueryAtomicSwapByID)(nil), "bep3.QueryAtomicSwapByID")
+	proto.RegisterType((*QueryAssetSupplies)(nil), "bep3.QueryAssetSupplies")
+	proto.RegisterType((*QueryAtomicSwaps)(nil), "bep3.QueryAtomicSwaps")
+}
+
+func init() { proto.RegisterFile("bep3/query.proto", fileDescriptor_f793549314fa9524) }
+
+var fileDescriptor_f793549314fa9524 = []byte{
+	// 2108 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4b, 0x6f, 0x1b, 0xd7,
+	0xf5, 0xf7, 0x98, 0x22, 0x25, 0x1d, 0xbd, 0xa8, 0xab, 0x07, 0xa9, 0x91, 0xcc, 0x51, 0xae, 0xec,
+	0xfc, 0xfd, 0x88, 0x49, 0x58, 0x09, 0xf0, 0x47, 0x1d, 0xa0, 0x8d, 0x46, 0x42, 0x20, 0x21, 0x69,
+	0xd0, 0x5c, 0xdb, 0x09, 0x1a, 0xa4, 0x9d, 0x0e, 0x39, 0xd7, 0xe4, 0xb4, 0x9c, 0x19, 0x86, 0x77,
+	0x68, 0x9b, 0x4d, 0xb3, 0x48, 0x5a, 0xa0, 0x5b, 0x03, 0xfd, 0x00, 0x5d, 0xb4, 0xe8, 0xa6, 0x1f,
+	0xa4, 0xe9, 0xa2, 0x40, 0x80, 0x6e, 0xba, 0x62, 0x0a, 0xbb, 0x9f, 0x80, 0xcb, 0xae, 0x8a, 0xfb,
+	0x98, 0x07, 0x67, 0x48, 0x5b, 0x32, 0xda, 0xac, 0xc8, 0x39, 0xe7, 0x77, 0x7e, 0xe7, 0x71, 0x9f,
+	0xe7, 0x42, 0xb9, 0x49, 0x7b, 0x6f, 0x36, 0x3e, 0x1b, 0xd0, 0xfe, 0xb0, 0xde, 0xeb, 0x07, 0x61,
+	0x80, 0xe6, 0xb8, 0x44, 0xdf, 0x6c, 0x07, 0xed, 0x40, 0x08, 0x1a, 0xfc, 0x9f, 0xd4, 0xe9, 0x7b,
+	0xed, 0x20, 0x68, 0x77, 0x69, 0xc3, 0xee, 0xb9, 0x0d, 0xdb, 0xf7, 0x83, 0xd0, 0x0e, 0xdd, 0xc0,
+	0x67, 0x4a, 0x6b, 0x28, 0xad, 0xf8, 0x6a, 0x0e, 0x1e, 0x36, 0x42, 0xd7, 0xa3, 0x2c, 0xb4, 0xbd,
+	0x9e, 0x02, 0xd4, 0x5a, 0x01, 0xf3, 0x02, 0xd6, 0x68, 0xda, 0x8c, 0x36, 0x1e, 0xdd, 0x69, 0xd2,
+	0xd0, 0xbe, 0xd3, 0x68, 0x05, 0xae, 0xaf, 0xf4, 0x48, 0x04, 0xd3, 0xa6, 0x3e, 0x65, 0x6e, 0x44,
+	0xba, 0x26, 0x64, 0xec, 0xb1, 0xad, 0x48, 0xf0, 0x11, 0x54, 0x3e, 0xe4, 0xe1, 0x1e, 0x31, 0x46,
+	0xc3, 0x7b, 0x83, 0x5e, 0xaf, 0x3b, 0x24, 0xf4, 0xb3, 0x01, 0x65, 0x21, 0x7a, 0x1d, 0x8a, 0x0e,
+	0xf5, 0x03, 0xaf, 0xaa, 0xed, 0x6b, 0xd7, 0x17, 0xcd, 0xf2, 0x78, 0x64, 0x2c, 0x0f, 0x6d, 0xaf,
+	0x7b, 0x17, 0x0b, 0x31, 0x26, 0x52, 0x8d, 0x3f, 0x85, 0x6a, 0x9e, 0x82, 0xf5, 0x02, 0x9f, 0x51,
+	0xf4, 0x0e, 0x94, 0x98, 0x90, 0x08, 0x92, 0xa5, 0xc3, 0xf5, 0x3a, 0x0f, 0xa0, 0x9e, 0x82, 0x9a,
+	0x5b, 0x5f, 0x8f, 0x8c, 0x4b, 0xe3, 0x91, 0xb1, 0x22, 0xb9, 0x25, 0x1c, 0x13, 0x65, 0x87, 0x77,
+	0x61, 0x27, 0xc3, 0xee, 0x52, 0xa6, 0x42, 0xc4, 0x0f, 0x41, 0x9f, 0xa6, 0x54, 0xce, 0x4f, 0x61,
+	0x81, 0x29, 0x99, 0x72, 0xbf, 0x91, 0x75, 0xef, 0x52, 0x66, 0x56, 0x54, 0x00, 0x6b, 0xa9, 0x00,
+	0x5c, 0xca, 0x30, 0x89, 0xad, 0xf1, 0x97, 0x1a, 0x94, 0x85, 0xa3, 0x7b, 0x8f, 0xed, 0x5e, 0x54,
+	0x1f, 0x0f, 0xe6, 0x79, 0x21, 0x2d, 0xd7, 0x11, 0xec, 0xcb, 0xe6, 0xfd, 0x67, 0x23, 0xa3, 0xc4,
+	0x11, 0x67, 0x27, 0xe3, 0x91, 0xb1, 0xaa, 0xe8, 0x24, 0x04, 0xff, 0x7b, 0x64, 0xbc, 0xd5, 0x76,
+	0xc3, 0xce, 0xa0, 0x59, 0x6f, 0x05, 0x5e, 0x23, 0xa4, 0xbe, 0x43, 0xfb, 0x9e, 0xeb, 0x87, 0xe9,
+	0xbf, 0x5d, 0xb7, 0xc9, 0x1a, 0xcd, 0x61, 0x48, 0x59, 0xfd, 0x94, 0x3e, 0x31, 0xf9, 0x1f, 0x52,
+	0xe2, 0x0c, 0x67, 0x0e, 0xfe, 0x00, 0xd6, 0x53, 0x21, 0xa8, 0x14, 0xbf, 0x07, 0x73, 0x5c, 0xad,
+	0xd2, 0x2b, 0xab, 0xf4, 0xc2, 0xc0, 0x73, 0x5b, 0x1c, 0x67, 0x6e, 0xa8, 0xdc, 0x96, 0x92, 0x60,
+	0x30, 0x11, 0x26, 0xf8, 0xa3, 0x14, 0x5f, 0x54, 0x50, 0x74, 0x04, 0xa5, 0x9e, 0xdd, 0xb7, 0xbd,
+	0xa8, 0x60, 0xdb, 0x92, 0x51, 0x16, 0x39, 0xa6, 0x65, 0xe6, 0x7a, 0x32, 0x60, 0x12, 0x8f, 0x89,
+	0x32, 0xc4, 0x9f, 0x02, 0x4a, 0xf3, 0xaa, 0x40, 0xdf, 0x85, 0x22, 0xf7, 0x1a, 0xf1, 0xea, 0x2a,
+	0xd2, 0x41, 0xdb, 0xa3, 0x7e, 0x48, 0x9d, 0x34, 0xf7, 0xa6, 0x8a, 0x79, 0x39, 0x89, 0x99, 0x61,
+	0x22, 0xcd, 0xf1, 0x9f, 0x35, 0x38, 0x48, 0xe8, 0xcd, 0x21, 0xb1, 0x7d, 0x27, 0xf0, 0x3e, 0x18,
+	0x78, 0x4d, 0xda, 0x3f, 0xb5, 0x59, 0x27, 0x4a, 0xe4, 0xd7, 0x1a, 0xa0, 0xbe, 0xd0, 0x59, 0xbe,
+	0x50, 0x5a, 0x1d, 0x9b, 0x75, 0xd4, 0x40, 0x3d, 0x18, 0x8f, 0x8c, 0x1d, 0xc9, 0x9e, 0xc7, 0xbc,
+	0xfa, 0x48, 0x95, 0xfb, 0x99, 0x60, 0xb0, 0x0f, 0x57, 0x5f, 0x1c, 0xec, 0x7f, 0xb9, 0x3a, 0xdb,
+	0xb0, 0x29, 0xfc, 0x9d, 0x50, 0x7f, 0xf8, 0xbe, 0xcb, 0xc2, 0x68, 0x9d, 0xbc, 0x07, 0x5b, 0x19,
+	0xb9, 0x72, 0x7c, 0x08, 0x8b, 0xb6, 0xe3, 0xf4, 0x29, 0x63, 0x62, 0x8d, 0x14, 0xae, 0x2f, 0x9a,
+	0x9b, 0xe3, 0x91, 0x51, 0x96, 0xe4, 0xb1, 0x0a, 0x93, 0x04, 0x86, 0xff, 0xaa, 0x45, 0x33, 0x27,
+	0xb4, 0x43, 0x76, 0xc1, 0xdd, 0x42, 0xe0, 0x6c, 0xb7, 0x3b, 0xac, 0x5e, 0xde, 0xd7, 0xae, 0x2f,
+	0x4c, 0xe0, 0xb8, 0x98, 0xe3, 0xf8, 0x2f, 0x7a, 0x0b, 0x80, 0x85, 0x76, 0x3f, 0xb4, 0xf8, 0xb6,
+	0x57, 0x2d, 0xec, 0x6b, 0xd7, 0x0b, 0xe6, 0xd6, 0x78, 0x64, 0xac, 0xab, 0xbc, 0x63, 0x1d, 0x26,
+	0x8b, 0xe2, 0xe3, 0xbe, 0xeb, 0x51, 0x54, 0x87, 0x05, 0xea, 0x3b, 0xd2, 0x66, 0x4e, 0xd8, 0x6c,
+	0x24, 0x2b, 0x3b, 0xd2, 0x60, 0x32, 0x4f, 0x7d, 0x87, 0xe3, 0xf1, 0x1f, 0x35, 0x40, 0xe9, 0x5c,
+	0x54, 0x59, 0xde, 0x86, 0x22, 0xe3, 0x02, 0x51, 0x92, 0xa5, 0xc3, 0x35, 0x39, 0x1e, 0x7c, 0x00,
+	0x04, 0x2e, 0x37, 0x08, 0x5c, 0xc8, 0x07, 0x81, 0xff, 0xa2, 0x0f, 0x61, 0x49, 0xa4, 0x60, 0x49,
+	0x8a, 0xcb, 0x82, 0x62, 0x53, 0x52, 0x9c, 0x70, 0x45, 0xc2, 0xa3, 0x2b, 0x1e, 0x94, 0xaa, 0x80,
+	0xa5, 0xd8, 0x40, 0x7c, 0x09, 0x1c, 0xfe, 0xcb, 0x1c, 0x2c, 0x9f, 0xd0, 0xde, 0x20, 0x1c, 0x9e,
+	0x52, 0xbb, 0x1b, 0x76, 0xce, 0x5d, 0xed, 0x77, 0x60, 0xd5, 0x11, 0x76, 0x96, 0x1a, 0x3f, 0x51,
+	0xf6, 0x45, 0x73, 0x67, 0x3c, 0x32, 0xb6, 0x22, 0x83, 0xb4, 0x1e, 0x93, 0x15, 0x29, 0x38, 0x92,
+	0xdf, 0xe8, 0x3d, 0x40, 0x5d, 0x9b, 0x85, 0x96, 0xdd, 0x0a, 0xdd, 0x47, 0xd4, 0xea, 0x50, 0xb7,
+	0xdd, 0x09, 0xd5, 0x78, 0x5c, 0x49, 0xd6, 0x51, 0x1e, 0x83, 0x49, 0x99, 0x0b, 0x8f, 0x84, 0xec,
+	0x54, 0x88, 0x90, 0x0b, 0xe5, 0x34, 0x30, 0x1e, 0x26, 0x3e, 0xe5, 0xe5, 0x71, 0x57, 0x8f, 0x8e,
+	0xbb, 0xfa, 0xfd, 0xe8, 0xb8, 0x33, 0x0f, 0x54, 0x95, 0x2a, 0x79, 0x57, 0x62, 0x38, 0x9f, 0x7e,
+	0x6b, 0x68, 0x64, 0x35, 0x71, 0x26, 0x66, 0xc2, 0x31, 0xac, 0xb9, 0xbe, 0x42, 0x35, 0xbb, 0x41,
+	0xeb, 0x17, 0xac, 0x5a, 0x14, 0x41, 0xeb, 0xe3, 0x91, 0xb1, 0x2d, 0x99, 0x32, 0x00, 0x4c, 0x56,
+	0x23, 0x89, 0x29, 0x04, 0xe8, 0x3e, 0x6c, 0x79, 0xf6, 0x13, 0x4b, 0x95, 0x48, 0x29, 0xdd, 0x70,
+	0x58, 0x2d, 0x09, 0xaa, 0xfd, 0xf1, 0xc8, 0xd8, 0x93, 0x54, 0x53, 0x61, 0x98, 0x6c, 0x78, 0xf6,
+	0x13, 0x39, 0x70, 0x67, 0xb1, 0x94, 0x2f, 0x3a, 0x36, 0x60, 0x3d, 0xbe, 0x99, 0x38, 0xd5, 0x79,
+	0xb1, 0x0c, 0x52, 0x8b, 0x2e, 0x56, 0xf1, 0x89, 0x1d, 0xfd, 0x47, 0xef, 0x42, 0x39, 0xfe, 0x88,
+	0x06, 0x61, 0x41, 0x04, 0xb1, 0x9b, 0x54, 0x26, 0x8b, 0xc0, 0x64, 0x2d, 0x16, 0xc9, 0x11, 0xc0,
+	0xa6, 0x3a, 0xac, 0xd3, 0xb3, 0xe9, 0xa2, 0x07, 0xfe, 0x4f, 0x61, 0x67, 0x0a, 0x87, 0x5a, 0x3a,
+	0x47, 0x50, 0xea, 0x08, 0x89, 0xda, 0xcb, 0x90, 0x9a, 0xf8, 0x29, 0x6c, 0xf6, 0xc8, 0x97, 0x78,
+	0x4c, 0x94, 0x21, 0xde, 0x53, 0xa7, 0xba, 0xb0, 0x71, 0x29, 0x9b, 0x88, 0x12, 0xff, 0x0c, 0x76,
+	0xa7, 0x6a, 0xa7, 0xf8, 0x2f, 0xbc, 0x9a, 0xff, 0x4f, 0x60, 0x3b, 0x95, 0x9f, 0x19, 0xf8, 0x4e,
+	0x54, 0xa1, 0xfc, 0x72, 0xd2, 0x2e, 0xb6, 0x9c, 0xf0, 0x9f, 0x34, 0xa8, 0xe4, 0xc8, 0x93, 0xc3,
+	0xbc, 0x19, 0xf8, 0xce, 0xe4, 0x61, 0x9e, 0xe0, 0xb2, 0x87, 0x39, 0xc7, 0x62, 0x22, 0x4c, 0xd0,
+	0x8f, 0x00, 0x06, 0x3e, 0xff, 0xe7, 0xfa, 0xed, 0x68, 0xcb, 0xd9, 0x4a, 0x13, 0x3c, 0x88, 0xb4,
+	0xe6, 0x8e, 0x62, 0x51, 0x1b, 0x69, 0x62, 0x86, 0x49, 0x8a, 0x03, 0xff, 0xbe, 0x04, 0x2b, 0xe2,
+	0x9e, 0x74, 0x6c, 0xf7, 0xec, 0x16, 0x9f, 0xb6, 0xe7, 0xdd, 0x73, 0x3a, 0xb0, 0xee, 0xfa, 0xad,
+	0xc0, 0x73, 0xfd, 0xb6, 0xd5, 0x52, 0xc6, 0x62, 0xdb, 0x59, 0x3a, 0xdc, 0xa9, 0xcb, 0x3b, 0x6b,
+	0x9d, 0xdf, 0x59, 0xeb, 0xea, 0xce, 0x5a, 0x3f, 0x0e, 0x5c, 0xdf, 0xdc, 0x57, 0x61, 0x55, 0xa3,
+	0xa5, 0x99, 0x61, 0xc0, 0xa4, 0x1c, 0xc9, 0xe2, 0x88, 0xbe, 0xd2, 0x40, 0xb7, 0x9b, 0x2c, 0xe8,
+	0x0e, 0x42, 0x6a, 0xe5, 0x7d, 0x16, 0x5e, 0xe6, 0xf3, 0x86, 0xf2, 0xf9, 0x9a, 0xf4, 0x39, 0x9b,
+	0x0a, 0x93, 0x6a, 0xa4, 0x3c, 0xcb, 0x06, 0x71, 0x17, 0x96, 0xf9, 0x2e, 0x64, 0x75, 0x5d, 0xcf,
+	0x0d, 0xa9, 0x23, 0xf6, 0xb3, 0x05, 0xb3, 0x32, 0x1e, 0x19, 0x1b, 0x92, 0x36, 0xad, 0xc5, 0x64,
+	0x89, 0x7f, 0xbe, 0x2f, 0xbf, 0xd0, 0x6f, 0x35, 0xd8, 0x13, 0x6a, 0x1e, 0x9b, 0x33, 0x25, 0x85,
+	0xe2, 0xcb, 0x52, 0xb8, 0xa5, 0x52, 0x38, 0x48, 0xf9, 0x9a, 0x41, 0x86, 0xc9, 0x0e, 0x57, 0x9b,
+	0x5c, 0x9b, 0xcb, 0xa2, 0x03, 0xeb, 0xc1, 0x20, 0x6c, 0x07, 0x13, 0xde, 0x4b, 0x17, 0x1c, 0xb4,
+	0x1c, 0x03, 0x26, 0xe5, 0x48, 0x16, 0x7b, 0xea, 0xc2, 0xfa, 0x63, 0xd7, 0x77, 0x82, 0xc7, 0x56,
+	0x9f, 0x32, 0xaa, 0xce, 0xf7, 0xf9, 0x97, 0x1e, 0x02, 0x57, 0x27, 0x5d, 0xe5, 0x28, 0xe4, 0x29,
+	0xb0, 0x26, 0xe5, 0x84, 0x8b, 0xc5, 0x31, 0xf0, 0x63, 0xa8, 0x88, 0x9a, 0x0c, 0xfc, 0xd0, 0xed,
+	0x5a, 0x69, 0x2b, 0xb5, 0x7d, 0xe2, 0xf1, 0xc8, 0xa8, 0xa5, 0x8a, 0x97, 0x07, 0x62, 0xb2, 0xc9,
+	0x35, 0x0f, 0xb8, 0xe2, 0xe3, 0x84, 0x1e, 0x1f, 0xa7, 0x3b, 0x93, 0x28, 0xbd, 0x8b, 0xee, 0xa5,
+	0x13, 0x1d, 0x4c, 0x42, 0x92, 0x74, 0x30, 0xf1, 0x60, 0xe4, 0x3b, 0x98, 0x08, 0x9e, 0xed, 0x60,
+	0x92, 0xea, 0xc7, 0xd6, 0xf8, 0x27, 0x50, 0x93, 0xf7, 0x1c, 0xd7, 0x1b, 0x74, 0xed, 0x90, 0x1e,
+	0xf7, 0xa9, 0x1d, 0xd2, 0x74, 0x3b, 0xf3, 0x36, 0x14, 0x3c, 0xd6, 0x56, 0x6e, 0x76, 0xa4, 0x9b,
+	0x1f, 0xb2, 0xb6, 0x04, 0xa6, 0x5a, 0x8a, 0xd5, 0xf1, 0xc8, 0x00, 0x75, 0xe8, 0xb1, 0x36, 0x26,
+	0xdc, 0x0a, 0x7f, 0x5b, 0x00, 0x63, 0x26, 0xbf, 0x4a, 0xe6, 0xbb, 0xed, 0x97, 0xf8, 0x89, 0x49,
+	0x9f, 0xf4, 0xdc, 0xbe, 0xbc, 0x24, 0x88, 0x49, 0x54, 0xbd, 0x9c, 0x3d, 0x31, 0xb3, 0x08, 0x4c,
+	0xd6, 0xa4, 0x28, 0x9e, 0x78, 0xe8, 0x18, 0x16, 0x1d, 0xb7, 0x4f, 0x5b, 0xbc, 0x37, 0x17, 0x5b,
+	0xca, 0x8a, 0x79, 0x2d, 0x39, 0xad, 0x63, 0x15, 0x0f, 0x78, 0x85, 0x27, 0x73, 0x12, 0x49, 0x48,
+	0x62, 0x87, 0x7e, 0x00, 0x85, 0x87, 0x34, 0xba, 0xeb, 0xbc, 0x60, 0x41, 0x21, 0x35, 0x92, 0xaa,
+	0xc0, 0x0f, 0x29, 0xc5, 0x84, 0x5b, 0xf2, 0xf9, 0x44, 0xfb, 0xfd, 0xa0, 0x5f, 0x2d, 0x66, 0xe7,
+	0x93, 0x10, 0x63, 0x22, 0xd5, 0xfc, 0x6e, 0xd1, 0x0a, 0x1c, 0xca, 0x7a, 0x76, 0x8b, 0x8a, 0xf5,
+	0x3b, 0x71, 0xa1, 0x8f, 0x55, 0x98, 0x24, 0x30, 0x74, 0x00, 0x73, 0xfc, 0x43, 0x2c, 0xc2, 0x15,
+	0x73, 0x2d, 0x39, 0x61, 0xb8, 0x14, 0x13, 0xa1, 0xc4, 0x77, 0x55, 0x07, 0x9c, 0x6a, 0xdd, 0xcf,
+	0x3d, 0xc9, 0x7f, 0xa3, 0xc1, 0x46, 0xa6, 0x85, 0x34, 0x87, 0x67, 0x27, 0xdf, 0x75, 0x07, 0x1d,
+	0x00, 0xca, 0xa4, 0xe0, 0x52, 0x86, 0x6e, 0xc2, 0x5c, 0xcf, 0x6e, 0x53, 0x11, 0x41, 0xc1, 0xdc,
+	0x4e, 0xb2, 0xe7, 0x52, 0xee, 0xb4, 0xe0, 0xfa, 0x21, 0x11, 0x18, 0x74, 0x1b, 0x8a, 0x62, 0x23,
+	0x57, 0x13, 0xa9, 0x92, 0x24, 0x2c, 0xc4, 0x31, 0x5a, 0xa2, 0xf0, 0xdf, 0x2e, 0x43, 0x39, 0x93,
+	0xf7, 0xff, 0xd2, 0x1f, 0x7a, 0x03, 0xe6, 0x5d, 0xff, 0x51, 0xd0, 0x7d, 0x24, 0x1b, 0xa6, 0x45,
+	0x13, 0x25, 0x55, 0x54, 0x0a, 0x4c, 0x22, 0x08, 0x3a, 0x04, 0x10, 0x73, 0x5d, 0xbc, 0x3a, 0xa9,
+	0x6e, 0x69, 0x9a, 0x41, 0x0a, 0x85, 0xfe, 0x1f, 0x4a, 0x2c, 0xb4, 0xc3, 0x81, 0xbc, 0x4c, 0xaf,
+	0x98, 0x46, 0xea, 0xe1, 0x46, 0xc8, 0x79, 0x48, 0x10, 0x35, 0x38, 0x03, 0x5e, 0x7b, 0xf1, 0x3b,
+	0xb9, 0x8a, 0x4a, 0xaf, 0xb6, 0x8a, 0x0e, 0xbf, 0x5c, 0x82, 0xa2, 0xa8, 0x27, 0xfa, 0x39, 0x2c,
+	0xa5, 0x27, 0xe2, 0x95, 0xf4, 0x33, 0x45, 0xee, 0x25, 0x4b, 0xaf, 0xcd, 0x52, 0xcb, 0x9d, 0x09,
+	0xef, 0x7d, 0xf5, 0xf7, 0x7f, 0xfd, 0xee, 0xf2, 0x36, 0xda, 0x6c, 0xd0, 0xdb, 0x5e, 0xe0, 0xd3,
+	0x61, 0x43, 0x3e, 0x93, 0x49, 0xf2, 0x3e, 0xac, 0x24, 0x46, 0x7c, 0xc6, 0x18, 0x53, 0xe9, 0x92,
+	0x67, 0x29, 0x7d, 0x7f, 0x36, 0x40, 0x79, 0xac, 0x09, 0x8f, 0x55, 0xb4, 0x3d, 0xc5, 0x23, 0x77,
+	0x71, 0x0f, 0xe6, 0x78, 0x15, 0x50, 0xfa, 0xfd, 0x25, 0xb5, 0x59, 0xeb, 0x95, 0x9c, 0x5c, 0x11,
+	0xeb, 0x82, 0x78, 0x13, 0xa1, 0x0c, 0x31, 0x27, 0xfb, 0x08, 0x8a, 0x72, 0x0a, 0x66, 0xad, 0xe3,
+	0xc0, 0xab, 0x79, 0xc5, 0x39, 0x78, 0xff, 0xa0, 0x41, 0x65, 0xc6, 0x0b, 0x07, 0xba, 0x91, 0x65,
+	0x9c, 0xf9, 0x64, 0xa3, 0xdf, 0x3c, 0x0f, 0x54, 0x85, 0x73, 0x47, 0x84, 0x73, 0x0b, 0xdd, 0xc8,
+	0x87, 0xc3, 0xac, 0xe6, 0xd0, 0xca, 0x3f, 0xeb, 0x20, 0x07, 0x16, 0xa2, 0xe7, 0x0f, 0xa4, 0xa7,
+	0x5c, 0x65, 0xde, 0x4a, 0xf4, 0xdd, 0xa9, 0x3a, 0xe5, 0xd7, 0x10, 0x7e, 0x77, 0x50, 0x65, 0xd2,
+	0xaf, 0x43, 0xfd, 0xa1, 0xd5, 0xe5, 0xcc, 0x1f, 0x43, 0x51, 0xb4, 0xec, 0x93, 0x35, 0x4e, 0x3d,
+	0x94, 0xe8, 0xd5, 0xbc, 0x42, 0x91, 0xef, 0x0a, 0xf2, 0x2d, 0xb4, 0x91, 0x49, 0x4a, 0xf0, 0xfd,
+	0x2a, 0xf3, 0x02, 0x50, 0x9b, 0x08, 0x33, 0xd7, 0xcc, 0xe9, 0xc6, 0x4c, 0xbd, 0xf2, 0x76, 0x4b,
+	0x78, 0xbb, 0x86, 0x0e, 0xb2, 0xa9, 0x70, 0xac, 0x25, 0x5b, 0xa1, 0xc6, 0xe7, 0x62, 0x03, 0xff,
+	0x02, 0xfd, 0x12, 0x56, 0x27, 0xfb, 0x2d, 0xb4, 0x9f, 0xe5, 0xcf, 0x36, 0x6a, 0xfa, 0x6b, 0x2f,
+	0x40, 0xa8, 0x18, 0x0e, 0x44, 0x0c, 0x57, 0xd0, 0xee, 0x0b, 0x62, 0x40, 0x5f, 0x00, 0x24, 0x4d,
+	0x10, 0xda, 0xcb, 0xe5, 0x95, 0x6a, 0xd0, 0xf4, 0x2b, 0x33, 0xb4, 0xca, 0xdf, 0xa1, 0xf0, 0xf7,
+	0x06, 0xba, 0x39, 0xd5, 0x1f, 0xef, 0x7d, 0x1a, 0x9f, 0xab, 0x0f, 0xd5, 0xc0, 0xf1, 0xd4, 0x33,
+	0x7d, 0x50, 0x6e, 0xf9, 0x67, 0xee, 0x7e, 0xfa, 0xfe, 0x6c, 0x80, 0x8a, 0xe3, 0x75, 0x11, 0xc7,
+	0x3e, 0xaa, 0x4d, 0xc6, 0x11, 0xdd, 0xd6, 0xe2, 0xb2, 0x3f, 0xd5, 0x00, 0xe5, 0x6f, 0x54, 0xe8,
+	0x6a, 0x7a, 0x0a, 0xcd, 0xba, 0xd0, 0xe9, 0xd7, 0x5e, 0x82, 0x52, 0xb1, 0xdc, 0x16, 0xb1, 0xfc,
+	0x1f, 0xc6, 0x99, 0x59, 0xa7, 0x2c, 0xac, 0x96, 0x30, 0xb1, 0xf8, 0xd2, 0xba, 0xab, 0xdd, 0x34,
+	0xbf, 0xff, 0xf5, 0xb3, 0x9a, 0xf6, 0xcd, 0xb3, 0x9a, 0xf6, 0xcf, 0x67, 0x35, 0xed, 0xe9, 0xf3,
+	0xda, 0xa5, 0x6f, 0x9e, 0xd7, 0x2e, 0xfd, 0xe3, 0x79, 0xed, 0xd2, 0x27, 0x57, 0x53, 0x87, 0xf3,
+	0x04, 0x95, 0x17, 0x38, 0x83, 0x2e, 0x6d, 0x84, 0xc3, 0x1e, 0x65, 0xcd, 0x92, 0xb8, 0xdb, 0xbf,
+	0xf9, 0x9f, 0x01, 0x00, 0xd8, 0x31, 0xf0, 0x78, 0x2b, 0x19, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	AssetSupply(ctx context.Context, in *QueryAssetSupplyRequest, opts ...grpc.CallOption) (*QueryAssetSupplyResponse, error)
+	AssetSupplies(ctx context.Context, in *QueryAssetSuppliesRequest, opts ...grpc.CallOption) (*QueryAssetSuppliesResponse, error)
+	Swap(ctx context.Context, in *QuerySwapRequest, opts ...grpc.CallOption) (*QuerySwapResponse, error)
+	Swaps(ctx context.Context, in *QuerySwapsRequest, opts ...grpc.CallOption) (*QuerySwapsResponse, error)
+	SwapsByRandomNumberHash(ctx context.Context, in *QuerySwapsByRandomNumberHashRequest, opts ...grpc.CallOption) (*QuerySwapsByRandomNumberHashResponse, error)
+	DenyList(ctx context.Context, in *QueryDenyListRequest, opts ...grpc.CallOption) (*QueryDenyListResponse, error)
+	Stats(ctx context.Context, in *QueryStatsRequest, opts ...grpc.CallOption) (*QueryStatsResponse, error)
+	DeputyHealth(ctx context.Context, in *QueryDeputyHealthRequest, opts ...grpc.CallOption) (*QueryDeputyHealthResponse, error)
+	DeputiesHealth(ctx context.Context, in *QueryDeputiesHealthRequest, opts ...grpc.CallOption) (*QueryDeputiesHealthResponse, error)
+	DeputyBond(ctx context.Context, in *QueryDeputyBondRequest, opts ...grpc.CallOption) (*QueryDeputyBondResponse, error)
+	AssetCapacity(ctx context.Context, in *QueryAssetCapacityRequest, opts ...grpc.CallOption) (*QueryAssetCapacityResponse, error)
+	SimulateCreateSwap(ctx context.Context, in *QuerySimulateCreateSwapRequest, opts ...grpc.CallOption) (*QuerySimulateCreateSwapResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) AssetSupply(ctx context.Context, in *QueryAssetSupplyRequest, opts ...grpc.CallOption) (*QueryAssetSupplyResponse, error) {
+	out := new(QueryAssetSupplyResponse)
+	err := c.cc.Invoke(ctx, "/bep3.Query/AssetSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AssetSupplies(ctx context.Context, in *QueryAssetSuppliesRequest, opts ...grpc.CallOption) (*QueryAssetSuppliesResponse, error) {
+	out := new(QueryAssetSuppliesResponse)
+	err := c.cc.Invoke(ctx, "/bep3.Query/AssetSupplies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Swap(ctx context.Context, in *QuerySwapRequest, opts ...grpc.CallOption) (*QuerySwapResponse, error) {
+	out := new(QuerySwapResponse)
+	err := c.cc.Invoke(ctx, "/bep3.Query/Swap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Swaps(ctx context.Context, in *QuerySwapsRequest, opts ...grpc.CallOption) (*QuerySwapsResponse, error) {
+	out := new(QuerySwapsResponse)
+	err := c.cc.Invoke(ctx, "/bep3.Query/Swaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SwapsByRandomNumberHash(ctx context.Context, in *QuerySwapsByRandomNumberHashRequest, opts ...grpc.CallOption) (*QuerySwapsByRandomNumberHashResponse, error) {
+	out := new(QuerySwapsByRandomNumberHashResponse)
+	err := c.cc.Invoke(ctx, "/bep3.Query/SwapsByRandomNumberHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenyList(ctx context.Context, in *QueryDenyListRequest, opts ...grpc.CallOption) (*QueryDenyListResponse, error) {
+	out := new(QueryDenyListResponse)
+	err := c.cc.Invoke(ctx, "/bep3.Query/DenyList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Stats(ctx context.Context, in *QueryStatsRequest, opts ...grpc.CallOption) (*QueryStatsResponse, error) {
+	out := new(QueryStatsResponse)
+	err := c.cc.Invoke(ctx, "/bep3.Query/Stats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeputyHealth(ctx context.Context, in *QueryDeputyHealthRequest, opts ...grpc.CallOption) (*QueryDeputyHealthResponse, error) {
+	out := new(QueryDeputyHealthResponse)
+	err := c.cc.Invoke(ctx, "/bep3.Query/DeputyHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeputiesHealth(ctx context.Context, in *QueryDeputiesHealthRequest, opts ...grpc.CallOption) (*QueryDeputiesHealthResponse, error) {
+	out := new(QueryDeputiesHealthResponse)
+	err := c.cc.Invoke(ctx, "/bep3.Query/DeputiesHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeputyBond(ctx context.Context, in *QueryDeputyBondRequest, opts ...grpc.CallOption) (*QueryDeputyBondResponse, error) {
+	out := new(QueryDeputyBondResponse)
+	err := c.cc.Invoke(ctx, "/bep3.Query/DeputyBond", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AssetCapacity(ctx context.Context, in *QueryAssetCapacityRequest, opts ...grpc.CallOption) (*QueryAssetCapacityResponse, error) {
+	out := new(QueryAssetCapacityResponse)
+	err := c.cc.Invoke(ctx, "/bep3.Query/AssetCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateCreateSwap(ctx context.Context, in *QuerySimulateCreateSwapRequest, opts ...grpc.CallOption) (*QuerySimulateCreateSwapResponse, error) {
+	out := new(QuerySimulateCreateSwapResponse)
+	err := c.cc.Invoke(ctx, "/bep3.Query/SimulateCreateSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	AssetSupply(context.Context, *QueryAssetSupplyRequest) (*QueryAssetSupplyResponse, error)
+	AssetSupplies(context.Context, *QueryAssetSuppliesRequest) (*QueryAssetSuppliesResponse, error)
+	Swap(context.Context, *QuerySwapRequest) (*QuerySwapResponse, error)
+	Swaps(context.Context, *QuerySwapsRequest) (*QuerySwapsResponse, error)
+	SwapsByRandomNumberHash(context.Context, *QuerySwapsByRandomNumberHashRequest) (*QuerySwapsByRandomNumberHashResponse, error)
+	DenyList(context.Context, *QueryDenyListRequest) (*QueryDenyListResponse, error)
+	Stats(context.Context, *QueryStatsRequest) (*QueryStatsResponse, error)
+	DeputyHealth(context.Context, *QueryDeputyHealthRequest) (*QueryDeputyHealthResponse, error)
+	DeputiesHealth(context.Context, *QueryDeputiesHealthRequest) (*QueryDeputiesHealthResponse, error)
+	DeputyBond(context.Context, *QueryDeputyBondRequest) (*QueryDeputyBondResponse, error)
+	AssetCapacity(context.Context, *QueryAssetCapacityRequest) (*QueryAssetCapacityResponse, error)
+	SimulateCreateSwap(context.Context, *QuerySimulateCreateSwapRequest) (*QuerySimulateCreateSwapResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) AssetSupply(ctx context.Context, req *QueryAssetSupplyRequest) (*QueryAssetSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssetSupply not implemented")
+}
+func (*UnimplementedQueryServer) AssetSupplies(ctx context.Context, req *QueryAssetSuppliesRequest) (*QueryAssetSuppliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssetSupplies not implemented")
+}
+func (*UnimplementedQueryServer) Swap(ctx context.Context, req *QuerySwapRequest) (*QuerySwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Swap not implemented")
+}
+func (*UnimplementedQueryServer) Swaps(ctx context.Context, req *QuerySwapsRequest) (*QuerySwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Swaps not implemented")
+}
+func (*UnimplementedQueryServer) SwapsByRandomNumberHash(ctx context.Context, req *QuerySwapsByRandomNumberHashRequest) (*QuerySwapsByRandomNumberHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapsByRandomNumberHash not implemented")
+}
+func (*UnimplementedQueryServer) DenyList(ctx context.Context, req *QueryDenyListRequest) (*QueryDenyListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenyList not implemented")
+}
+func (*UnimplementedQueryServer) Stats(ctx context.Context, req *QueryStatsRequest) (*QueryStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (*UnimplementedQueryServer) DeputyHealth(ctx context.Context, req *QueryDeputyHealthRequest) (*QueryDeputyHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeputyHealth not implemented")
+}
+func (*UnimplementedQueryServer) DeputiesHealth(ctx context.Context, req *QueryDeputiesHealthRequest) (*QueryDeputiesHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeputiesHealth not implemented")
+}
+func (*UnimplementedQueryServer) DeputyBond(ctx context.Context, req *QueryDeputyBondRequest) (*QueryDeputyBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeputyBond not implemented")
+}
+func (*UnimplementedQueryServer) AssetCapacity(ctx context.Context, req *QueryAssetCapacityRequest) (*QueryAssetCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssetCapacity not implemented")
+}
+func (*UnimplementedQueryServer) SimulateCreateSwap(ctx context.Context, req *QuerySimulateCreateSwapRequest) (*QuerySimulateCreateSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateCreateSwap not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_AssetSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAssetSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AssetSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bep3.Query/AssetSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AssetSupply(ctx, req.(*QueryAssetSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AssetSupplies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAssetSuppliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AssetSupplies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bep3.Query/AssetSupplies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AssetSupplies(ctx, req.(*QueryAssetSuppliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Swap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Swap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bep3.Query/Swap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Swap(ctx, req.(*QuerySwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Swaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Swaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bep3.Query/Swaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Swaps(ctx, req.(*QuerySwapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SwapsByRandomNumberHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapsByRandomNumberHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SwapsByRandomNumberHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bep3.Query/SwapsByRandomNumberHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapsByRandomNumberHash(ctx, req.(*QuerySwapsByRandomNumberHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenyList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenyListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenyList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bep3.Query/DenyList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenyList(ctx, req.(*QueryDenyListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bep3.Query/Stats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Stats(ctx, req.(*QueryStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeputyHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeputyHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeputyHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bep3.Query/DeputyHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeputyHealth(ctx, req.(*QueryDeputyHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeputiesHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeputiesHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeputiesHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bep3.Query/DeputiesHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeputiesHealth(ctx, req.(*QueryDeputiesHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeputyBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeputyBondRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeputyBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bep3.Query/DeputyBond",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeputyBond(ctx, req.(*QueryDeputyBondRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AssetCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAssetCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AssetCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bep3.Query/AssetCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AssetCapacity(ctx, req.(*QueryAssetCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateCreateSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateCreateSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateCreateSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bep3.Query/SimulateCreateSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateCreateSwap(ctx, req.(*QuerySimulateCreateSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "bep3.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AssetSupply",
+			Handler:    _Query_AssetSupply_Handler,
+		},
+		{
+			MethodName: "AssetSupplies",
+			Handler:    _Query_AssetSupplies_Handler,
+		},
+		{
+			MethodName: "Swap",
+			Handler:    _Query_Swap_Handler,
+		},
+		{
+			MethodName: "Swaps",
+			Handler:    _Query_Swaps_Handler,
+		},
+		{
+			MethodName: "SwapsByRandomNumberHash",
+			Handler:    _Query_SwapsByRandomNumberHash_Handler,
+		},
+		{
+			MethodName: "DenyList",
+			Handler:    _Query_DenyList_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _Query_Stats_Handler,
+		},
+		{
+			MethodName: "DeputyHealth",
+			Handler:    _Query_DeputyHealth_Handler,
+		},
+		{
+			MethodName: "DeputiesHealth",
+			Handler:    _Query_DeputiesHealth_Handler,
+		},
+		{
+			MethodName: "DeputyBond",
+			Handler:    _Query_DeputyBond_Handler,
+		},
+		{
+			MethodName: "AssetCapacity",
+			Handler:    _Query_AssetCapacity_Handler,
+		},
+		{
+			MethodName: "SimulateCreateSwap",
+			Handler:    _Query_SimulateCreateSwap_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bep3/query.proto",
+}
+
+func (m *QueryAssetSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAssetSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAssetSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAssetSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAssetSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAssetSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Supply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAssetSuppliesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAssetSuppliesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAssetSuppliesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAssetSuppliesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAssetSuppliesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAssetSuppliesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Supplies.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SwapID) > 0 {
+		i -= len(m.SwapID)
+		copy(dAtA[i:], m.SwapID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SwapID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Swap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Swaps.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapsByRandomNumberHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapsByRandomNumberHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapsByRandomNumberHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RandomNumberHash) > 0 {
+		i -= len(m.RandomNumberHash)
+		copy(dAtA[i:], m.RandomNumberHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RandomNumberHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapsByRandomNumberHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapsByRandomNumberHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapsByRandomNumberHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Swaps.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenyListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenyListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenyListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenyListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenyListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenyListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Daily {
+		i--
+		if m.Daily {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DailyStats) > 0 {
+		for iNdEx := len(m.DailyStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DailyStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeputyHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeputyHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeputyHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SuspendedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SuspendedHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Suspended {
+		i--
+		if m.Suspended {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxDeputyInactivity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxDeputyInactivity))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.InactiveBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InactiveBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastActiveTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastActiveTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x22
+	if m.LastActiveHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastActiveHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DeputyAddress) > 0 {
+		i -= len(m.DeputyAddress)
+		copy(dAtA[i:], m.DeputyAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DeputyAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeputyHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeputyHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeputyHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeputyHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeputyHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeputyHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeputiesHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeputiesHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeputiesHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeputiesHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeputiesHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeputiesHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Health) > 0 {
+		for iNdEx := len(m.Health) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Health[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeputyBondRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeputyBondRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeputyBondRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeputyAddress) > 0 {
+		i -= len(m.DeputyAddress)
+		copy(dAtA[i:], m.DeputyAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DeputyAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeputyBondResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeputyBondResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeputyBondResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unbondings) > 0 {
+		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Bond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetCapacity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetCapacity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetCapacity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeUntilWindowReset != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TimeUntilWindowReset))
+		i--
+		dAtA[i] = 0x40
+	}
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.WindowResetTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.WindowResetTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintQuery(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.OutgoingCapacity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.TimeBasedIncomingCapacity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.TimeLimited {
+		i--
+		if m.TimeLimited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.AbsoluteIncomingCapacity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.IncomingCapacity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAssetCapacityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAssetCapacityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAssetCapacityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAssetCapacityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAssetCapacityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAssetCapacityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Capacity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateCreateSwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateCreateSwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateCreateSwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateCreateSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateCreateSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateCreateSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Direction != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExpireTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpireTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SwapID) > 0 {
+		i -= len(m.SwapID)
+		copy(dAtA[i:], m.SwapID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SwapID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAssetSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAssetSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAssetSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAtomicSwapByID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAtomicSwapByID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAtomicSwapByID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SwapID) > 0 {
+		i -= len(m.SwapID)
+		copy(dAtA[i:], m.SwapID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SwapID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAssetSupplies) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAssetSupplies) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAssetSupplies) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Page != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAtomicSwaps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAtomicSwaps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAtomicSwaps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Direction != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Expiration != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Expiration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Involve) > 0 {
+		i -= len(m.Involve)
+		copy(dAtA[i:], m.Involve)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Involve)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Page != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAssetSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAssetSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAssetSuppliesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAssetSuppliesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Supplies.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SwapID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Swap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySwapsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Swaps.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySwapsByRandomNumberHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RandomNumberHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapsByRandomNumberHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Swaps.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenyListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDenyListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Daily {
+		n += 2
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovQuery(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovQuery(uint64(m.EndTime))
+	}
+	return n
+}
+
+func (m *QueryStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DailyStats) > 0 {
+		for _, e := range m.DailyStats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeputyHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DeputyAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LastActiveHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastActiveHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastActiveTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.InactiveBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.InactiveBlocks))
+	}
+	if m.MaxDeputyInactivity != 0 {
+		n += 1 + sovQuery(uint64(m.MaxDeputyInactivity))
+	}
+	if m.Suspended {
+		n += 2
+	}
+	if m.SuspendedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SuspendedHeight))
+	}
+	return n
+}
+
+func (m *QueryDeputyHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeputyHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Health.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDeputiesHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDeputiesHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Health) > 0 {
+		for _, e := range m.Health {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDeputyBondRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DeputyAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeputyBondResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Bond.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AssetCapacity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.IncomingCapacity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AbsoluteIncomingCapacity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.TimeLimited {
+		n += 2
+	}
+	l = m.TimeBasedIncomingCapacity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.OutgoingCapacity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.WindowResetTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.TimeUntilWindowReset != 0 {
+		n += 1 + sovQuery(uint64(m.TimeUntilWindowReset))
+	}
+	return n
+}
+
+func (m *QueryAssetCapacityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAssetCapacityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Capacity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySimulateCreateSwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateCreateSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SwapID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ExpireTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.ExpireTimestamp))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovQuery(uint64(m.Direction))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovQuery(uint64(m.Code))
+	}
+	return n
+}
+
+func (m *QueryAssetSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAtomicSwapByID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SwapID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAssetSupplies) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Page != 0 {
+		n += 1 + sovQuery(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryAtomicSwaps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Page != 0 {
+		n += 1 + sovQuery(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	l = len(m.Involve)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Expiration != 0 {
+		n += 1 + sovQuery(uint64(m.Expiration))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovQuery(uint64(m.Direction))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAssetSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAssetSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAssetSuppliesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetSuppliesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetSuppliesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAssetSuppliesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetSuppliesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetSuppliesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supplies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supplies.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapID = append(m.SwapID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SwapID == nil {
+				m.SwapID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Swap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &QueryAtomicSwaps{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Swaps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapsByRandomNumberHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapsByRandomNumberHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapsByRandomNumberHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandomNumberHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RandomNumberHash = append(m.RandomNumberHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RandomNumberHash == nil {
+				m.RandomNumberHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapsByRandomNumberHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapsByRandomNumberHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapsByRandomNumberHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Swaps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenyListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenyListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenyListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenyListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenyListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenyListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Daily", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Daily = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stats = append(m.Stats, SwapStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DailyStats = append(m.DailyStats, DailySwapStats{})
+			if err := m.DailyStats[len(m.DailyStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeputyHealth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeputyHealth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeputyHealth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeputyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeputyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastActiveHeight", wireType)
+			}
+			m.LastActiveHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastActiveHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastActiveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastActiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactiveBlocks", wireType)
+			}
+			m.InactiveBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InactiveBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeputyInactivity", wireType)
+			}
+			m.MaxDeputyInactivity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDeputyInactivity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspended", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Suspended = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendedHeight", wireType)
+			}
+			m.SuspendedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuspendedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeputyHealthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeputyHealthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeputyHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeputyHealthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeputyHealthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeputyHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeputiesHealthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeputiesHealthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeputiesHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeputiesHealthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeputiesHealthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeputiesHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Health = append(m.Health, DeputyHealth{})
+			if err := m.Health[len(m.Health)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryAtomicSwaps) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Page != 0 {
-		n += 1 + sovQuery(uint64(m.Page))
-	}
-	if m.Limit != 0 {
-		n += 1 + sovQuery(uint64(m.Limit))
-	}
-	l = len(m.Involve)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Expiration != 0 {
-		n += 1 + sovQuery(uint64(m.Expiration))
-	}
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
-	}
-	if m.Direction != 0 {
-		n += 1 + sovQuery(uint64(m.Direction))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryAssetSupplyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDeputyBondRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1475,15 +5658,15 @@ func (m *QueryAssetSupplyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAssetSupplyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeputyBondRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAssetSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeputyBondRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeputyAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1511,7 +5694,7 @@ func (m *QueryAssetSupplyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.DeputyAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1534,7 +5717,7 @@ func (m *QueryAssetSupplyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAssetSupplyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDeputyBondResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1557,15 +5740,15 @@ func (m *QueryAssetSupplyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAssetSupplyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeputyBondResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAssetSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeputyBondResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1592,7 +5775,41 @@ func (m *QueryAssetSupplyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unbondings = append(m.Unbondings, DeputyUnbonding{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1617,7 +5834,7 @@ func (m *QueryAssetSupplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAssetSuppliesRequest) Unmarshal(dAtA []byte) error {
+func (m *AssetCapacity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1640,65 +5857,199 @@ func (m *QueryAssetSuppliesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAssetSuppliesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssetCapacity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAssetSuppliesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssetCapacity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncomingCapacity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncomingCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbsoluteIncomingCapacity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AbsoluteIncomingCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeLimited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimeLimited = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeBasedIncomingCapacity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeBasedIncomingCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingCapacity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAssetSuppliesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.OutgoingCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAssetSuppliesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAssetSuppliesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supplies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowResetTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1725,10 +6076,29 @@ func (m *QueryAssetSuppliesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Supplies.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.WindowResetTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeUntilWindowReset", wireType)
+			}
+			m.TimeUntilWindowReset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeUntilWindowReset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1750,7 +6120,7 @@ func (m *QueryAssetSuppliesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySwapRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAssetCapacityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1773,17 +6143,17 @@ func (m *QuerySwapRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAssetCapacityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAssetCapacityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1793,25 +6163,23 @@ func (m *QuerySwapRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SwapID = append(m.SwapID[:0], dAtA[iNdEx:postIndex]...)
-			if m.SwapID == nil {
-				m.SwapID = []byte{}
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1834,7 +6202,7 @@ func (m *QuerySwapRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySwapResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAssetCapacityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1857,15 +6225,15 @@ func (m *QuerySwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAssetCapacityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAssetCapacityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Swap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1892,7 +6260,7 @@ func (m *QuerySwapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Swap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Capacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1917,7 +6285,7 @@ func (m *QuerySwapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySwapsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateCreateSwapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1940,15 +6308,15 @@ func (m *QuerySwapsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateCreateSwapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateCreateSwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1975,10 +6343,10 @@ func (m *QuerySwapsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &QueryAtomicSwaps{}
+			if m.Msg == nil {
+				m.Msg = &MsgCreateAtomicSwap{}
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2003,7 +6371,7 @@ func (m *QuerySwapsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySwapsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateCreateSwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2026,15 +6394,87 @@ func (m *QuerySwapsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateCreateSwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateCreateSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Swaps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapID = append(m.SwapID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SwapID == nil {
+				m.SwapID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTimestamp", wireType)
+			}
+			m.ExpireTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= SwapDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2061,10 +6501,93 @@ func (m *QuerySwapsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Swaps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// SecondsPerDay is the width of a daily swap stats bucket
+	SecondsPerDay = 24 * 60 * 60
+	// DailySwapStatsRetentionDays is the number of days before the current day that daily swap stats are kept for
+	DailySwapStatsRetentionDays = 90
+)
+
+// NewSwapStats returns empty swap stats for an asset
+func NewSwapStats(denom string) SwapStats {
+	zero := sdk.NewCoin(denom, sdk.ZeroInt())
+	return SwapStats{
+		Denom:          denom,
+		IncomingVolume: zero,
+		OutgoingVolume: zero,
+		Fees:           zero,
+		ClaimTips:      zero,
+	}
+}
+
+// Validate performs a basic validation of swap stats fields.
+func (s SwapStats) Validate() error {
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return err
+	}
+	for name, coin := range map[string]sdk.Coin{
+		"incoming volume": s.IncomingVolume,
+		"outgoing volume": s.OutgoingVolume,
+		"fees":            s.Fees,
+		"claim tips":      s.ClaimTips,
+	} {
+		if !coin.IsValid() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "%s %s", name, coin)
+		}
+		if coin.Denom != s.Denom {
+			return fmt.Errorf("swap stats %s denom %s does not match %s", name, coin.Denom, s.Denom)
+		}
+	}
+	return nil
+}
+
+// String implements stringer
+func (s SwapStats) String() string {
+	return fmt.Sprintf(`
+	swap stats:
+		Denom:           %s
+		Created:         %d
+		Claimed:         %d
+		Refunded:        %d
+		Incoming volume: %s
+		Outgoing volume: %s
+		Fees:            %s
+		Claim tips:      %s
+		`,
+		s.Denom, s.Created, s.Claimed, s.Refunded, s.IncomingVolume, s.OutgoingVolume, s.Fees, s.ClaimTips)
+}
+
+// Validate performs a basic validation of daily swap stats fields.
+func (d DailySwapStats) Validate() error {
+	if d.Day < 0 || d.Day%SecondsPerDay != 0 {
+		return fmt.Errorf("daily swap stats day %d is not the start of a UTC day", d.Day)
+	}
+	return d.Stats.Validate()
+}
+
+// GetDayStart returns the unix seconds of the start of the UTC day containing t
+func GetDayStart(t time.Time) int64 {
+	return t.Unix() - t.Unix()%SecondsPerDay
+}
@@ -40,6 +40,9 @@ func (a AtomicSwap) GetSwapID() tmbytes.HexBytes {
 		return nil
 	}
 
+	if a.Direction == Local {
+		return CalculateLocalSwapID(a.RandomNumberHash, sender)
+	}
 	return CalculateSwapID(a.RandomNumberHash, sender, a.SenderOtherChain)
 }
 
@@ -88,22 +91,106 @@ func (a AtomicSwap) Validate() error {
 	if len(recAcc.Bytes()) != AddrByteCount {
 		return fmt.Errorf("the expected address length is %d, actual length is %d", AddrByteCount, len(a.Recipient))
 	}
-	// NOTE: These adresses may not have a bech32 prefix.
-	if strings.TrimSpace(a.SenderOtherChain) == "" {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender other chain cannot be blank")
+	// NOTE: These adresses may not have a bech32 prefix. Interchain swaps only know the counterparty address of
+	// the party on the other chain, and local swaps have no other chain.
+	if a.Direction == Local {
+		if a.SenderOtherChain != "" || a.RecipientOtherChain != "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "local swap cannot have addresses on other chain")
+		}
+	} else if a.IBCPacket != nil {
+		if strings.TrimSpace(a.SenderOtherChain+a.RecipientOtherChain) == "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "interchain swap counterparty address cannot be blank")
+		}
+	} else {
+		if strings.TrimSpace(a.SenderOtherChain) == "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender other chain cannot be blank")
+		}
+		if strings.TrimSpace(a.RecipientOtherChain) == "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "recipient other chain cannot be blank")
+		}
 	}
-	if strings.TrimSpace(a.RecipientOtherChain) == "" {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "recipient other chain cannot be blank")
+	if a.ClosedBlock < 0 {
+		return fmt.Errorf("closed block cannot be negative: %d", a.ClosedBlock)
 	}
-	if a.Status == Completed && a.ClosedBlock == 0 {
+	// Swaps closed before a zero height genesis are rebased to closed block 0
+	if a.Status == Completed && a.ClosedBlock == 0 && a.ClosedTime == 0 {
 		return errors.New("closed block cannot be 0")
 	}
 	if a.Status == NULL || a.Status > 3 {
 		return errors.New("invalid swap status")
 	}
-	if a.Direction == INVALID || a.Direction > 2 {
+	if !a.Direction.IsValid() {
 		return errors.New("invalid swap direction")
 	}
+	if err := ValidateClaimTip(a.Amount, a.ClaimTip); err != nil {
+		return err
+	}
+	if err := a.validateRemainingAmount(); err != nil {
+		return err
+	}
+	return ValidateSwapMetadata(a.Memo, a.OtherChainTxHash)
+}
+
+// validateRemainingAmount checks that the remaining amount of a swap with partial claims is a part of its amount,
+// which is only claimed in full once the swap is closed. Swaps without partial claims have no remaining amount.
+func (a AtomicSwap) validateRemainingAmount() error {
+	if !a.PartialClaims {
+		if !a.RemainingAmount.Empty() {
+			return sdkerrors.Wrap(ErrInvalidPartialClaim, "remaining amount of swap without partial claims must be empty")
+		}
+		return nil
+	}
+	if !a.ClaimTip.Empty() {
+		return sdkerrors.Wrap(ErrInvalidPartialClaim, "swap with partial claims cannot have a claim tip")
+	}
+	if !a.RemainingAmount.IsValid() || len(a.RemainingAmount) > 1 || !a.Amount.IsAllGTE(a.RemainingAmount) {
+		return sdkerrors.Wrapf(ErrInvalidPartialClaim, "remaining amount %s must be part of the swap amount %s", a.RemainingAmount, a.Amount)
+	}
+	if a.RemainingAmount.Empty() && a.Status != Completed {
+		return sdkerrors.Wrapf(ErrInvalidPartialClaim, "%s swap has no remaining amount", a.Status)
+	}
+	return nil
+}
+
+// UnclaimedAmount returns the part of the amount of an open or expired swap that has not been claimed yet, and
+// makes up the incoming or outgoing supply of the swap's asset
+func (a AtomicSwap) UnclaimedAmount() sdk.Coins {
+	if a.PartialClaims {
+		return a.RemainingAmount
+	}
+	return a.Amount
+}
+
+// ValidateSwapMetadata checks that the optional memo and other chain tx hash of a swap are within their
+// length limits.
+func ValidateSwapMetadata(memo, otherChainTxHash string) error {
+	if len(memo) > MaxMemoLength {
+		return sdkerrors.Wrapf(ErrInvalidSwapMetadata, "memo length %d exceeds max %d", len(memo), MaxMemoLength)
+	}
+	if len(otherChainTxHash) > MaxOtherChainTxHashLength {
+		return sdkerrors.Wrapf(ErrInvalidSwapMetadata, "other chain tx hash length %d exceeds max %d", len(otherChainTxHash), MaxOtherChainTxHashLength)
+	}
+	if strings.ContainsAny(otherChainTxHash, " \t\n") {
+		return sdkerrors.Wrap(ErrInvalidSwapMetadata, "other chain tx hash cannot contain whitespace")
+	}
+	return nil
+}
+
+// ValidateClaimTip checks that an optional claim tip is a single coin of the swap's denom
+// and strictly smaller than the swap amount.
+func ValidateClaimTip(amount, claimTip sdk.Coins) error {
+	if claimTip.Empty() {
+		return nil
+	}
+	if !claimTip.IsValid() {
+		return sdkerrors.Wrap(ErrInvalidClaimTip, claimTip.String())
+	}
+	if len(claimTip) != 1 || len(amount) != 1 || claimTip[0].Denom != amount[0].Denom {
+		return sdkerrors.Wrapf(ErrInvalidClaimTip, "claim tip %s must be in the swap denom of %s", claimTip, amount)
+	}
+	if !claimTip[0].Amount.LT(amount[0].Amount) {
+		return sdkerrors.Wrapf(ErrInvalidClaimTip, "claim tip %s must be less than the swap amount %s", claimTip, amount)
+	}
 	return nil
 }
 
@@ -122,12 +209,19 @@ func (a AtomicSwap) String() string {
 		"\n    Recipient other chain:    %s"+
 		"\n    Closed block:             %d"+
 		"\n    Cross chain:              %t"+
-		"\n    Direction:                %s",
+		"\n    Direction:                %s"+
+		"\n    Claim tip:                %s"+
+		"\n    Memo:                     %s"+
+		"\n    Other chain tx hash:      %s"+
+		"\n    Deputy slashed:           %t"+
+		"\n    Partial claims:           %t"+
+		"\n    Remaining amount:         %s",
 		a.GetSwapID(), a.Status.String(), a.Amount.String(),
 		hex.EncodeToString(a.RandomNumberHash), a.ExpireTimestamp,
 		a.Timestamp, a.Sender, a.Recipient,
 		a.SenderOtherChain, a.RecipientOtherChain, a.ClosedBlock,
-		a.CrossChain, a.Direction)
+		a.CrossChain, a.Direction, a.ClaimTip.String(), a.Memo, a.OtherChainTxHash, a.DeputySlashed,
+		a.PartialClaims, a.RemainingAmount.String())
 }
 
 // AtomicSwaps is a slice of AtomicSwap
@@ -214,6 +308,7 @@ const (
 	INVALID  SwapDirection = 0x00
 	Incoming SwapDirection = 0x01
 	Outgoing SwapDirection = 0x02
+	Local    SwapDirection = 0x03
 )
 
 // NewSwapDirectionFromString converts string to SwapDirection type
@@ -223,6 +318,8 @@ func NewSwapDirectionFromString(str string) SwapDirection {
 		return Incoming
 	case "Outgoing", "outgoing", "out", "O", "o":
 		return Outgoing
+	case "Local", "local", "loc", "L", "l":
+		return Local
 	default:
 		return INVALID
 	}
@@ -235,6 +332,8 @@ func (direction SwapDirection) String() string {
 		return "Incoming"
 	case Outgoing:
 		return "Outgoing"
+	case Local:
+		return "Local"
 	default:
 		return "INVALID"
 	}
@@ -259,7 +358,8 @@ func (direction *SwapDirection) UnmarshalJSON(data []byte) error {
 // IsValid returns true if the swap direction is valid and false otherwise.
 func (direction SwapDirection) IsValid() bool {
 	if direction == Incoming ||
-		direction == Outgoing {
+		direction == Outgoing ||
+		direction == Local {
 		return true
 	}
 	return false
@@ -280,5 +380,12 @@ func NewAugmentedAtomicSwap(swap AtomicSwap) AugmentedAtomicSwap {
 		Status:              swap.Status,
 		CrossChain:          swap.CrossChain,
 		Direction:           swap.Direction,
+		ClaimTip:            swap.ClaimTip,
+		ClosedTime:          swap.ClosedTime,
+		Memo:                swap.Memo,
+		OtherChainTxHash:    swap.OtherChainTxHash,
+		DeputySlashed:       swap.DeputySlashed,
+		PartialClaims:       swap.PartialClaims,
+		RemainingAmount:     swap.RemainingAmount,
 	}
-}
\ No newline at end of file
+}
@@ -7,6 +7,8 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	types2 "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	types1 "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -44,6 +46,23 @@ type AtomicSwap struct {
 	Status              SwapStatus                                           `protobuf:"varint,10,opt,name=status,proto3,casttype=SwapStatus" json:"status,omitempty" yaml:"status"`
 	CrossChain          bool                                                 `protobuf:"varint,11,opt,name=cross_chain,json=crossChain,proto3" json:"cross_chain,omitempty" yaml:"cross_chain"`
 	Direction           SwapDirection                                        `protobuf:"varint,12,opt,name=direction,proto3,casttype=SwapDirection" json:"direction,omitempty" yaml:"direction"`
+	// optional part of the amount paid to the address submitting the successful claim
+	ClaimTip github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=claim_tip,json=claimTip,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claim_tip" yaml:"claim_tip"`
+	// the block time at which the swap was claimed or refunded, in unix seconds
+	ClosedTime int64 `protobuf:"varint,14,opt,name=closed_time,json=closedTime,proto3" json:"closed_time,omitempty" yaml:"closed_time"`
+	// optional reference supplied by the swap creator
+	Memo string `protobuf:"bytes,15,opt,name=memo,proto3" json:"memo,omitempty" yaml:"memo"`
+	// optional hash of the counterparty transaction on the other chain
+	OtherChainTxHash string `protobuf:"bytes,16,opt,name=other_chain_tx_hash,json=otherChainTxHash,proto3" json:"other_chain_tx_hash,omitempty" yaml:"other_chain_tx_hash"`
+	// whether the deputy has been slashed for failing to honor this swap
+	DeputySlashed bool `protobuf:"varint,17,opt,name=deputy_slashed,json=deputySlashed,proto3" json:"deputy_slashed,omitempty" yaml:"deputy_slashed"`
+	// the IBC packet that created the mirrored swap on the counterparty chain, or
+	// that this swap mirrors. Interchain swaps are settled over IBC.
+	IBCPacket *types1.Packet `protobuf:"bytes,18,opt,name=ibc_packet,json=ibcPacket,proto3" json:"ibc_packet,omitempty" yaml:"ibc_packet"`
+	// whether the swap may be claimed in parts
+	PartialClaims bool `protobuf:"varint,19,opt,name=partial_claims,json=partialClaims,proto3" json:"partial_claims,omitempty" yaml:"partial_claims"`
+	// the part of the amount not claimed yet, for swaps with partial claims
+	RemainingAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,20,rep,name=remaining_amount,json=remainingAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining_amount" yaml:"remaining_amount"`
 }
 
 func (m *AtomicSwap) Reset()      { *m = AtomicSwap{} }
@@ -162,6 +181,62 @@ func (m *AtomicSwap) GetDirection() SwapDirection {
 	return 0
 }
 
+func (m *AtomicSwap) GetClaimTip() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClaimTip
+	}
+	return nil
+}
+
+func (m *AtomicSwap) GetClosedTime() int64 {
+	if m != nil {
+		return m.ClosedTime
+	}
+	return 0
+}
+
+func (m *AtomicSwap) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *AtomicSwap) GetOtherChainTxHash() string {
+	if m != nil {
+		return m.OtherChainTxHash
+	}
+	return ""
+}
+
+func (m *AtomicSwap) GetDeputySlashed() bool {
+	if m != nil {
+		return m.DeputySlashed
+	}
+	return false
+}
+
+func (m *AtomicSwap) GetIBCPacket() *types1.Packet {
+	if m != nil {
+		return m.IBCPacket
+	}
+	return nil
+}
+
+func (m *AtomicSwap) GetPartialClaims() bool {
+	if m != nil {
+		return m.PartialClaims
+	}
+	return false
+}
+
+func (m *AtomicSwap) GetRemainingAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RemainingAmount
+	}
+	return nil
+}
+
 // Slice of Augmented Atomic Swaps
 type AugmentedAtomicSwaps struct {
 	AugmentedAtomicSwaps []AugmentedAtomicSwap `protobuf:"bytes,1,rep,name=augmented_atomic_swaps,json=augmentedAtomicSwaps,proto3" json:"augmented_atomic_swaps" yaml:"augmented_atomic_swaps"`
@@ -222,6 +297,14 @@ type AugmentedAtomicSwap struct {
 	Status              SwapStatus                                           `protobuf:"varint,11,opt,name=status,proto3,casttype=SwapStatus" json:"status,omitempty" yaml:"status"`
 	CrossChain          bool                                                 `protobuf:"varint,12,opt,name=cross_chain,json=crossChain,proto3" json:"cross_chain,omitempty" yaml:"cross_chain"`
 	Direction           SwapDirection                                        `protobuf:"varint,13,opt,name=direction,proto3,casttype=SwapDirection" json:"direction,omitempty" yaml:"direction"`
+	// optional part of the amount paid to the address submitting the successful claim
+	ClaimTip         github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,14,rep,name=claim_tip,json=claimTip,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claim_tip" yaml:"claim_tip"`
+	ClosedTime       int64                                    `protobuf:"varint,15,opt,name=closed_time,json=closedTime,proto3" json:"closed_time,omitempty" yaml:"closed_time"`
+	Memo             string                                   `protobuf:"bytes,16,opt,name=memo,proto3" json:"memo,omitempty" yaml:"memo"`
+	OtherChainTxHash string                                   `protobuf:"bytes,17,opt,name=other_chain_tx_hash,json=otherChainTxHash,proto3" json:"other_chain_tx_hash,omitempty" yaml:"other_chain_tx_hash"`
+	DeputySlashed    bool                                     `protobuf:"varint,18,opt,name=deputy_slashed,json=deputySlashed,proto3" json:"deputy_slashed,omitempty" yaml:"deputy_slashed"`
+	PartialClaims    bool                                     `protobuf:"varint,19,opt,name=partial_claims,json=partialClaims,proto3" json:"partial_claims,omitempty" yaml:"partial_claims"`
+	RemainingAmount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,20,rep,name=remaining_amount,json=remainingAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining_amount" yaml:"remaining_amount"`
 }
 
 func (m *AugmentedAtomicSwap) Reset()         { *m = AugmentedAtomicSwap{} }
@@ -348,6 +431,55 @@ func (m *AugmentedAtomicSwap) GetDirection() SwapDirection {
 	return 0
 }
 
+func (m *AugmentedAtomicSwap) GetClaimTip() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClaimTip
+	}
+	return nil
+}
+
+func (m *AugmentedAtomicSwap) GetClosedTime() int64 {
+	if m != nil {
+		return m.ClosedTime
+	}
+	return 0
+}
+
+func (m *AugmentedAtomicSwap) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *AugmentedAtomicSwap) GetOtherChainTxHash() string {
+	if m != nil {
+		return m.OtherChainTxHash
+	}
+	return ""
+}
+
+func (m *AugmentedAtomicSwap) GetDeputySlashed() bool {
+	if m != nil {
+		return m.DeputySlashed
+	}
+	return false
+}
+
+func (m *AugmentedAtomicSwap) GetPartialClaims() bool {
+	if m != nil {
+		return m.PartialClaims
+	}
+	return false
+}
+
+func (m *AugmentedAtomicSwap) GetRemainingAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RemainingAmount
+	}
+	return nil
+}
+
 // MsgCreateAtomicSwap contains an AtomicSwap struct
 type MsgCreateAtomicSwap struct {
 	From                string                                               `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
@@ -359,6 +491,17 @@ type MsgCreateAtomicSwap struct {
 	Amount              github_com_cosmos_cosmos_sdk_types.Coins             `protobuf:"bytes,7,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
 	// minutes span before time expiration
 	TimeSpanMin int64 `protobuf:"varint,8,opt,name=time_span_min,json=timeSpanMin,proto3" json:"time_span_min,omitempty" yaml:"time_span_min"`
+	// optional part of the amount paid to the address submitting the successful claim
+	ClaimTip github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=claim_tip,json=claimTip,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claim_tip" yaml:"claim_tip"`
+	// optional reference stored with the swap
+	Memo string `protobuf:"bytes,10,opt,name=memo,proto3" json:"memo,omitempty" yaml:"memo"`
+	// optional hash of the counterparty transaction on the other chain
+	OtherChainTxHash string `protobuf:"bytes,11,opt,name=other_chain_tx_hash,json=otherChainTxHash,proto3" json:"other_chain_tx_hash,omitempty" yaml:"other_chain_tx_hash"`
+	// proof of the HTLC on the counterparty chain, required for incoming swaps of
+	// assets that verify them
+	Proof *SwapProof `protobuf:"bytes,12,opt,name=proof,proto3" json:"proof,omitempty" yaml:"proof"`
+	// allow the swap to be claimed in parts, see MsgClaimAtomicSwap.amount
+	PartialClaims bool `protobuf:"varint,13,opt,name=partial_claims,json=partialClaims,proto3" json:"partial_claims,omitempty" yaml:"partial_claims"`
 }
 
 func (m *MsgCreateAtomicSwap) Reset()      { *m = MsgCreateAtomicSwap{} }
@@ -449,24 +592,63 @@ func (m *MsgCreateAtomicSwap) GetTimeSpanMin() int64 {
 	return 0
 }
 
-// MsgClaimAtomicSwap defines a AtomicSwap claim
-type MsgClaimAtomicSwap struct {
-	From         string                                               `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
-	SwapID       github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=swap_id,json=swapId,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"swap_id,omitempty" yaml:"swap_id"`
-	RandomNumber github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,3,opt,name=random_number,json=randomNumber,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"random_number,omitempty" yaml:"random_number"`
+func (m *MsgCreateAtomicSwap) GetClaimTip() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClaimTip
+	}
+	return nil
 }
 
-func (m *MsgClaimAtomicSwap) Reset()      { *m = MsgClaimAtomicSwap{} }
-func (*MsgClaimAtomicSwap) ProtoMessage() {}
-func (*MsgClaimAtomicSwap) Descriptor() ([]byte, []int) {
+func (m *MsgCreateAtomicSwap) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *MsgCreateAtomicSwap) GetOtherChainTxHash() string {
+	if m != nil {
+		return m.OtherChainTxHash
+	}
+	return ""
+}
+
+func (m *MsgCreateAtomicSwap) GetProof() *SwapProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *MsgCreateAtomicSwap) GetPartialClaims() bool {
+	if m != nil {
+		return m.PartialClaims
+	}
+	return false
+}
+
+// SwapProof proves that the HTLC an incoming swap mirrors exists on the
+// counterparty chain. Both fields are encoded as expected by the verifier.
+type SwapProof struct {
+	// header of the counterparty chain, verified against the light client state
+	Header []byte `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty" yaml:"header"`
+	// Merkle proof of the inclusion of the HTLC in the state committed to by
+	// the header
+	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty" yaml:"proof"`
+}
+
+func (m *SwapProof) Reset()         { *m = SwapProof{} }
+func (m *SwapProof) String() string { return proto.CompactTextString(m) }
+func (*SwapProof) ProtoMessage()    {}
+func (*SwapProof) Descriptor() ([]byte, []int) {
 	return fileDescriptor_576398e36903b242, []int{4}
 }
-func (m *MsgClaimAtomicSwap) XXX_Unmarshal(b []byte) error {
+func (m *SwapProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgClaimAtomicSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SwapProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgClaimAtomicSwap.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SwapProof.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -476,56 +658,62 @@ func (m *MsgClaimAtomicSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgClaimAtomicSwap) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgClaimAtomicSwap.Merge(m, src)
+func (m *SwapProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapProof.Merge(m, src)
 }
-func (m *MsgClaimAtomicSwap) XXX_Size() int {
+func (m *SwapProof) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgClaimAtomicSwap) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgClaimAtomicSwap.DiscardUnknown(m)
+func (m *SwapProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapProof.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgClaimAtomicSwap proto.InternalMessageInfo
-
-func (m *MsgClaimAtomicSwap) GetFrom() string {
-	if m != nil {
-		return m.From
-	}
-	return ""
-}
+var xxx_messageInfo_SwapProof proto.InternalMessageInfo
 
-func (m *MsgClaimAtomicSwap) GetSwapID() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+func (m *SwapProof) GetHeader() []byte {
 	if m != nil {
-		return m.SwapID
+		return m.Header
 	}
 	return nil
 }
 
-func (m *MsgClaimAtomicSwap) GetRandomNumber() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+func (m *SwapProof) GetProof() []byte {
 	if m != nil {
-		return m.RandomNumber
+		return m.Proof
 	}
 	return nil
 }
 
-// MsgRefundAtomicSwap defines a refund msg
-type MsgRefundAtomicSwap struct {
-	From   string                                               `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
-	SwapID github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=swap_id,json=swapId,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"swap_id,omitempty" yaml:"swap_id"`
+// MsgCreateInterchainSwap creates an outgoing swap that is mirrored on a
+// Cosmos counterparty chain over the IBC channel instead of by a deputy
+type MsgCreateInterchainSwap struct {
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
+	// recipient of the mirrored swap on the counterparty chain
+	To               string                                               `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty" yaml:"to"`
+	RandomNumberHash github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,3,opt,name=random_number_hash,json=randomNumberHash,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"random_number_hash,omitempty" yaml:"random_number_hash"`
+	Timestamp        int64                                                `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty" yaml:"timestamp"`
+	Amount           github_com_cosmos_cosmos_sdk_types.Coins             `protobuf:"bytes,5,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+	// minutes span before the mirrored swap expires
+	TimeSpanMin   int64  `protobuf:"varint,6,opt,name=time_span_min,json=timeSpanMin,proto3" json:"time_span_min,omitempty" yaml:"time_span_min"`
+	SourcePort    string `protobuf:"bytes,7,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty" yaml:"source_port"`
+	SourceChannel string `protobuf:"bytes,8,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty" yaml:"source_channel"`
+	// counterparty height after which the packet times out, if not zero
+	TimeoutHeight types2.Height `protobuf:"bytes,9,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height" yaml:"timeout_height"`
+	// counterparty time in unix nanoseconds after which the packet times out, if not zero
+	TimeoutTimestamp uint64 `protobuf:"varint,10,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp"`
 }
 
-func (m *MsgRefundAtomicSwap) Reset()      { *m = MsgRefundAtomicSwap{} }
-func (*MsgRefundAtomicSwap) ProtoMessage() {}
-func (*MsgRefundAtomicSwap) Descriptor() ([]byte, []int) {
+func (m *MsgCreateInterchainSwap) Reset()      { *m = MsgCreateInterchainSwap{} }
+func (*MsgCreateInterchainSwap) ProtoMessage() {}
+func (*MsgCreateInterchainSwap) Descriptor() ([]byte, []int) {
 	return fileDescriptor_576398e36903b242, []int{5}
 }
-func (m *MsgRefundAtomicSwap) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateInterchainSwap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRefundAtomicSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateInterchainSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRefundAtomicSwap.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateInterchainSwap.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -535,50 +723,111 @@ func (m *MsgRefundAtomicSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgRefundAtomicSwap) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRefundAtomicSwap.Merge(m, src)
+func (m *MsgCreateInterchainSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateInterchainSwap.Merge(m, src)
 }
-func (m *MsgRefundAtomicSwap) XXX_Size() int {
+func (m *MsgCreateInterchainSwap) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRefundAtomicSwap) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRefundAtomicSwap.DiscardUnknown(m)
+func (m *MsgCreateInterchainSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateInterchainSwap.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRefundAtomicSwap proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateInterchainSwap proto.InternalMessageInfo
 
-func (m *MsgRefundAtomicSwap) GetFrom() string {
+func (m *MsgCreateInterchainSwap) GetFrom() string {
 	if m != nil {
 		return m.From
 	}
 	return ""
 }
 
-func (m *MsgRefundAtomicSwap) GetSwapID() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+func (m *MsgCreateInterchainSwap) GetTo() string {
 	if m != nil {
-		return m.SwapID
+		return m.To
+	}
+	return ""
+}
+
+func (m *MsgCreateInterchainSwap) GetRandomNumberHash() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.RandomNumberHash
 	}
 	return nil
 }
 
-// Proto type required for serializing the previous block time to manage supply
-// expirations.
-type PrevBlockTime struct {
-	Val time.Time `protobuf:"bytes,1,opt,name=val,proto3,stdtime" json:"val"`
+func (m *MsgCreateInterchainSwap) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
 }
 
-func (m *PrevBlockTime) Reset()         { *m = PrevBlockTime{} }
-func (m *PrevBlockTime) String() string { return proto.CompactTextString(m) }
-func (*PrevBlockTime) ProtoMessage()    {}
-func (*PrevBlockTime) Descriptor() ([]byte, []int) {
+func (m *MsgCreateInterchainSwap) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *MsgCreateInterchainSwap) GetTimeSpanMin() int64 {
+	if m != nil {
+		return m.TimeSpanMin
+	}
+	return 0
+}
+
+func (m *MsgCreateInterchainSwap) GetSourcePort() string {
+	if m != nil {
+		return m.SourcePort
+	}
+	return ""
+}
+
+func (m *MsgCreateInterchainSwap) GetSourceChannel() string {
+	if m != nil {
+		return m.SourceChannel
+	}
+	return ""
+}
+
+func (m *MsgCreateInterchainSwap) GetTimeoutHeight() types2.Height {
+	if m != nil {
+		return m.TimeoutHeight
+	}
+	return types2.Height{}
+}
+
+func (m *MsgCreateInterchainSwap) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
+// InterchainSwapPacketData is the data of the packet creating the mirrored
+// swap of an interchain swap on the counterparty chain
+type InterchainSwapPacketData struct {
+	Sender           string                                               `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Recipient        string                                               `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty" yaml:"recipient"`
+	RandomNumberHash github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,3,opt,name=random_number_hash,json=randomNumberHash,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"random_number_hash,omitempty" yaml:"random_number_hash"`
+	Timestamp        int64                                                `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty" yaml:"timestamp"`
+	Amount           github_com_cosmos_cosmos_sdk_types.Coins             `protobuf:"bytes,5,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+	TimeSpanMin      int64                                                `protobuf:"varint,6,opt,name=time_span_min,json=timeSpanMin,proto3" json:"time_span_min,omitempty" yaml:"time_span_min"`
+}
+
+func (m *InterchainSwapPacketData) Reset()         { *m = InterchainSwapPacketData{} }
+func (m *InterchainSwapPacketData) String() string { return proto.CompactTextString(m) }
+func (*InterchainSwapPacketData) ProtoMessage()    {}
+func (*InterchainSwapPacketData) Descriptor() ([]byte, []int) {
 	return fileDescriptor_576398e36903b242, []int{6}
 }
-func (m *PrevBlockTime) XXX_Unmarshal(b []byte) error {
+func (m *InterchainSwapPacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PrevBlockTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InterchainSwapPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PrevBlockTime.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InterchainSwapPacketData.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -588,789 +837,4757 @@ func (m *PrevBlockTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *PrevBlockTime) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PrevBlockTime.Merge(m, src)
+func (m *InterchainSwapPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterchainSwapPacketData.Merge(m, src)
 }
-func (m *PrevBlockTime) XXX_Size() int {
+func (m *InterchainSwapPacketData) XXX_Size() int {
 	return m.Size()
 }
-func (m *PrevBlockTime) XXX_DiscardUnknown() {
-	xxx_messageInfo_PrevBlockTime.DiscardUnknown(m)
+func (m *InterchainSwapPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterchainSwapPacketData.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PrevBlockTime proto.InternalMessageInfo
+var xxx_messageInfo_InterchainSwapPacketData proto.InternalMessageInfo
 
-func (m *PrevBlockTime) GetVal() time.Time {
+func (m *InterchainSwapPacketData) GetSender() string {
 	if m != nil {
-		return m.Val
+		return m.Sender
 	}
-	return time.Time{}
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*AtomicSwap)(nil), "bep3.AtomicSwap")
-	proto.RegisterType((*AugmentedAtomicSwaps)(nil), "bep3.AugmentedAtomicSwaps")
-	proto.RegisterType((*AugmentedAtomicSwap)(nil), "bep3.AugmentedAtomicSwap")
-	proto.RegisterType((*MsgCreateAtomicSwap)(nil), "bep3.MsgCreateAtomicSwap")
-	proto.RegisterType((*MsgClaimAtomicSwap)(nil), "bep3.MsgClaimAtomicSwap")
-	proto.RegisterType((*MsgRefundAtomicSwap)(nil), "bep3.MsgRefundAtomicSwap")
-	proto.RegisterType((*PrevBlockTime)(nil), "bep3.PrevBlockTime")
+func (m *InterchainSwapPacketData) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("bep3/swap.proto", fileDescriptor_576398e36903b242) }
+func (m *InterchainSwapPacketData) GetRandomNumberHash() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.RandomNumberHash
+	}
+	return nil
+}
 
-var fileDescriptor_576398e36903b242 = []byte{
-	// 1003 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x3f, 0x6f, 0xdb, 0x46,
-	0x14, 0x37, 0x25, 0x99, 0xb6, 0x4e, 0x52, 0xed, 0x52, 0x6a, 0xc2, 0xb8, 0xb5, 0x28, 0xb0, 0x0d,
-	0xa0, 0x0e, 0x21, 0x11, 0xa7, 0x68, 0x00, 0xa3, 0x28, 0x60, 0x3a, 0x68, 0x13, 0x14, 0x69, 0x03,
-	0xda, 0x5d, 0xba, 0x10, 0x47, 0xf2, 0x2c, 0x1d, 0x22, 0xf2, 0x04, 0xde, 0xc9, 0x89, 0xe7, 0xee,
-	0x45, 0xc6, 0x8e, 0x5d, 0x8a, 0x02, 0x5d, 0xfa, 0x35, 0x32, 0x66, 0xec, 0xc4, 0x14, 0xf2, 0x17,
-	0x28, 0x38, 0x76, 0x69, 0x71, 0x77, 0x94, 0x48, 0x25, 0x0a, 0x50, 0xcb, 0x41, 0x92, 0x49, 0xbc,
-	0xf7, 0xe7, 0xf7, 0x1e, 0xef, 0xbd, 0xf7, 0x7b, 0x22, 0xd8, 0xf2, 0xd1, 0xf8, 0x96, 0x4d, 0x1f,
-	0xc1, 0xb1, 0x35, 0x4e, 0x08, 0x23, 0x5a, 0x8d, 0x0b, 0x76, 0x3a, 0x03, 0x32, 0x20, 0x42, 0x60,
-	0xf3, 0x27, 0xa9, 0xdb, 0x31, 0x06, 0x84, 0x0c, 0x46, 0xc8, 0x16, 0x27, 0x7f, 0x72, 0x62, 0x33,
-	0x1c, 0x21, 0xca, 0x60, 0x94, 0x3b, 0xef, 0x74, 0x03, 0x42, 0x23, 0x42, 0x6d, 0x1f, 0x52, 0x64,
-	0x9f, 0xde, 0xf4, 0x11, 0x83, 0x37, 0xed, 0x80, 0xe0, 0x58, 0xea, 0xcd, 0x7f, 0x55, 0x00, 0x0e,
-	0x18, 0x89, 0x70, 0x70, 0xf4, 0x08, 0x8e, 0x35, 0x06, 0x54, 0x18, 0x91, 0x49, 0xcc, 0x74, 0xa5,
-	0x57, 0xed, 0x37, 0xf6, 0xae, 0x59, 0xd2, 0xdf, 0xe2, 0xfe, 0x56, 0xee, 0x6f, 0x1d, 0x12, 0x1c,
-	0x3b, 0x07, 0x4f, 0x53, 0x63, 0x2d, 0x4b, 0x8d, 0xd6, 0x19, 0x8c, 0x46, 0xfb, 0xa6, 0x74, 0x33,
-	0x7f, 0x7f, 0x6e, 0xf4, 0x07, 0x98, 0x0d, 0x27, 0xbe, 0x15, 0x90, 0xc8, 0xce, 0xa3, 0xcb, 0x9f,
-	0x1b, 0x34, 0x7c, 0x68, 0xb3, 0xb3, 0x31, 0xa2, 0x02, 0x81, 0xba, 0x79, 0x2c, 0xed, 0x47, 0x05,
-	0x68, 0x09, 0x8c, 0x43, 0x12, 0x79, 0xf1, 0x24, 0xf2, 0x51, 0xe2, 0x0d, 0x21, 0x1d, 0xea, 0x95,
-	0x9e, 0xd2, 0x6f, 0x3a, 0xdf, 0x67, 0xa9, 0x71, 0x4d, 0xc6, 0x78, 0xd9, 0xc6, 0xfc, 0x27, 0x35,
-	0x3e, 0x2b, 0xc5, 0x63, 0x28, 0x0e, 0x51, 0x12, 0xe1, 0x98, 0x95, 0x1f, 0x47, 0xd8, 0xa7, 0xb6,
-	0x7f, 0xc6, 0x10, 0xb5, 0xee, 0xa2, 0xc7, 0x0e, 0x7f, 0x70, 0xb7, 0x25, 0xd8, 0xb7, 0x02, 0xeb,
-	0x2e, 0xa4, 0x43, 0xed, 0x2b, 0xb0, 0x8d, 0x1e, 0x8f, 0x71, 0x82, 0xbc, 0xf9, 0x25, 0xea, 0xd5,
-	0x9e, 0xd2, 0xaf, 0x3a, 0x1f, 0x66, 0xa9, 0x71, 0x55, 0xa6, 0xf0, 0xa2, 0x85, 0xe9, 0x6e, 0x49,
-	0xd1, 0xf1, 0x4c, 0xa2, 0xed, 0x81, 0x7a, 0x01, 0x50, 0x13, 0x00, 0x9d, 0x2c, 0x35, 0xb6, 0x25,
-	0x40, 0xc9, 0xb3, 0x30, 0xd3, 0x3e, 0x05, 0x2a, 0x15, 0xf9, 0xea, 0xeb, 0x3d, 0xa5, 0x5f, 0x77,
-	0xde, 0x2f, 0x2e, 0x56, 0xca, 0x4d, 0x37, 0x37, 0xe0, 0xf0, 0x09, 0x0a, 0xf0, 0x18, 0xa3, 0x98,
-	0xe9, 0xaa, 0xb0, 0x2e, 0xc1, 0xcf, 0x55, 0xa6, 0x5b, 0x98, 0x69, 0xdf, 0x00, 0x4d, 0x7a, 0x7b,
-	0x84, 0x0d, 0x51, 0xe2, 0x05, 0x43, 0x88, 0x63, 0x7d, 0x43, 0x38, 0xef, 0x16, 0xf7, 0xfb, 0xb2,
-	0x8d, 0xe9, 0x6e, 0x4b, 0xe1, 0x77, 0x5c, 0x76, 0xc8, 0x45, 0xda, 0x31, 0xf8, 0x60, 0x8e, 0xbc,
-	0x80, 0xb7, 0x29, 0xf0, 0x7a, 0x59, 0x6a, 0x7c, 0xf4, 0x42, 0x32, 0x8b, 0x90, 0xed, 0xb9, 0xbc,
-	0x84, 0xba, 0x0f, 0x9a, 0xc1, 0x88, 0x50, 0x14, 0x7a, 0xfe, 0x88, 0x04, 0x0f, 0xf5, 0xba, 0xb8,
-	0xb8, 0xab, 0x59, 0x6a, 0xb4, 0x25, 0x58, 0x59, 0x6b, 0xba, 0x0d, 0x79, 0x74, 0xf8, 0x49, 0xbb,
-	0x0d, 0x54, 0xca, 0x20, 0x9b, 0x50, 0x1d, 0xf4, 0x94, 0x7e, 0xcb, 0x31, 0x4a, 0xb7, 0x27, 0xe4,
-	0xbc, 0x4d, 0x00, 0x6f, 0xf0, 0x23, 0x71, 0x74, 0x73, 0x73, 0xed, 0x36, 0x68, 0x04, 0x09, 0xa1,
-	0x34, 0x7f, 0x81, 0x46, 0x4f, 0xe9, 0x6f, 0x3a, 0x57, 0xb2, 0xd4, 0xd0, 0xf2, 0x98, 0x85, 0xd2,
-	0x74, 0x81, 0x38, 0xc9, 0x6c, 0x0f, 0x41, 0x3d, 0xc4, 0x09, 0x0a, 0x18, 0x26, 0xb1, 0xde, 0x14,
-	0x41, 0xaf, 0x17, 0x45, 0x98, 0xab, 0x78, 0xdc, 0x16, 0x8f, 0x7b, 0x67, 0x26, 0x71, 0x0b, 0xbf,
-	0xfd, 0xda, 0xcf, 0xbf, 0x18, 0x6b, 0xe6, 0x4f, 0x0a, 0xe8, 0x1c, 0x4c, 0x06, 0x11, 0x8a, 0x19,
-	0x0a, 0x8b, 0x51, 0xa4, 0xda, 0x29, 0xb8, 0x02, 0x67, 0x72, 0x0f, 0x0a, 0x85, 0xc7, 0x69, 0x81,
-	0xce, 0x67, 0x93, 0x13, 0x83, 0xb5, 0xc4, 0xd7, 0xb9, 0x9e, 0xcf, 0xe6, 0x6e, 0x3e, 0x9b, 0x4b,
-	0x61, 0x4c, 0xb7, 0x03, 0x97, 0xc4, 0x35, 0x7f, 0xdb, 0x00, 0xed, 0x25, 0xa0, 0xda, 0xc7, 0xa0,
-	0x82, 0x43, 0x5d, 0x11, 0x45, 0x6e, 0x4f, 0x53, 0xa3, 0x72, 0xef, 0x4e, 0x96, 0x1a, 0x75, 0x19,
-	0x02, 0x87, 0xa6, 0x5b, 0xc1, 0x61, 0x89, 0x40, 0x2a, 0x6f, 0x9f, 0x40, 0xaa, 0x6f, 0x9f, 0x40,
-	0x6a, 0x97, 0x25, 0x90, 0xf5, 0x8b, 0x12, 0x88, 0x7a, 0x21, 0x02, 0xd9, 0xb8, 0x0c, 0x81, 0x6c,
-	0xbe, 0x66, 0x02, 0xa9, 0xbf, 0x4e, 0x02, 0x01, 0x2b, 0x11, 0x48, 0xe3, 0x52, 0x04, 0xd2, 0x5c,
-	0x8d, 0x40, 0x5a, 0xab, 0x11, 0x88, 0xf9, 0x77, 0x0d, 0xb4, 0xef, 0xd3, 0xc1, 0x61, 0x82, 0x20,
-	0x43, 0x0b, 0x93, 0x5a, 0x3b, 0x49, 0x48, 0x94, 0xcf, 0xea, 0x56, 0x96, 0x1a, 0x0d, 0x89, 0xcb,
-	0xa5, 0xa6, 0x2b, 0x94, 0xda, 0x2e, 0xa8, 0x30, 0x22, 0x76, 0x6c, 0xdd, 0x69, 0x15, 0x83, 0xcc,
-	0x88, 0xe9, 0x56, 0x18, 0x79, 0x75, 0x91, 0xaa, 0x97, 0x29, 0xd2, 0xf2, 0x3e, 0xaa, 0xad, 0xd6,
-	0x47, 0xaf, 0x98, 0xfa, 0xf5, 0x37, 0x3b, 0xf5, 0x0b, 0xd3, 0xaa, 0xfe, 0xbf, 0x69, 0x2d, 0x58,
-	0x72, 0xe3, 0x0d, 0xb2, 0xe4, 0x17, 0xa0, 0xc5, 0x53, 0xf0, 0xe8, 0x18, 0xc6, 0x5e, 0x94, 0xcf,
-	0x6f, 0xd5, 0xd1, 0xb3, 0xd4, 0xe8, 0x14, 0xd9, 0xce, 0xd5, 0xa6, 0xdb, 0xe0, 0xe7, 0xa3, 0x31,
-	0x8c, 0xef, 0xe3, 0xd9, 0xb6, 0xfa, 0xb5, 0x02, 0x34, 0xde, 0x72, 0x23, 0x88, 0xa3, 0x8b, 0x76,
-	0x5c, 0x04, 0x36, 0xf8, 0xe2, 0xf1, 0x70, 0x98, 0xff, 0xb5, 0x3b, 0x9e, 0xa6, 0x86, 0xca, 0xfd,
-	0xc5, 0x26, 0x79, 0x2f, 0xaf, 0xbd, 0x34, 0x59, 0xbd, 0x44, 0x2a, 0x47, 0xb8, 0x17, 0x6a, 0x13,
-	0xd0, 0x5a, 0xa8, 0x7c, 0xbe, 0x0e, 0x1e, 0x14, 0xaf, 0xbb, 0xa0, 0x5e, 0x3d, 0x60, 0xb3, 0xdc,
-	0x13, 0xf9, 0x3d, 0xfd, 0xa1, 0x88, 0xd1, 0x74, 0xd1, 0xc9, 0x24, 0x0e, 0xdf, 0xed, 0x8b, 0xca,
-	0x33, 0xfe, 0x1a, 0xb4, 0x1e, 0x24, 0xe8, 0x54, 0x10, 0x22, 0xdf, 0x45, 0xda, 0xe7, 0xa0, 0x7a,
-	0x0a, 0x47, 0x22, 0xd3, 0xc6, 0xde, 0x8e, 0x25, 0xbf, 0x34, 0xac, 0xd9, 0x97, 0x86, 0x35, 0xdf,
-	0x57, 0xce, 0x26, 0x6f, 0xd1, 0x27, 0xcf, 0x0d, 0xc5, 0xe5, 0x0e, 0xce, 0x97, 0x4f, 0xa7, 0x5d,
-	0xe5, 0xd9, 0xb4, 0xab, 0xfc, 0x35, 0xed, 0x2a, 0x4f, 0xce, 0xbb, 0x6b, 0xcf, 0xce, 0xbb, 0x6b,
-	0x7f, 0x9e, 0x77, 0xd7, 0x7e, 0xf8, 0xa4, 0x94, 0x26, 0xba, 0x11, 0x91, 0x18, 0x9d, 0xd9, 0xe2,
-	0x6b, 0x27, 0x22, 0xe1, 0x64, 0x84, 0x64, 0xd3, 0xfa, 0xaa, 0x08, 0x71, 0xeb, 0xbf, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xcc, 0x45, 0x72, 0xca, 0x09, 0x0d, 0x00, 0x00,
+func (m *InterchainSwapPacketData) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
 }
 
-func (m *AtomicSwap) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *InterchainSwapPacketData) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AtomicSwap) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *InterchainSwapPacketData) GetTimeSpanMin() int64 {
+	if m != nil {
+		return m.TimeSpanMin
+	}
+	return 0
 }
 
-func (m *AtomicSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Direction != 0 {
-		i = encodeVarintSwap(dAtA, i, uint64(m.Direction))
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.CrossChain {
-		i--
-		if m.CrossChain {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+// MsgCreateLocalSwap creates a swap between two accounts of this chain. The counterparty locks another denom in a
+// swap under the same random number hash and timestamp, so that claiming one swap reveals the secret to the other.
+type MsgCreateLocalSwap struct {
+	From             string                                               `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
+	To               string                                               `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty" yaml:"to"`
+	RandomNumberHash github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,3,opt,name=random_number_hash,json=randomNumberHash,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"random_number_hash,omitempty" yaml:"random_number_hash"`
+	Timestamp        int64                                                `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty" yaml:"timestamp"`
+	Amount           github_com_cosmos_cosmos_sdk_types.Coins             `protobuf:"bytes,5,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+	TimeSpanMin      int64                                                `protobuf:"varint,6,opt,name=time_span_min,json=timeSpanMin,proto3" json:"time_span_min,omitempty" yaml:"time_span_min"`
+	Memo             string                                               `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty" yaml:"memo"`
+}
+
+func (m *MsgCreateLocalSwap) Reset()      { *m = MsgCreateLocalSwap{} }
+func (*MsgCreateLocalSwap) ProtoMessage() {}
+func (*MsgCreateLocalSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_576398e36903b242, []int{7}
+}
+func (m *MsgCreateLocalSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateLocalSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateLocalSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.Status != 0 {
-		i = encodeVarintSwap(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.ClosedBlock != 0 {
-		i = encodeVarintSwap(dAtA, i, uint64(m.ClosedBlock))
-		i--
-		dAtA[i] = 0x48
+		return b[:n], nil
 	}
-	if len(m.RecipientOtherChain) > 0 {
-		i -= len(m.RecipientOtherChain)
-		copy(dAtA[i:], m.RecipientOtherChain)
-		i = encodeVarintSwap(dAtA, i, uint64(len(m.RecipientOtherChain)))
-		i--
-		dAtA[i] = 0x42
+}
+func (m *MsgCreateLocalSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateLocalSwap.Merge(m, src)
+}
+func (m *MsgCreateLocalSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateLocalSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateLocalSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateLocalSwap proto.InternalMessageInfo
+
+func (m *MsgCreateLocalSwap) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	if len(m.SenderOtherChain) > 0 {
-		i -= len(m.SenderOtherChain)
-		copy(dAtA[i:], m.SenderOtherChain)
-		i = encodeVarintSwap(dAtA, i, uint64(len(m.SenderOtherChain)))
-		i--
-		dAtA[i] = 0x3a
+	return ""
+}
+
+func (m *MsgCreateLocalSwap) GetTo() string {
+	if m != nil {
+		return m.To
 	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintSwap(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+func (m *MsgCreateLocalSwap) GetRandomNumberHash() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.RandomNumberHash
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintSwap(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x2a
+	return nil
+}
+
+func (m *MsgCreateLocalSwap) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	if m.Timestamp != 0 {
-		i = encodeVarintSwap(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x20
+	return 0
+}
+
+func (m *MsgCreateLocalSwap) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	if m.ExpireTimestamp != 0 {
-		i = encodeVarintSwap(dAtA, i, uint64(m.ExpireTimestamp))
-		i--
-		dAtA[i] = 0x18
+	return nil
+}
+
+func (m *MsgCreateLocalSwap) GetTimeSpanMin() int64 {
+	if m != nil {
+		return m.TimeSpanMin
 	}
-	if len(m.RandomNumberHash) > 0 {
-		i -= len(m.RandomNumberHash)
-		copy(dAtA[i:], m.RandomNumberHash)
-		i = encodeVarintSwap(dAtA, i, uint64(len(m.RandomNumberHash)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *MsgCreateLocalSwap) GetMemo() string {
+	if m != nil {
+		return m.Memo
 	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwap(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	return ""
+}
+
+// MsgClaimAtomicSwap defines a AtomicSwap claim
+type MsgClaimAtomicSwap struct {
+	From         string                                               `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
+	SwapID       github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=swap_id,json=swapId,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"swap_id,omitempty" yaml:"swap_id"`
+	RandomNumber github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,3,opt,name=random_number,json=randomNumber,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"random_number,omitempty" yaml:"random_number"`
+	// the part of a swap with partial claims to claim, or empty to claim the
+	// remaining amount
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+}
+
+func (m *MsgClaimAtomicSwap) Reset()      { *m = MsgClaimAtomicSwap{} }
+func (*MsgClaimAtomicSwap) ProtoMessage() {}
+func (*MsgClaimAtomicSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_576398e36903b242, []int{8}
+}
+func (m *MsgClaimAtomicSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAtomicSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAtomicSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgClaimAtomicSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAtomicSwap.Merge(m, src)
+}
+func (m *MsgClaimAtomicSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAtomicSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAtomicSwap.DiscardUnknown(m)
 }
 
-func (m *AugmentedAtomicSwaps) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgClaimAtomicSwap proto.InternalMessageInfo
+
+func (m *MsgClaimAtomicSwap) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *AugmentedAtomicSwaps) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgClaimAtomicSwap) GetSwapID() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.SwapID
+	}
+	return nil
 }
 
-func (m *AugmentedAtomicSwaps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AugmentedAtomicSwaps) > 0 {
-		for iNdEx := len(m.AugmentedAtomicSwaps) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AugmentedAtomicSwaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwap(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *MsgClaimAtomicSwap) GetRandomNumber() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.RandomNumber
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *AugmentedAtomicSwap) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClaimAtomicSwap) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AugmentedAtomicSwap) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRefundAtomicSwap defines a refund msg
+type MsgRefundAtomicSwap struct {
+	From   string                                               `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
+	SwapID github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=swap_id,json=swapId,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"swap_id,omitempty" yaml:"swap_id"`
 }
 
-func (m *AugmentedAtomicSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Direction != 0 {
-		i = encodeVarintSwap(dAtA, i, uint64(m.Direction))
-		i--
-		dAtA[i] = 0x68
-	}
-	if m.CrossChain {
-		i--
-		if m.CrossChain {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgRefundAtomicSwap) Reset()      { *m = MsgRefundAtomicSwap{} }
+func (*MsgRefundAtomicSwap) ProtoMessage() {}
+func (*MsgRefundAtomicSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_576398e36903b242, []int{9}
+}
+func (m *MsgRefundAtomicSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundAtomicSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundAtomicSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x60
+		return b[:n], nil
 	}
-	if m.Status != 0 {
-		i = encodeVarintSwap(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.ClosedBlock != 0 {
-		i = encodeVarintSwap(dAtA, i, uint64(m.ClosedBlock))
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.RecipientOtherChain) > 0 {
-		i -= len(m.RecipientOtherChain)
-		copy(dAtA[i:], m.RecipientOtherChain)
-		i = encodeVarintSwap(dAtA, i, uint64(len(m.RecipientOtherChain)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.SenderOtherChain) > 0 {
-		i -= len(m.SenderOtherChain)
-		copy(dAtA[i:], m.SenderOtherChain)
-		i = encodeVarintSwap(dAtA, i, uint64(len(m.SenderOtherChain)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintSwap(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintSwap(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Timestamp != 0 {
-		i = encodeVarintSwap(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.ExpireTimestamp != 0 {
-		i = encodeVarintSwap(dAtA, i, uint64(m.ExpireTimestamp))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.RandomNumberHash) > 0 {
-		i -= len(m.RandomNumberHash)
-		copy(dAtA[i:], m.RandomNumberHash)
-		i = encodeVarintSwap(dAtA, i, uint64(len(m.RandomNumberHash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwap(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintSwap(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRefundAtomicSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundAtomicSwap.Merge(m, src)
+}
+func (m *MsgRefundAtomicSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundAtomicSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundAtomicSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefundAtomicSwap proto.InternalMessageInfo
+
+func (m *MsgRefundAtomicSwap) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateAtomicSwap) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRefundAtomicSwap) GetSwapID() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.SwapID
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCreateAtomicSwap) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgAnnotateSwap attaches the counterparty transaction hash to an existing
+// swap. Only the deputy of the swap's asset may annotate a swap and the hash
+// cannot be changed once set.
+type MsgAnnotateSwap struct {
+	From             string                                               `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
+	SwapID           github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=swap_id,json=swapId,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"swap_id,omitempty" yaml:"swap_id"`
+	OtherChainTxHash string                                               `protobuf:"bytes,3,opt,name=other_chain_tx_hash,json=otherChainTxHash,proto3" json:"other_chain_tx_hash,omitempty" yaml:"other_chain_tx_hash"`
 }
 
-func (m *MsgCreateAtomicSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TimeSpanMin != 0 {
-		i = encodeVarintSwap(dAtA, i, uint64(m.TimeSpanMin))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwap(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
+func (m *MsgAnnotateSwap) Reset()      { *m = MsgAnnotateSwap{} }
+func (*MsgAnnotateSwap) ProtoMessage() {}
+func (*MsgAnnotateSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_576398e36903b242, []int{10}
+}
+func (m *MsgAnnotateSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAnnotateSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAnnotateSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Timestamp != 0 {
-		i = encodeVarintSwap(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.RandomNumberHash) > 0 {
-		i -= len(m.RandomNumberHash)
-		copy(dAtA[i:], m.RandomNumberHash)
-		i = encodeVarintSwap(dAtA, i, uint64(len(m.RandomNumberHash)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.SenderOtherChain) > 0 {
-		i -= len(m.SenderOtherChain)
-		copy(dAtA[i:], m.SenderOtherChain)
-		i = encodeVarintSwap(dAtA, i, uint64(len(m.SenderOtherChain)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.RecipientOtherChain) > 0 {
-		i -= len(m.RecipientOtherChain)
-		copy(dAtA[i:], m.RecipientOtherChain)
-		i = encodeVarintSwap(dAtA, i, uint64(len(m.RecipientOtherChain)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintSwap(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgAnnotateSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAnnotateSwap.Merge(m, src)
+}
+func (m *MsgAnnotateSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAnnotateSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAnnotateSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAnnotateSwap proto.InternalMessageInfo
+
+func (m *MsgAnnotateSwap) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintSwap(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgAnnotateSwap) GetSwapID() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.SwapID
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgClaimAtomicSwap) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAnnotateSwap) GetOtherChainTxHash() string {
+	if m != nil {
+		return m.OtherChainTxHash
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgClaimAtomicSwap) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgDeputyBond bonds collateral of a deputy into the module account
+type MsgDeputyBond struct {
+	From   string                                   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
 }
 
-func (m *MsgClaimAtomicSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RandomNumber) > 0 {
-		i -= len(m.RandomNumber)
-		copy(dAtA[i:], m.RandomNumber)
-		i = encodeVarintSwap(dAtA, i, uint64(len(m.RandomNumber)))
-		i--
-		dAtA[i] = 0x1a
+func (m *MsgDeputyBond) Reset()      { *m = MsgDeputyBond{} }
+func (*MsgDeputyBond) ProtoMessage() {}
+func (*MsgDeputyBond) Descriptor() ([]byte, []int) {
+	return fileDescriptor_576398e36903b242, []int{11}
+}
+func (m *MsgDeputyBond) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeputyBond) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeputyBond.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.SwapID) > 0 {
-		i -= len(m.SwapID)
-		copy(dAtA[i:], m.SwapID)
-		i = encodeVarintSwap(dAtA, i, uint64(len(m.SwapID)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgDeputyBond) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeputyBond.Merge(m, src)
+}
+func (m *MsgDeputyBond) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeputyBond) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeputyBond.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeputyBond proto.InternalMessageInfo
+
+func (m *MsgDeputyBond) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintSwap(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgDeputyBond) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgRefundAtomicSwap) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgDeputyUnbond starts returning bonded collateral to a deputy. The
+// collateral remains slashable until the unbonding period has passed.
+type MsgDeputyUnbond struct {
+	From   string                                   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
 }
 
-func (m *MsgRefundAtomicSwap) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDeputyUnbond) Reset()      { *m = MsgDeputyUnbond{} }
+func (*MsgDeputyUnbond) ProtoMessage() {}
+func (*MsgDeputyUnbond) Descriptor() ([]byte, []int) {
+	return fileDescriptor_576398e36903b242, []int{12}
 }
-
-func (m *MsgRefundAtomicSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SwapID) > 0 {
-		i -= len(m.SwapID)
-		copy(dAtA[i:], m.SwapID)
-		i = encodeVarintSwap(dAtA, i, uint64(len(m.SwapID)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgDeputyUnbond) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeputyUnbond) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeputyUnbond.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintSwap(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgDeputyUnbond) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeputyUnbond.Merge(m, src)
+}
+func (m *MsgDeputyUnbond) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeputyUnbond) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeputyUnbond.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeputyUnbond proto.InternalMessageInfo
+
+func (m *MsgDeputyUnbond) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *PrevBlockTime) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDeputyUnbond) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PrevBlockTime) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BatchClaimItem is a single swap claim within a MsgBatchClaimAtomicSwaps
+type BatchClaimItem struct {
+	SwapID       github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=swap_id,json=swapId,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"swap_id,omitempty" yaml:"swap_id"`
+	RandomNumber github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=random_number,json=randomNumber,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"random_number,omitempty" yaml:"random_number"`
 }
 
-func (m *PrevBlockTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Val, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Val):])
-	if err1 != nil {
-		return 0, err1
+func (m *BatchClaimItem) Reset()         { *m = BatchClaimItem{} }
+func (m *BatchClaimItem) String() string { return proto.CompactTextString(m) }
+func (*BatchClaimItem) ProtoMessage()    {}
+func (*BatchClaimItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_576398e36903b242, []int{13}
+}
+func (m *BatchClaimItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchClaimItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchClaimItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i -= n1
-	i = encodeVarintSwap(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *BatchClaimItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchClaimItem.Merge(m, src)
+}
+func (m *BatchClaimItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchClaimItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchClaimItem.DiscardUnknown(m)
 }
 
-func encodeVarintSwap(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSwap(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_BatchClaimItem proto.InternalMessageInfo
+
+func (m *BatchClaimItem) GetSwapID() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.SwapID
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *AtomicSwap) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *BatchClaimItem) GetRandomNumber() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.RandomNumber
 	}
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovSwap(uint64(l))
+	return nil
+}
+
+// MsgBatchClaimAtomicSwaps defines a msg claiming several atomic swaps at once.
+// When atomic is set the whole batch fails if any claim fails, otherwise each
+// claim is applied independently.
+type MsgBatchClaimAtomicSwaps struct {
+	From   string           `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
+	Claims []BatchClaimItem `protobuf:"bytes,2,rep,name=claims,proto3" json:"claims" yaml:"claims"`
+	Atomic bool             `protobuf:"varint,3,opt,name=atomic,proto3" json:"atomic,omitempty" yaml:"atomic"`
+}
+
+func (m *MsgBatchClaimAtomicSwaps) Reset()      { *m = MsgBatchClaimAtomicSwaps{} }
+func (*MsgBatchClaimAtomicSwaps) ProtoMessage() {}
+func (*MsgBatchClaimAtomicSwaps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_576398e36903b242, []int{14}
+}
+func (m *MsgBatchClaimAtomicSwaps) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchClaimAtomicSwaps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchClaimAtomicSwaps.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.RandomNumberHash)
-	if l > 0 {
-		n += 1 + l + sovSwap(uint64(l))
-	}
-	if m.ExpireTimestamp != 0 {
-		n += 1 + sovSwap(uint64(m.ExpireTimestamp))
-	}
-	if m.Timestamp != 0 {
-		n += 1 + sovSwap(uint64(m.Timestamp))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovSwap(uint64(l))
-	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovSwap(uint64(l))
-	}
-	l = len(m.SenderOtherChain)
-	if l > 0 {
-		n += 1 + l + sovSwap(uint64(l))
-	}
-	l = len(m.RecipientOtherChain)
-	if l > 0 {
-		n += 1 + l + sovSwap(uint64(l))
-	}
-	if m.ClosedBlock != 0 {
-		n += 1 + sovSwap(uint64(m.ClosedBlock))
-	}
-	if m.Status != 0 {
-		n += 1 + sovSwap(uint64(m.Status))
-	}
-	if m.CrossChain {
-		n += 2
+}
+func (m *MsgBatchClaimAtomicSwaps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchClaimAtomicSwaps.Merge(m, src)
+}
+func (m *MsgBatchClaimAtomicSwaps) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchClaimAtomicSwaps) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchClaimAtomicSwaps.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchClaimAtomicSwaps proto.InternalMessageInfo
+
+func (m *MsgBatchClaimAtomicSwaps) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	if m.Direction != 0 {
-		n += 1 + sovSwap(uint64(m.Direction))
+	return ""
+}
+
+func (m *MsgBatchClaimAtomicSwaps) GetClaims() []BatchClaimItem {
+	if m != nil {
+		return m.Claims
 	}
-	return n
+	return nil
 }
 
-func (m *AugmentedAtomicSwaps) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBatchClaimAtomicSwaps) GetAtomic() bool {
+	if m != nil {
+		return m.Atomic
 	}
-	var l int
-	_ = l
-	if len(m.AugmentedAtomicSwaps) > 0 {
-		for _, e := range m.AugmentedAtomicSwaps {
-			l = e.Size()
-			n += 1 + l + sovSwap(uint64(l))
+	return false
+}
+
+// MsgBatchRefundAtomicSwaps defines a msg refunding several atomic swaps at
+// once. When atomic is set the whole batch fails if any refund fails,
+// otherwise each refund is applied independently.
+type MsgBatchRefundAtomicSwaps struct {
+	From    string                                                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
+	SwapIDs []github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,2,rep,name=swap_ids,json=swapIds,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"swap_ids,omitempty" yaml:"swap_ids"`
+	Atomic  bool                                                   `protobuf:"varint,3,opt,name=atomic,proto3" json:"atomic,omitempty" yaml:"atomic"`
+}
+
+func (m *MsgBatchRefundAtomicSwaps) Reset()      { *m = MsgBatchRefundAtomicSwaps{} }
+func (*MsgBatchRefundAtomicSwaps) ProtoMessage() {}
+func (*MsgBatchRefundAtomicSwaps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_576398e36903b242, []int{15}
+}
+func (m *MsgBatchRefundAtomicSwaps) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchRefundAtomicSwaps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchRefundAtomicSwaps.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgBatchRefundAtomicSwaps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchRefundAtomicSwaps.Merge(m, src)
+}
+func (m *MsgBatchRefundAtomicSwaps) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchRefundAtomicSwaps) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchRefundAtomicSwaps.DiscardUnknown(m)
 }
 
-func (m *AugmentedAtomicSwap) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgBatchRefundAtomicSwaps proto.InternalMessageInfo
+
+func (m *MsgBatchRefundAtomicSwaps) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovSwap(uint64(l))
+	return ""
+}
+
+func (m *MsgBatchRefundAtomicSwaps) GetSwapIDs() []github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.SwapIDs
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovSwap(uint64(l))
+	return nil
+}
+
+func (m *MsgBatchRefundAtomicSwaps) GetAtomic() bool {
+	if m != nil {
+		return m.Atomic
+	}
+	return false
+}
+
+// Proto type required for serializing the previous block time to manage supply
+// expirations.
+type PrevBlockTime struct {
+	Val time.Time `protobuf:"bytes,1,opt,name=val,proto3,stdtime" json:"val"`
+}
+
+func (m *PrevBlockTime) Reset()         { *m = PrevBlockTime{} }
+func (m *PrevBlockTime) String() string { return proto.CompactTextString(m) }
+func (*PrevBlockTime) ProtoMessage()    {}
+func (*PrevBlockTime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_576398e36903b242, []int{16}
+}
+func (m *PrevBlockTime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrevBlockTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrevBlockTime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.RandomNumberHash)
-	if l > 0 {
-		n += 1 + l + sovSwap(uint64(l))
+}
+func (m *PrevBlockTime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrevBlockTime.Merge(m, src)
+}
+func (m *PrevBlockTime) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrevBlockTime) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrevBlockTime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrevBlockTime proto.InternalMessageInfo
+
+func (m *PrevBlockTime) GetVal() time.Time {
+	if m != nil {
+		return m.Val
 	}
-	if m.ExpireTimestamp != 0 {
-		n += 1 + sovSwap(uint64(m.ExpireTimestamp))
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*AtomicSwap)(nil), "bep3.AtomicSwap")
+	proto.RegisterType((*AugmentedAtomicSwaps)(nil), "bep3.AugmentedAtomicSwaps")
+	proto.RegisterType((*AugmentedAtomicSwap)(nil), "bep3.AugmentedAtomicSwap")
+	proto.RegisterType((*MsgCreateAtomicSwap)(nil), "bep3.MsgCreateAtomicSwap")
+	proto.RegisterType((*SwapProof)(nil), "bep3.SwapProof")
+	proto.RegisterType((*MsgCreateInterchainSwap)(nil), "bep3.MsgCreateInterchainSwap")
+	proto.RegisterType((*InterchainSwapPacketData)(nil), "bep3.InterchainSwapPacketData")
+	proto.RegisterType((*MsgCreateLocalSwap)(nil), "bep3.MsgCreateLocalSwap")
+	proto.RegisterType((*MsgClaimAtomicSwap)(nil), "bep3.MsgClaimAtomicSwap")
+	proto.RegisterType((*MsgRefundAtomicSwap)(nil), "bep3.MsgRefundAtomicSwap")
+	proto.RegisterType((*MsgAnnotateSwap)(nil), "bep3.MsgAnnotateSwap")
+	proto.RegisterType((*MsgDeputyBond)(nil), "bep3.MsgDeputyBond")
+	proto.RegisterType((*MsgDeputyUnbond)(nil), "bep3.MsgDeputyUnbond")
+	proto.RegisterType((*BatchClaimItem)(nil), "bep3.BatchClaimItem")
+	proto.RegisterType((*MsgBatchClaimAtomicSwaps)(nil), "bep3.MsgBatchClaimAtomicSwaps")
+	proto.RegisterType((*MsgBatchRefundAtomicSwaps)(nil), "bep3.MsgBatchRefundAtomicSwaps")
+	proto.RegisterType((*PrevBlockTime)(nil), "bep3.PrevBlockTime")
+}
+
+func init() { proto.RegisterFile("bep3/swap.proto", fileDescriptor_576398e36903b242) }
+
+var fileDescriptor_576398e36903b242 = []byte{
+	// 1781 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcd, 0x6f, 0xe3, 0xc6,
+	0x15, 0x37, 0x25, 0x59, 0xb6, 0x46, 0x92, 0x25, 0xd3, 0xde, 0x2c, 0xd7, 0xc9, 0x8a, 0xea, 0xa4,
+	0x29, 0xd4, 0x43, 0x24, 0xd8, 0x29, 0xba, 0xc0, 0xa2, 0x28, 0x6a, 0xca, 0x68, 0xd7, 0x48, 0xdd,
+	0x1a, 0x5c, 0xa7, 0x87, 0x1e, 0xc2, 0x8e, 0xc8, 0x59, 0x89, 0x58, 0x91, 0xc3, 0x92, 0x23, 0x67,
+	0x0d, 0xf4, 0xd6, 0x7b, 0x91, 0xde, 0x0a, 0xf4, 0x52, 0xf4, 0xd8, 0x4b, 0x8b, 0x02, 0xfd, 0x1f,
+	0x72, 0xcc, 0xb1, 0x97, 0x32, 0x85, 0xb7, 0x40, 0x81, 0xde, 0xca, 0x43, 0x81, 0xe6, 0x54, 0xcc,
+	0x07, 0x45, 0xd2, 0xf1, 0x36, 0x96, 0xd6, 0xb0, 0x37, 0x41, 0x4e, 0xe6, 0xbc, 0x79, 0xef, 0xcd,
+	0x9b, 0x79, 0x1f, 0xbf, 0x37, 0x23, 0x83, 0xd6, 0x08, 0x07, 0xef, 0x0c, 0xa2, 0x0f, 0x50, 0xd0,
+	0x0f, 0x42, 0x42, 0x89, 0x5a, 0x61, 0x84, 0x9d, 0xed, 0x31, 0x19, 0x13, 0x4e, 0x18, 0xb0, 0x2f,
+	0x31, 0xb7, 0xa3, 0x8f, 0x09, 0x19, 0x4f, 0xf1, 0x80, 0x8f, 0x46, 0xb3, 0x27, 0x03, 0xea, 0x7a,
+	0x38, 0xa2, 0xc8, 0x93, 0xc2, 0x3b, 0x1d, 0x9b, 0x44, 0x1e, 0x89, 0x06, 0x23, 0x14, 0xe1, 0xc1,
+	0xe9, 0xee, 0x08, 0x53, 0xb4, 0x3b, 0xb0, 0x89, 0xeb, 0xcb, 0xf9, 0xaf, 0xb9, 0x23, 0x7b, 0x60,
+	0x93, 0x10, 0x0f, 0xec, 0x09, 0xf2, 0x7d, 0x3c, 0x1d, 0x9c, 0xee, 0xa6, 0x9f, 0xe9, 0x1a, 0x19,
+	0xcb, 0xd4, 0xc5, 0x3e, 0xe5, 0x1c, 0xfc, 0x4b, 0x30, 0xc0, 0xdf, 0x37, 0x00, 0xd8, 0xa7, 0xc4,
+	0x73, 0xed, 0xc7, 0x1f, 0xa0, 0x40, 0xa5, 0xa0, 0x8a, 0x3c, 0x32, 0xf3, 0xa9, 0xa6, 0x74, 0xcb,
+	0xbd, 0xfa, 0xde, 0xbd, 0xbe, 0xb0, 0xa1, 0xcf, 0x6c, 0xe8, 0x4b, 0x1b, 0xfa, 0x43, 0xe2, 0xfa,
+	0xc6, 0xfe, 0x47, 0xb1, 0xbe, 0x92, 0xc4, 0x7a, 0xf3, 0x0c, 0x79, 0xd3, 0x87, 0x50, 0x88, 0xc1,
+	0x3f, 0x7c, 0xa2, 0xf7, 0xc6, 0x2e, 0x9d, 0xcc, 0x46, 0x7d, 0x9b, 0x78, 0x03, 0xb9, 0x03, 0xf1,
+	0xe7, 0xed, 0xc8, 0x79, 0x3a, 0xa0, 0x67, 0x01, 0x8e, 0xb8, 0x86, 0xc8, 0x94, 0x6b, 0xa9, 0xbf,
+	0x54, 0x80, 0x1a, 0x22, 0xdf, 0x21, 0x9e, 0xe5, 0xcf, 0xbc, 0x11, 0x0e, 0xad, 0x09, 0x8a, 0x26,
+	0x5a, 0xa9, 0xab, 0xf4, 0x1a, 0xc6, 0x7b, 0x49, 0xac, 0xdf, 0x13, 0x6b, 0x7c, 0x96, 0x07, 0x7e,
+	0x1a, 0xeb, 0xdf, 0xca, 0xad, 0x47, 0xb1, 0xef, 0xe0, 0xd0, 0x73, 0x7d, 0x9a, 0xff, 0x9c, 0xba,
+	0xa3, 0x68, 0x30, 0x3a, 0xa3, 0x38, 0xea, 0x3f, 0xc2, 0xcf, 0x0c, 0xf6, 0x61, 0xb6, 0x85, 0xb2,
+	0x1f, 0x71, 0x5d, 0x8f, 0x50, 0x34, 0x51, 0xbf, 0x0f, 0xda, 0xf8, 0x59, 0xe0, 0x86, 0xd8, 0x9a,
+	0x3b, 0x42, 0x2b, 0x77, 0x95, 0x5e, 0xd9, 0x78, 0x3d, 0x89, 0xf5, 0xbb, 0xc2, 0x84, 0x8b, 0x1c,
+	0xd0, 0x6c, 0x09, 0xd2, 0x49, 0x4a, 0x51, 0xf7, 0x40, 0x2d, 0x53, 0x50, 0xe1, 0x0a, 0xb6, 0x93,
+	0x58, 0x6f, 0x0b, 0x05, 0x39, 0xc9, 0x8c, 0x4d, 0xfd, 0x26, 0xa8, 0x46, 0xdc, 0x5e, 0x6d, 0xb5,
+	0xab, 0xf4, 0x6a, 0xc6, 0x66, 0x76, 0xb0, 0x82, 0x0e, 0x4d, 0xc9, 0xc0, 0xd4, 0x87, 0xd8, 0x76,
+	0x03, 0xe6, 0x44, 0xad, 0xca, 0xb9, 0x73, 0xea, 0xe7, 0x53, 0xd0, 0xcc, 0xd8, 0xd4, 0x77, 0x81,
+	0x2a, 0xa4, 0x2d, 0x42, 0x27, 0x38, 0xb4, 0xec, 0x09, 0x72, 0x7d, 0x6d, 0x8d, 0x0b, 0xdf, 0xcf,
+	0xce, 0xf7, 0xb3, 0x3c, 0xd0, 0x6c, 0x0b, 0xe2, 0x8f, 0x19, 0x6d, 0xc8, 0x48, 0xea, 0x09, 0xb8,
+	0x33, 0xd7, 0x5c, 0xd0, 0xb7, 0xce, 0xf5, 0x75, 0x93, 0x58, 0x7f, 0xe3, 0x82, 0x31, 0x45, 0x95,
+	0x5b, 0x73, 0x7a, 0x4e, 0xeb, 0x43, 0xd0, 0xb0, 0xa7, 0x24, 0xc2, 0x8e, 0x35, 0x9a, 0x12, 0xfb,
+	0xa9, 0x56, 0xe3, 0x07, 0x77, 0x37, 0x89, 0xf5, 0x2d, 0xa1, 0x2c, 0x3f, 0x0b, 0xcd, 0xba, 0x18,
+	0x1a, 0x6c, 0xa4, 0x3e, 0x00, 0xd5, 0x88, 0x22, 0x3a, 0x8b, 0x34, 0xd0, 0x55, 0x7a, 0x4d, 0x43,
+	0xcf, 0x9d, 0x1e, 0xa7, 0xb3, 0x30, 0x01, 0x2c, 0xc0, 0x1f, 0xf3, 0xa1, 0x29, 0xd9, 0xd5, 0x07,
+	0xa0, 0x6e, 0x87, 0x24, 0x8a, 0xe4, 0x06, 0xea, 0x5d, 0xa5, 0xb7, 0x6e, 0xbc, 0x96, 0xc4, 0xba,
+	0x2a, 0xd7, 0xcc, 0x26, 0xa1, 0x09, 0xf8, 0x48, 0x58, 0x3b, 0x04, 0x35, 0xc7, 0x0d, 0xb1, 0x4d,
+	0x5d, 0xe2, 0x6b, 0x0d, 0xbe, 0xe8, 0x5b, 0x99, 0x13, 0xe6, 0x53, 0x6c, 0xdd, 0x26, 0x5b, 0xf7,
+	0x20, 0xa5, 0x98, 0x99, 0x9c, 0xfa, 0x0b, 0x50, 0xb3, 0xa7, 0xc8, 0xf5, 0x2c, 0xea, 0x06, 0x5a,
+	0xf3, 0xf3, 0xf2, 0xed, 0x40, 0xe6, 0x5b, 0x3b, 0x3d, 0x0e, 0x29, 0xb9, 0x58, 0xca, 0xad, 0x73,
+	0xb9, 0x13, 0x37, 0xe0, 0x7b, 0x17, 0x47, 0xca, 0xc2, 0x50, 0xdb, 0xe0, 0xe7, 0x9d, 0xdf, 0x7b,
+	0x36, 0xc9, 0xf6, 0xce, 0x47, 0x2c, 0xc8, 0xd5, 0x37, 0x41, 0xc5, 0xc3, 0x1e, 0xd1, 0x5a, 0xdc,
+	0xdd, 0xad, 0x24, 0xd6, 0xeb, 0x42, 0x82, 0x51, 0xa1, 0xc9, 0x27, 0xd5, 0x23, 0xb0, 0x95, 0xf3,
+	0xb9, 0x45, 0x9f, 0x89, 0x94, 0x6e, 0x73, 0x99, 0x4e, 0x12, 0xeb, 0x3b, 0x42, 0xe6, 0x12, 0x26,
+	0x68, 0xb6, 0xc9, 0x3c, 0x2e, 0x4e, 0x9e, 0xf1, 0xdc, 0xfc, 0x1e, 0xd8, 0x70, 0x70, 0x30, 0xa3,
+	0x67, 0x56, 0x34, 0x45, 0xd1, 0x04, 0x3b, 0xda, 0x26, 0xf7, 0xd5, 0xbd, 0x24, 0xd6, 0xef, 0xc8,
+	0x43, 0x2f, 0xcc, 0x43, 0xb3, 0x29, 0x08, 0x8f, 0xc5, 0x58, 0x7d, 0x1f, 0x00, 0x77, 0x64, 0x5b,
+	0x01, 0xb2, 0x9f, 0x62, 0xaa, 0xa9, 0x5d, 0xa5, 0x57, 0xdf, 0x7b, 0xbd, 0xef, 0x8e, 0xec, 0x3e,
+	0x2b, 0x8f, 0xfd, 0xb4, 0x6c, 0x9e, 0xee, 0xf6, 0x8f, 0x39, 0x8b, 0x01, 0xcf, 0x63, 0xbd, 0x76,
+	0x68, 0x0c, 0xc5, 0x30, 0x89, 0xf5, 0x4d, 0xb1, 0x4e, 0xa6, 0x05, 0x9a, 0x35, 0x77, 0x64, 0x8b,
+	0x79, 0x66, 0x61, 0x80, 0x42, 0xea, 0xa2, 0xa9, 0xc5, 0x8f, 0x38, 0xd2, 0xb6, 0x2e, 0x5a, 0x58,
+	0x9c, 0x87, 0x66, 0x53, 0x12, 0x86, 0x7c, 0xac, 0xfe, 0x5a, 0x01, 0xed, 0x10, 0x7b, 0xc8, 0xf5,
+	0x5d, 0x7f, 0x6c, 0xc9, 0x32, 0xbc, 0xfd, 0x79, 0x61, 0xf1, 0xae, 0x0c, 0x8b, 0xbb, 0x69, 0xca,
+	0x15, 0x15, 0x2c, 0x16, 0x1d, 0xad, 0xb9, 0xf8, 0x3e, 0x97, 0x7e, 0x58, 0xf9, 0xcd, 0xef, 0xf4,
+	0x15, 0xf8, 0x2b, 0x05, 0x6c, 0xef, 0xcf, 0xc6, 0x1e, 0xf6, 0x29, 0x76, 0x32, 0xb4, 0x88, 0xd4,
+	0x53, 0xf0, 0x1a, 0x4a, 0xe9, 0x16, 0xe2, 0x13, 0x16, 0x43, 0xbf, 0x68, 0x0e, 0x1f, 0x0c, 0xff,
+	0xfa, 0x97, 0xc8, 0x1a, 0x6f, 0x49, 0xbb, 0xef, 0x4b, 0xf8, 0xb8, 0x54, 0x0d, 0x34, 0xb7, 0xd1,
+	0x25, 0xeb, 0xc2, 0x7f, 0xd5, 0xc1, 0xd6, 0x25, 0x4a, 0xd5, 0x37, 0x41, 0xc9, 0x75, 0x34, 0x85,
+	0x07, 0xd9, 0xd6, 0x79, 0xac, 0x97, 0x0e, 0x0f, 0x92, 0x58, 0xaf, 0x49, 0xc7, 0x39, 0xd0, 0x2c,
+	0xb9, 0x4e, 0x0e, 0xe3, 0x4a, 0xb7, 0x8f, 0x71, 0xe5, 0xdb, 0xc7, 0xb8, 0xca, 0xcb, 0x62, 0xdc,
+	0xea, 0xa2, 0x18, 0x57, 0x5d, 0x08, 0xe3, 0xd6, 0x5e, 0x06, 0xe3, 0xd6, 0xaf, 0x19, 0xe3, 0x6a,
+	0xd7, 0x89, 0x71, 0x60, 0x29, 0x8c, 0xab, 0xbf, 0x14, 0xc6, 0x35, 0x96, 0xc3, 0xb8, 0xe6, 0x75,
+	0x60, 0xdc, 0xc6, 0x2d, 0x63, 0x5c, 0x6b, 0x61, 0x8c, 0x6b, 0x2f, 0x81, 0x71, 0x9b, 0xd7, 0x86,
+	0x71, 0xea, 0x82, 0x18, 0xf7, 0xa5, 0xc4, 0x20, 0xf8, 0xb7, 0x35, 0xb0, 0x75, 0x14, 0x8d, 0x87,
+	0x21, 0x46, 0x14, 0x17, 0x8a, 0x7d, 0xe5, 0x49, 0x48, 0x3c, 0x4d, 0xb9, 0xe8, 0x23, 0x46, 0x85,
+	0x26, 0x9f, 0x54, 0xef, 0x83, 0x12, 0x25, 0xfc, 0x26, 0x51, 0x33, 0x9a, 0x19, 0x16, 0x50, 0x02,
+	0xcd, 0x12, 0x25, 0x2f, 0xce, 0xf3, 0xf2, 0xcb, 0xe4, 0xf9, 0xe5, 0xa5, 0xa8, 0xb2, 0x5c, 0x29,
+	0x7a, 0x01, 0x70, 0xac, 0xde, 0x2c, 0x70, 0x14, 0x0a, 0x7e, 0xf5, 0x6a, 0x05, 0x3f, 0x03, 0xda,
+	0xb5, 0x1b, 0x04, 0xda, 0xef, 0x80, 0x26, 0x33, 0xc1, 0x8a, 0x02, 0xe4, 0x5b, 0x9e, 0x84, 0x80,
+	0xb2, 0xa1, 0x25, 0xb1, 0xbe, 0x9d, 0x59, 0x3b, 0x9f, 0x86, 0x66, 0x9d, 0x8d, 0x1f, 0x07, 0xc8,
+	0x3f, 0x72, 0x2f, 0xd4, 0xab, 0xda, 0x4d, 0xd7, 0xab, 0xb4, 0xec, 0x80, 0x25, 0xca, 0x4e, 0x7d,
+	0xc9, 0xb2, 0xf3, 0x00, 0xac, 0x06, 0x21, 0x21, 0x4f, 0x38, 0x32, 0xd4, 0xf7, 0x5a, 0xa2, 0x65,
+	0x63, 0x19, 0x76, 0xcc, 0xc8, 0x46, 0x3b, 0x89, 0xf5, 0x86, 0x2c, 0x1e, 0x8c, 0x00, 0x4d, 0xc1,
+	0x7f, 0x49, 0xb5, 0x69, 0x2e, 0x56, 0x6d, 0x64, 0x77, 0xf9, 0x3e, 0xa8, 0xcd, 0x57, 0x63, 0x4d,
+	0xc2, 0x04, 0x23, 0xd6, 0x24, 0x28, 0x3c, 0xc0, 0x73, 0x4d, 0x82, 0xa0, 0x43, 0x53, 0x32, 0xa8,
+	0xdf, 0x48, 0x0d, 0x17, 0xef, 0x04, 0x2f, 0xb2, 0x13, 0xfe, 0x77, 0x15, 0xdc, 0x9d, 0xd7, 0x8f,
+	0x43, 0x9f, 0xe2, 0x90, 0x9f, 0xc9, 0xb5, 0xd5, 0x90, 0x57, 0xa3, 0xb3, 0x5b, 0xe6, 0xd5, 0x21,
+	0x4b, 0xd0, 0xd5, 0xdb, 0x4c, 0xd0, 0xea, 0x22, 0x09, 0xfa, 0x00, 0xd4, 0x23, 0x32, 0x0b, 0x6d,
+	0x6c, 0x05, 0x24, 0x4c, 0x9b, 0xc3, 0x1c, 0xa4, 0xe7, 0x26, 0xa1, 0x09, 0xc4, 0xe8, 0x98, 0x84,
+	0xfc, 0x82, 0x26, 0xe7, 0xe4, 0x5d, 0x4f, 0xf6, 0x86, 0xb9, 0x70, 0x2d, 0xce, 0x43, 0xb3, 0x29,
+	0x08, 0x43, 0x31, 0x56, 0x7f, 0x06, 0x36, 0x98, 0x25, 0x64, 0x46, 0xad, 0x09, 0x76, 0xc7, 0x13,
+	0xca, 0xbb, 0xc1, 0xfa, 0xde, 0x4e, 0xee, 0x1a, 0x29, 0xde, 0xd6, 0x4e, 0x77, 0xfb, 0x8f, 0x38,
+	0x87, 0x71, 0x5f, 0x9e, 0xdb, 0x9d, 0x6c, 0x67, 0x99, 0x3c, 0x34, 0x9b, 0x92, 0x20, 0xb8, 0xd5,
+	0x43, 0xb0, 0x99, 0x72, 0x64, 0xce, 0x64, 0xc5, 0xa0, 0x62, 0xbc, 0x91, 0xc4, 0xba, 0x56, 0x54,
+	0x92, 0x73, 0x6a, 0x5b, 0xd2, 0xe6, 0x1d, 0xba, 0xcc, 0xad, 0x7f, 0x96, 0x81, 0x56, 0x0c, 0x79,
+	0x71, 0x5d, 0x3d, 0x40, 0x14, 0xe5, 0x1a, 0x72, 0x65, 0xa1, 0x86, 0xbc, 0x74, 0xb5, 0x86, 0xfc,
+	0xab, 0xbc, 0xb8, 0xc1, 0xbc, 0x80, 0xff, 0x2e, 0x03, 0x75, 0x5e, 0xe5, 0x7e, 0x48, 0x6c, 0x34,
+	0xfd, 0xaa, 0xc0, 0x7d, 0x21, 0x0b, 0x5c, 0xda, 0x03, 0xac, 0xfd, 0x9f, 0x1e, 0x40, 0x66, 0xf7,
+	0x6f, 0xa5, 0xcf, 0x19, 0x9a, 0x2e, 0xda, 0x18, 0x7b, 0x60, 0x8d, 0x3d, 0xb1, 0x58, 0xae, 0x23,
+	0xf1, 0xf3, 0xe4, 0x3c, 0xd6, 0xab, 0x4c, 0x9e, 0xbf, 0x99, 0x6c, 0x08, 0x09, 0xc9, 0xb2, 0xbc,
+	0x1f, 0xab, 0x4c, 0xc3, 0xa1, 0xa3, 0xce, 0x40, 0xb3, 0x10, 0x1e, 0x32, 0x7a, 0x8e, 0xb3, 0x33,
+	0x29, 0x4c, 0x2f, 0xbf, 0x60, 0x23, 0x1f, 0x38, 0xb9, 0x00, 0xa8, 0xdc, 0x5c, 0x00, 0x48, 0xef,
+	0xfc, 0x51, 0xe1, 0xf7, 0x16, 0x13, 0x3f, 0x99, 0xf9, 0xce, 0xab, 0xed, 0x1e, 0x69, 0xf1, 0xa7,
+	0x0a, 0x68, 0x1d, 0x45, 0xe3, 0x7d, 0xdf, 0x27, 0x14, 0x51, 0xfc, 0xca, 0x06, 0xd3, 0x0b, 0x3a,
+	0xe0, 0xf2, 0x72, 0x1d, 0xb0, 0xdc, 0xfc, 0x9f, 0x14, 0xd0, 0x3c, 0x8a, 0xc6, 0x07, 0xfc, 0x46,
+	0x6d, 0x10, 0xdf, 0xb9, 0xda, 0xd6, 0x6f, 0xe5, 0x35, 0x51, 0x9a, 0xfc, 0x67, 0xe1, 0x2f, 0x61,
+	0xf2, 0x7b, 0xfe, 0xe8, 0x0b, 0x61, 0xf4, 0x7f, 0x14, 0xb0, 0x61, 0x20, 0x6a, 0x4f, 0x78, 0xd9,
+	0x3a, 0xa4, 0xb8, 0x10, 0x3e, 0xca, 0x6d, 0xd4, 0xa2, 0xd2, 0x4d, 0xd4, 0x22, 0xf8, 0x17, 0x05,
+	0x68, 0x47, 0xd1, 0x38, 0xdb, 0x7b, 0xfe, 0x25, 0xfd, 0x4a, 0x6e, 0x1b, 0x82, 0xaa, 0xbc, 0x69,
+	0x09, 0xb7, 0x6d, 0x8b, 0xbb, 0x5a, 0xf1, 0x34, 0x8d, 0x3b, 0x45, 0x8f, 0xa5, 0x77, 0x2f, 0x29,
+	0xca, 0xba, 0x3e, 0xf1, 0xc4, 0xce, 0xf3, 0x65, 0x3d, 0xdf, 0xf5, 0x09, 0x3a, 0x34, 0x25, 0x83,
+	0x74, 0xd8, 0x3f, 0x14, 0x70, 0x2f, 0xb5, 0xfb, 0x62, 0x31, 0xbb, 0xa2, 0xe1, 0x3f, 0x07, 0xeb,
+	0xd2, 0x7b, 0xc2, 0xf4, 0x86, 0xf1, 0x93, 0xf3, 0x58, 0x5f, 0x13, 0x1e, 0x8e, 0x92, 0x58, 0x6f,
+	0x15, 0x5c, 0x1c, 0x2d, 0x7f, 0xe4, 0x6b, 0xc2, 0xc7, 0x4b, 0x6c, 0xf3, 0x07, 0xa0, 0x79, 0x1c,
+	0xe2, 0x53, 0xfe, 0xda, 0xca, 0xdf, 0x00, 0xbf, 0x0d, 0xca, 0xa7, 0x68, 0xaa, 0x29, 0xb2, 0xc7,
+	0x17, 0xbf, 0xd6, 0xf7, 0xd3, 0x5f, 0xeb, 0xfb, 0xf3, 0x56, 0xdb, 0x58, 0x67, 0x07, 0xfe, 0xe1,
+	0x27, 0xba, 0x62, 0x32, 0x01, 0xe3, 0xbb, 0x1f, 0x9d, 0x77, 0x94, 0x8f, 0xcf, 0x3b, 0xca, 0xdf,
+	0xcf, 0x3b, 0xca, 0x87, 0xcf, 0x3b, 0x2b, 0x1f, 0x3f, 0xef, 0xac, 0xfc, 0xf5, 0x79, 0x67, 0xe5,
+	0xa7, 0x5f, 0xcf, 0x6d, 0x09, 0xbf, 0xed, 0x11, 0x1f, 0x9f, 0x0d, 0xf8, 0x7f, 0x0c, 0x78, 0xc4,
+	0x99, 0x4d, 0xb1, 0x48, 0x9a, 0x51, 0x95, 0x2f, 0xf1, 0xce, 0xff, 0x06, 0x00, 0x42, 0x3e, 0xc5,
+	0xad, 0x4d, 0x20, 0x00, 0x00,
+}
+
+func (m *AtomicSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Timestamp != 0 {
-		n += 1 + sovSwap(uint64(m.Timestamp))
+	return dAtA[:n], nil
+}
+
+func (m *AtomicSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AtomicSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemainingAmount) > 0 {
+		for iNdEx := len(m.RemainingAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemainingAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovSwap(uint64(l))
+	if m.PartialClaims {
+		i--
+		if m.PartialClaims {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovSwap(uint64(l))
+	if m.IBCPacket != nil {
+		{
+			size, err := m.IBCPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSwap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
-	l = len(m.SenderOtherChain)
-	if l > 0 {
-		n += 1 + l + sovSwap(uint64(l))
+	if m.DeputySlashed {
+		i--
+		if m.DeputySlashed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
 	}
-	l = len(m.RecipientOtherChain)
-	if l > 0 {
-		n += 1 + l + sovSwap(uint64(l))
+	if len(m.OtherChainTxHash) > 0 {
+		i -= len(m.OtherChainTxHash)
+		copy(dAtA[i:], m.OtherChainTxHash)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.OtherChainTxHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	if m.ClosedBlock != 0 {
-		n += 1 + sovSwap(uint64(m.ClosedBlock))
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x7a
 	}
-	if m.Status != 0 {
-		n += 1 + sovSwap(uint64(m.Status))
+	if m.ClosedTime != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.ClosedTime))
+		i--
+		dAtA[i] = 0x70
 	}
-	if m.CrossChain {
-		n += 2
+	if len(m.ClaimTip) > 0 {
+		for iNdEx := len(m.ClaimTip) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimTip[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
 	}
 	if m.Direction != 0 {
-		n += 1 + sovSwap(uint64(m.Direction))
+		i = encodeVarintSwap(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x60
 	}
-	return n
-}
-
-func (m *MsgCreateAtomicSwap) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.CrossChain {
+		i--
+		if m.CrossChain {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
 	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovSwap(uint64(l))
+	if m.Status != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x50
 	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovSwap(uint64(l))
+	if m.ClosedBlock != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.ClosedBlock))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.RecipientOtherChain)
-	if l > 0 {
-		n += 1 + l + sovSwap(uint64(l))
+	if len(m.RecipientOtherChain) > 0 {
+		i -= len(m.RecipientOtherChain)
+		copy(dAtA[i:], m.RecipientOtherChain)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.RecipientOtherChain)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.SenderOtherChain)
-	if l > 0 {
-		n += 1 + l + sovSwap(uint64(l))
+	if len(m.SenderOtherChain) > 0 {
+		i -= len(m.SenderOtherChain)
+		copy(dAtA[i:], m.SenderOtherChain)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.SenderOtherChain)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.RandomNumberHash)
-	if l > 0 {
-		n += 1 + l + sovSwap(uint64(l))
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if m.Timestamp != 0 {
-		n += 1 + sovSwap(uint64(m.Timestamp))
+		i = encodeVarintSwap(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExpireTimestamp != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.ExpireTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RandomNumberHash) > 0 {
+		i -= len(m.RandomNumberHash)
+		copy(dAtA[i:], m.RandomNumberHash)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.RandomNumberHash)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovSwap(uint64(l))
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AugmentedAtomicSwaps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AugmentedAtomicSwaps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AugmentedAtomicSwaps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AugmentedAtomicSwaps) > 0 {
+		for iNdEx := len(m.AugmentedAtomicSwaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AugmentedAtomicSwaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AugmentedAtomicSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AugmentedAtomicSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AugmentedAtomicSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemainingAmount) > 0 {
+		for iNdEx := len(m.RemainingAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemainingAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.PartialClaims {
+		i--
+		if m.PartialClaims {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.DeputySlashed {
+		i--
+		if m.DeputySlashed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.OtherChainTxHash) > 0 {
+		i -= len(m.OtherChainTxHash)
+		copy(dAtA[i:], m.OtherChainTxHash)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.OtherChainTxHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.ClosedTime != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.ClosedTime))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.ClaimTip) > 0 {
+		for iNdEx := len(m.ClaimTip) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimTip[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.Direction != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.CrossChain {
+		i--
+		if m.CrossChain {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Status != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ClosedBlock != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.ClosedBlock))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.RecipientOtherChain) > 0 {
+		i -= len(m.RecipientOtherChain)
+		copy(dAtA[i:], m.RecipientOtherChain)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.RecipientOtherChain)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.SenderOtherChain) > 0 {
+		i -= len(m.SenderOtherChain)
+		copy(dAtA[i:], m.SenderOtherChain)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.SenderOtherChain)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpireTimestamp != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.ExpireTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RandomNumberHash) > 0 {
+		i -= len(m.RandomNumberHash)
+		copy(dAtA[i:], m.RandomNumberHash)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.RandomNumberHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateAtomicSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateAtomicSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateAtomicSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PartialClaims {
+		i--
+		if m.PartialClaims {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSwap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.OtherChainTxHash) > 0 {
+		i -= len(m.OtherChainTxHash)
+		copy(dAtA[i:], m.OtherChainTxHash)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.OtherChainTxHash)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ClaimTip) > 0 {
+		for iNdEx := len(m.ClaimTip) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimTip[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.TimeSpanMin != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.TimeSpanMin))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.RandomNumberHash) > 0 {
+		i -= len(m.RandomNumberHash)
+		copy(dAtA[i:], m.RandomNumberHash)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.RandomNumberHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SenderOtherChain) > 0 {
+		i -= len(m.SenderOtherChain)
+		copy(dAtA[i:], m.SenderOtherChain)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.SenderOtherChain)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RecipientOtherChain) > 0 {
+		i -= len(m.RecipientOtherChain)
+		copy(dAtA[i:], m.RecipientOtherChain)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.RecipientOtherChain)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Header) > 0 {
+		i -= len(m.Header)
+		copy(dAtA[i:], m.Header)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.Header)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateInterchainSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateInterchainSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateInterchainSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size, err := m.TimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.SourcePort) > 0 {
+		i -= len(m.SourcePort)
+		copy(dAtA[i:], m.SourcePort)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.SourcePort)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TimeSpanMin != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.TimeSpanMin))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RandomNumberHash) > 0 {
+		i -= len(m.RandomNumberHash)
+		copy(dAtA[i:], m.RandomNumberHash)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.RandomNumberHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InterchainSwapPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterchainSwapPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterchainSwapPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeSpanMin != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.TimeSpanMin))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RandomNumberHash) > 0 {
+		i -= len(m.RandomNumberHash)
+		copy(dAtA[i:], m.RandomNumberHash)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.RandomNumberHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateLocalSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateLocalSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateLocalSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TimeSpanMin != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.TimeSpanMin))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RandomNumberHash) > 0 {
+		i -= len(m.RandomNumberHash)
+		copy(dAtA[i:], m.RandomNumberHash)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.RandomNumberHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAtomicSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAtomicSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAtomicSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RandomNumber) > 0 {
+		i -= len(m.RandomNumber)
+		copy(dAtA[i:], m.RandomNumber)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.RandomNumber)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SwapID) > 0 {
+		i -= len(m.SwapID)
+		copy(dAtA[i:], m.SwapID)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.SwapID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundAtomicSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundAtomicSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundAtomicSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SwapID) > 0 {
+		i -= len(m.SwapID)
+		copy(dAtA[i:], m.SwapID)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.SwapID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAnnotateSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAnnotateSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAnnotateSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OtherChainTxHash) > 0 {
+		i -= len(m.OtherChainTxHash)
+		copy(dAtA[i:], m.OtherChainTxHash)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.OtherChainTxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SwapID) > 0 {
+		i -= len(m.SwapID)
+		copy(dAtA[i:], m.SwapID)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.SwapID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeputyBond) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeputyBond) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeputyBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeputyUnbond) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeputyUnbond) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeputyUnbond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchClaimItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchClaimItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchClaimItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RandomNumber) > 0 {
+		i -= len(m.RandomNumber)
+		copy(dAtA[i:], m.RandomNumber)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.RandomNumber)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SwapID) > 0 {
+		i -= len(m.SwapID)
+		copy(dAtA[i:], m.SwapID)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.SwapID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchClaimAtomicSwaps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchClaimAtomicSwaps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchClaimAtomicSwaps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Atomic {
+		i--
+		if m.Atomic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchRefundAtomicSwaps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchRefundAtomicSwaps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchRefundAtomicSwaps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Atomic {
+		i--
+		if m.Atomic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SwapIDs) > 0 {
+		for iNdEx := len(m.SwapIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SwapIDs[iNdEx])
+			copy(dAtA[i:], m.SwapIDs[iNdEx])
+			i = encodeVarintSwap(dAtA, i, uint64(len(m.SwapIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrevBlockTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrevBlockTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrevBlockTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Val, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Val):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintSwap(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSwap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSwap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AtomicSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	l = len(m.RandomNumberHash)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	if m.ExpireTimestamp != 0 {
+		n += 1 + sovSwap(uint64(m.ExpireTimestamp))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovSwap(uint64(m.Timestamp))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.SenderOtherChain)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.RecipientOtherChain)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	if m.ClosedBlock != 0 {
+		n += 1 + sovSwap(uint64(m.ClosedBlock))
+	}
+	if m.Status != 0 {
+		n += 1 + sovSwap(uint64(m.Status))
+	}
+	if m.CrossChain {
+		n += 2
+	}
+	if m.Direction != 0 {
+		n += 1 + sovSwap(uint64(m.Direction))
+	}
+	if len(m.ClaimTip) > 0 {
+		for _, e := range m.ClaimTip {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	if m.ClosedTime != 0 {
+		n += 1 + sovSwap(uint64(m.ClosedTime))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.OtherChainTxHash)
+	if l > 0 {
+		n += 2 + l + sovSwap(uint64(l))
+	}
+	if m.DeputySlashed {
+		n += 3
+	}
+	if m.IBCPacket != nil {
+		l = m.IBCPacket.Size()
+		n += 2 + l + sovSwap(uint64(l))
+	}
+	if m.PartialClaims {
+		n += 3
+	}
+	if len(m.RemainingAmount) > 0 {
+		for _, e := range m.RemainingAmount {
+			l = e.Size()
+			n += 2 + l + sovSwap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AugmentedAtomicSwaps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AugmentedAtomicSwaps) > 0 {
+		for _, e := range m.AugmentedAtomicSwaps {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AugmentedAtomicSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	l = len(m.RandomNumberHash)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	if m.ExpireTimestamp != 0 {
+		n += 1 + sovSwap(uint64(m.ExpireTimestamp))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovSwap(uint64(m.Timestamp))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.SenderOtherChain)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.RecipientOtherChain)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	if m.ClosedBlock != 0 {
+		n += 1 + sovSwap(uint64(m.ClosedBlock))
+	}
+	if m.Status != 0 {
+		n += 1 + sovSwap(uint64(m.Status))
+	}
+	if m.CrossChain {
+		n += 2
+	}
+	if m.Direction != 0 {
+		n += 1 + sovSwap(uint64(m.Direction))
+	}
+	if len(m.ClaimTip) > 0 {
+		for _, e := range m.ClaimTip {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	if m.ClosedTime != 0 {
+		n += 1 + sovSwap(uint64(m.ClosedTime))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 2 + l + sovSwap(uint64(l))
+	}
+	l = len(m.OtherChainTxHash)
+	if l > 0 {
+		n += 2 + l + sovSwap(uint64(l))
+	}
+	if m.DeputySlashed {
+		n += 3
+	}
+	if m.PartialClaims {
+		n += 3
+	}
+	if len(m.RemainingAmount) > 0 {
+		for _, e := range m.RemainingAmount {
+			l = e.Size()
+			n += 2 + l + sovSwap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateAtomicSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.RecipientOtherChain)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.SenderOtherChain)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.RandomNumberHash)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovSwap(uint64(m.Timestamp))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	if m.TimeSpanMin != 0 {
+		n += 1 + sovSwap(uint64(m.TimeSpanMin))
+	}
+	if len(m.ClaimTip) > 0 {
+		for _, e := range m.ClaimTip {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.OtherChainTxHash)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	if m.PartialClaims {
+		n += 2
+	}
+	return n
+}
+
+func (m *SwapProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Header)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateInterchainSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.RandomNumberHash)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovSwap(uint64(m.Timestamp))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	if m.TimeSpanMin != 0 {
+		n += 1 + sovSwap(uint64(m.TimeSpanMin))
+	}
+	l = len(m.SourcePort)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = m.TimeoutHeight.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovSwap(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func (m *InterchainSwapPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.RandomNumberHash)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovSwap(uint64(m.Timestamp))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	if m.TimeSpanMin != 0 {
+		n += 1 + sovSwap(uint64(m.TimeSpanMin))
+	}
+	return n
+}
+
+func (m *MsgCreateLocalSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.RandomNumberHash)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovSwap(uint64(m.Timestamp))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	if m.TimeSpanMin != 0 {
+		n += 1 + sovSwap(uint64(m.TimeSpanMin))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimAtomicSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.SwapID)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.RandomNumber)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRefundAtomicSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.SwapID)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAnnotateSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.SwapID)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.OtherChainTxHash)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeputyBond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeputyUnbond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchClaimItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SwapID)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.RandomNumber)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBatchClaimAtomicSwaps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	if m.Atomic {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgBatchRefundAtomicSwaps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	if len(m.SwapIDs) > 0 {
+		for _, b := range m.SwapIDs {
+			l = len(b)
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	if m.Atomic {
+		n += 2
+	}
+	return n
+}
+
+func (m *PrevBlockTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Val)
+	n += 1 + l + sovSwap(uint64(l))
+	return n
+}
+
+func sovSwap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSwap(x uint64) (n int) {
+	return sovSwap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AtomicSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AtomicSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AtomicSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandomNumberHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RandomNumberHash = append(m.RandomNumberHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RandomNumberHash == nil {
+				m.RandomNumberHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTimestamp", wireType)
+			}
+			m.ExpireTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderOtherChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderOtherChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientOtherChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientOtherChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedBlock", wireType)
+			}
+			m.ClosedBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClosedBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SwapStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChain", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CrossChain = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= SwapDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimTip", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimTip = append(m.ClaimTip, types.Coin{})
+			if err := m.ClaimTip[len(m.ClaimTip)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedTime", wireType)
+			}
+			m.ClosedTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClosedTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherChainTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OtherChainTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeputySlashed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeputySlashed = bool(v != 0)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IBCPacket == nil {
+				m.IBCPacket = &types1.Packet{}
+			}
+			if err := m.IBCPacket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialClaims", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PartialClaims = bool(v != 0)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingAmount = append(m.RemainingAmount, types.Coin{})
+			if err := m.RemainingAmount[len(m.RemainingAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AugmentedAtomicSwaps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AugmentedAtomicSwaps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AugmentedAtomicSwaps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AugmentedAtomicSwaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AugmentedAtomicSwaps = append(m.AugmentedAtomicSwaps, AugmentedAtomicSwap{})
+			if err := m.AugmentedAtomicSwaps[len(m.AugmentedAtomicSwaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AugmentedAtomicSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AugmentedAtomicSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AugmentedAtomicSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandomNumberHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RandomNumberHash = append(m.RandomNumberHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RandomNumberHash == nil {
+				m.RandomNumberHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTimestamp", wireType)
+			}
+			m.ExpireTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderOtherChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderOtherChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientOtherChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientOtherChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedBlock", wireType)
+			}
+			m.ClosedBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClosedBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SwapStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChain", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CrossChain = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= SwapDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimTip", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimTip = append(m.ClaimTip, types.Coin{})
+			if err := m.ClaimTip[len(m.ClaimTip)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedTime", wireType)
+			}
+			m.ClosedTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClosedTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherChainTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OtherChainTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeputySlashed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeputySlashed = bool(v != 0)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialClaims", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PartialClaims = bool(v != 0)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingAmount = append(m.RemainingAmount, types.Coin{})
+			if err := m.RemainingAmount[len(m.RemainingAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateAtomicSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateAtomicSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateAtomicSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientOtherChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientOtherChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderOtherChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderOtherChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandomNumberHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RandomNumberHash = append(m.RandomNumberHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RandomNumberHash == nil {
+				m.RandomNumberHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeSpanMin", wireType)
+			}
+			m.TimeSpanMin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeSpanMin |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimTip", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimTip = append(m.ClaimTip, types.Coin{})
+			if err := m.ClaimTip[len(m.ClaimTip)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherChainTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OtherChainTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &SwapProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialClaims", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PartialClaims = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Header = append(m.Header[:0], dAtA[iNdEx:postIndex]...)
+			if m.Header == nil {
+				m.Header = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateInterchainSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateInterchainSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateInterchainSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandomNumberHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RandomNumberHash = append(m.RandomNumberHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RandomNumberHash == nil {
+				m.RandomNumberHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeSpanMin", wireType)
+			}
+			m.TimeSpanMin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeSpanMin |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.TimeSpanMin != 0 {
-		n += 1 + sovSwap(uint64(m.TimeSpanMin))
-	}
-	return n
-}
-
-func (m *MsgClaimAtomicSwap) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovSwap(uint64(l))
-	}
-	l = len(m.SwapID)
-	if l > 0 {
-		n += 1 + l + sovSwap(uint64(l))
-	}
-	l = len(m.RandomNumber)
-	if l > 0 {
-		n += 1 + l + sovSwap(uint64(l))
-	}
-	return n
-}
-
-func (m *MsgRefundAtomicSwap) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovSwap(uint64(l))
-	}
-	l = len(m.SwapID)
-	if l > 0 {
-		n += 1 + l + sovSwap(uint64(l))
-	}
-	return n
-}
 
-func (m *PrevBlockTime) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Val)
-	n += 1 + l + sovSwap(uint64(l))
-	return n
-}
-
-func sovSwap(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozSwap(x uint64) (n int) {
-	return sovSwap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *AtomicSwap) Unmarshal(dAtA []byte) error {
+func (m *InterchainSwapPacketData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1393,17 +5610,17 @@ func (m *AtomicSwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AtomicSwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: InterchainSwapPacketData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AtomicSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InterchainSwapPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -1413,31 +5630,29 @@ func (m *AtomicSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSwap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RandomNumberHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -1447,31 +5662,29 @@ func (m *AtomicSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSwap
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RandomNumberHash = append(m.RandomNumberHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.RandomNumberHash == nil {
-				m.RandomNumberHash = []byte{}
-			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTimestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandomNumberHash", wireType)
 			}
-			m.ExpireTimestamp = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -1481,11 +5694,26 @@ func (m *AtomicSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpireTimestamp |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RandomNumberHash = append(m.RandomNumberHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RandomNumberHash == nil {
+				m.RandomNumberHash = []byte{}
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
@@ -1507,9 +5735,9 @@ func (m *AtomicSwap) Unmarshal(dAtA []byte) error {
 			}
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -1519,27 +5747,98 @@ func (m *AtomicSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSwap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeSpanMin", wireType)
+			}
+			m.TimeSpanMin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeSpanMin |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateLocalSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateLocalSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateLocalSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1567,11 +5866,11 @@ func (m *AtomicSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SenderOtherChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1599,13 +5898,13 @@ func (m *AtomicSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SenderOtherChain = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipientOtherChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RandomNumberHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -1615,29 +5914,31 @@ func (m *AtomicSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSwap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecipientOtherChain = string(dAtA[iNdEx:postIndex])
+			m.RandomNumberHash = append(m.RandomNumberHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RandomNumberHash == nil {
+				m.RandomNumberHash = []byte{}
+			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClosedBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.ClosedBlock = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -1647,16 +5948,16 @@ func (m *AtomicSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClosedBlock |= int64(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -1666,16 +5967,31 @@ func (m *AtomicSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= SwapStatus(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrossChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeSpanMin", wireType)
 			}
-			var v int
+			m.TimeSpanMin = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -1685,17 +6001,16 @@ func (m *AtomicSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.TimeSpanMin |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CrossChain = bool(v != 0)
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
 			}
-			m.Direction = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -1705,11 +6020,24 @@ func (m *AtomicSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Direction |= SwapDirection(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSwap(dAtA[iNdEx:])
@@ -1731,7 +6059,7 @@ func (m *AtomicSwap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AugmentedAtomicSwaps) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimAtomicSwap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1754,15 +6082,115 @@ func (m *AugmentedAtomicSwaps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AugmentedAtomicSwaps: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimAtomicSwap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AugmentedAtomicSwaps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimAtomicSwap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AugmentedAtomicSwaps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapID = append(m.SwapID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SwapID == nil {
+				m.SwapID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandomNumber", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RandomNumber = append(m.RandomNumber[:0], dAtA[iNdEx:postIndex]...)
+			if m.RandomNumber == nil {
+				m.RandomNumber = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1789,8 +6217,8 @@ func (m *AugmentedAtomicSwaps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AugmentedAtomicSwaps = append(m.AugmentedAtomicSwaps, AugmentedAtomicSwap{})
-			if err := m.AugmentedAtomicSwaps[len(m.AugmentedAtomicSwaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1815,7 +6243,7 @@ func (m *AugmentedAtomicSwaps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AugmentedAtomicSwap) Unmarshal(dAtA []byte) error {
+func (m *MsgRefundAtomicSwap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1838,49 +6266,17 @@ func (m *AugmentedAtomicSwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AugmentedAtomicSwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRefundAtomicSwap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AugmentedAtomicSwap: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSwap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSwap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSwap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			return fmt.Errorf("proto: MsgRefundAtomicSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -1890,29 +6286,27 @@ func (m *AugmentedAtomicSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSwap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RandomNumberHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1939,52 +6333,64 @@ func (m *AugmentedAtomicSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RandomNumberHash = append(m.RandomNumberHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.RandomNumberHash == nil {
-				m.RandomNumberHash = []byte{}
+			m.SwapID = append(m.SwapID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SwapID == nil {
+				m.SwapID = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTimestamp", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ExpireTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSwap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpireTimestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Timestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSwap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAnnotateSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
 			}
-		case 6:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAnnotateSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAnnotateSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2012,13 +6418,13 @@ func (m *AugmentedAtomicSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapID", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -2028,27 +6434,29 @@ func (m *AugmentedAtomicSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSwap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.SwapID = append(m.SwapID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SwapID == nil {
+				m.SwapID = []byte{}
+			}
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SenderOtherChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherChainTxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2076,11 +6484,61 @@ func (m *AugmentedAtomicSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SenderOtherChain = string(dAtA[iNdEx:postIndex])
+			m.OtherChainTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeputyBond) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeputyBond: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeputyBond: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipientOtherChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2108,51 +6566,13 @@ func (m *AugmentedAtomicSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecipientOtherChain = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClosedBlock", wireType)
-			}
-			m.ClosedBlock = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSwap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ClosedBlock |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSwap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= SwapStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrossChain", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -2162,31 +6582,26 @@ func (m *AugmentedAtomicSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CrossChain = bool(v != 0)
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
-			}
-			m.Direction = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSwap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Direction |= SwapDirection(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
 			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSwap(dAtA[iNdEx:])
@@ -2208,7 +6623,7 @@ func (m *AugmentedAtomicSwap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateAtomicSwap) Unmarshal(dAtA []byte) error {
+func (m *MsgDeputyUnbond) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2231,10 +6646,10 @@ func (m *MsgCreateAtomicSwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateAtomicSwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeputyUnbond: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateAtomicSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeputyUnbond: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2271,9 +6686,9 @@ func (m *MsgCreateAtomicSwap) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -2283,91 +6698,79 @@ func (m *MsgCreateAtomicSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSwap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipientOtherChain", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSwap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSwap
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthSwap
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecipientOtherChain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SenderOtherChain", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSwap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSwap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSwap
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchClaimItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SenderOtherChain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchClaimItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchClaimItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RandomNumberHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2394,35 +6797,16 @@ func (m *MsgCreateAtomicSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RandomNumberHash = append(m.RandomNumberHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.RandomNumberHash == nil {
-				m.RandomNumberHash = []byte{}
+			m.SwapID = append(m.SwapID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SwapID == nil {
+				m.SwapID = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
-			}
-			m.Timestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSwap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RandomNumber", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -2432,45 +6816,26 @@ func (m *MsgCreateAtomicSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSwap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RandomNumber = append(m.RandomNumber[:0], dAtA[iNdEx:postIndex]...)
+			if m.RandomNumber == nil {
+				m.RandomNumber = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeSpanMin", wireType)
-			}
-			m.TimeSpanMin = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSwap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TimeSpanMin |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSwap(dAtA[iNdEx:])
@@ -2492,7 +6857,7 @@ func (m *MsgCreateAtomicSwap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimAtomicSwap) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchClaimAtomicSwaps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2515,10 +6880,10 @@ func (m *MsgClaimAtomicSwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimAtomicSwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchClaimAtomicSwaps: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimAtomicSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchClaimAtomicSwaps: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2555,9 +6920,9 @@ func (m *MsgClaimAtomicSwap) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -2567,31 +6932,31 @@ func (m *MsgClaimAtomicSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSwap
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SwapID = append(m.SwapID[:0], dAtA[iNdEx:postIndex]...)
-			if m.SwapID == nil {
-				m.SwapID = []byte{}
+			m.Claims = append(m.Claims, BatchClaimItem{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RandomNumber", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Atomic", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -2601,26 +6966,12 @@ func (m *MsgClaimAtomicSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthSwap
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSwap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RandomNumber = append(m.RandomNumber[:0], dAtA[iNdEx:postIndex]...)
-			if m.RandomNumber == nil {
-				m.RandomNumber = []byte{}
-			}
-			iNdEx = postIndex
+			m.Atomic = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSwap(dAtA[iNdEx:])
@@ -2642,7 +6993,7 @@ func (m *MsgClaimAtomicSwap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRefundAtomicSwap) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchRefundAtomicSwaps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2665,10 +7016,10 @@ func (m *MsgRefundAtomicSwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRefundAtomicSwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchRefundAtomicSwaps: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRefundAtomicSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchRefundAtomicSwaps: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2705,7 +7056,7 @@ func (m *MsgRefundAtomicSwap) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapIDs", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2732,11 +7083,29 @@ func (m *MsgRefundAtomicSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SwapID = append(m.SwapID[:0], dAtA[iNdEx:postIndex]...)
-			if m.SwapID == nil {
-				m.SwapID = []byte{}
-			}
+			m.SwapIDs = append(m.SwapIDs, make([]byte, postIndex-iNdEx))
+			copy(m.SwapIDs[len(m.SwapIDs)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Atomic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Atomic = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSwap(dAtA[iNdEx:])
@@ -0,0 +1,20 @@
+package types
+
+// Telemetry metric keys and labels. Metrics are prefixed with the module name, e.g. bep3_swaps_created.
+const (
+	MetricKeySwaps          = "swaps"
+	MetricKeySwapsCreated   = "created"
+	MetricKeySwapsClaimed   = "claimed"
+	MetricKeySwapsRefunded  = "refunded"
+	MetricKeySwapsExpired   = "expired"
+	MetricKeyOpenSwaps      = "open_swaps"
+	MetricKeyCurrentSupply  = "current_supply"
+	MetricKeyIncomingSupply = "incoming_supply"
+	MetricKeyOutgoingSupply = "outgoing_supply"
+
+	// MetricKeyTimeLimitedUtilization is the fraction of an asset's time based limit used in the current period
+	MetricKeyTimeLimitedUtilization = "time_limited_utilization"
+
+	MetricLabelDenom     = "denom"
+	MetricLabelDirection = "direction"
+)
@@ -10,6 +10,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -17,12 +18,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -186,42 +189,425 @@ func (m *MsgRefundAtomicSwapResponse) GetTimestamp() int64 {
 	return 0
 }
 
+// BatchItemResult reports the outcome of a single swap within a batch msg
+type BatchItemResult struct {
+	SwapID  string `protobuf:"bytes,1,opt,name=swap_id,json=swapId,proto3" json:"swap_id,omitempty" yaml:"swap_id"`
+	Success bool   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty" yaml:"success"`
+	Error   string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty" yaml:"error"`
+}
+
+func (m *BatchItemResult) Reset()         { *m = BatchItemResult{} }
+func (m *BatchItemResult) String() string { return proto.CompactTextString(m) }
+func (*BatchItemResult) ProtoMessage()    {}
+func (*BatchItemResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faa2ab2616d5892c, []int{3}
+}
+func (m *BatchItemResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchItemResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchItemResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchItemResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchItemResult.Merge(m, src)
+}
+func (m *BatchItemResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchItemResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchItemResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchItemResult proto.InternalMessageInfo
+
+func (m *BatchItemResult) GetSwapID() string {
+	if m != nil {
+		return m.SwapID
+	}
+	return ""
+}
+
+func (m *BatchItemResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *BatchItemResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type MsgBatchClaimAtomicSwapsResponse struct {
+	Results []BatchItemResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results" yaml:"results"`
+}
+
+func (m *MsgBatchClaimAtomicSwapsResponse) Reset()         { *m = MsgBatchClaimAtomicSwapsResponse{} }
+func (m *MsgBatchClaimAtomicSwapsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchClaimAtomicSwapsResponse) ProtoMessage()    {}
+func (*MsgBatchClaimAtomicSwapsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faa2ab2616d5892c, []int{4}
+}
+func (m *MsgBatchClaimAtomicSwapsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchClaimAtomicSwapsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchClaimAtomicSwapsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBatchClaimAtomicSwapsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchClaimAtomicSwapsResponse.Merge(m, src)
+}
+func (m *MsgBatchClaimAtomicSwapsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchClaimAtomicSwapsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchClaimAtomicSwapsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchClaimAtomicSwapsResponse proto.InternalMessageInfo
+
+func (m *MsgBatchClaimAtomicSwapsResponse) GetResults() []BatchItemResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type MsgBatchRefundAtomicSwapsResponse struct {
+	Results []BatchItemResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results" yaml:"results"`
+}
+
+func (m *MsgBatchRefundAtomicSwapsResponse) Reset()         { *m = MsgBatchRefundAtomicSwapsResponse{} }
+func (m *MsgBatchRefundAtomicSwapsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchRefundAtomicSwapsResponse) ProtoMessage()    {}
+func (*MsgBatchRefundAtomicSwapsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faa2ab2616d5892c, []int{5}
+}
+func (m *MsgBatchRefundAtomicSwapsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchRefundAtomicSwapsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchRefundAtomicSwapsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBatchRefundAtomicSwapsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchRefundAtomicSwapsResponse.Merge(m, src)
+}
+func (m *MsgBatchRefundAtomicSwapsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchRefundAtomicSwapsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchRefundAtomicSwapsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchRefundAtomicSwapsResponse proto.InternalMessageInfo
+
+func (m *MsgBatchRefundAtomicSwapsResponse) GetResults() []BatchItemResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type MsgAnnotateSwapResponse struct {
+}
+
+func (m *MsgAnnotateSwapResponse) Reset()         { *m = MsgAnnotateSwapResponse{} }
+func (m *MsgAnnotateSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAnnotateSwapResponse) ProtoMessage()    {}
+func (*MsgAnnotateSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faa2ab2616d5892c, []int{6}
+}
+func (m *MsgAnnotateSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAnnotateSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAnnotateSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAnnotateSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAnnotateSwapResponse.Merge(m, src)
+}
+func (m *MsgAnnotateSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAnnotateSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAnnotateSwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAnnotateSwapResponse proto.InternalMessageInfo
+
+type MsgDeputyBondResponse struct {
+}
+
+func (m *MsgDeputyBondResponse) Reset()         { *m = MsgDeputyBondResponse{} }
+func (m *MsgDeputyBondResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeputyBondResponse) ProtoMessage()    {}
+func (*MsgDeputyBondResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faa2ab2616d5892c, []int{7}
+}
+func (m *MsgDeputyBondResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeputyBondResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeputyBondResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeputyBondResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeputyBondResponse.Merge(m, src)
+}
+func (m *MsgDeputyBondResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeputyBondResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeputyBondResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeputyBondResponse proto.InternalMessageInfo
+
+type MsgDeputyUnbondResponse struct {
+	CompletionTime time.Time `protobuf:"bytes,1,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
+}
+
+func (m *MsgDeputyUnbondResponse) Reset()         { *m = MsgDeputyUnbondResponse{} }
+func (m *MsgDeputyUnbondResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeputyUnbondResponse) ProtoMessage()    {}
+func (*MsgDeputyUnbondResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faa2ab2616d5892c, []int{8}
+}
+func (m *MsgDeputyUnbondResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeputyUnbondResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeputyUnbondResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeputyUnbondResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeputyUnbondResponse.Merge(m, src)
+}
+func (m *MsgDeputyUnbondResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeputyUnbondResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeputyUnbondResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeputyUnbondResponse proto.InternalMessageInfo
+
+func (m *MsgDeputyUnbondResponse) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+type MsgCreateInterchainSwapResponse struct {
+	SwapID string `protobuf:"bytes,1,opt,name=swap_id,json=swapId,proto3" json:"swap_id,omitempty" yaml:"swap_id"`
+}
+
+func (m *MsgCreateInterchainSwapResponse) Reset()         { *m = MsgCreateInterchainSwapResponse{} }
+func (m *MsgCreateInterchainSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateInterchainSwapResponse) ProtoMessage()    {}
+func (*MsgCreateInterchainSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faa2ab2616d5892c, []int{9}
+}
+func (m *MsgCreateInterchainSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateInterchainSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateInterchainSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateInterchainSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateInterchainSwapResponse.Merge(m, src)
+}
+func (m *MsgCreateInterchainSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateInterchainSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateInterchainSwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateInterchainSwapResponse proto.InternalMessageInfo
+
+func (m *MsgCreateInterchainSwapResponse) GetSwapID() string {
+	if m != nil {
+		return m.SwapID
+	}
+	return ""
+}
+
+type MsgCreateLocalSwapResponse struct {
+	SwapID string `protobuf:"bytes,1,opt,name=swap_id,json=swapId,proto3" json:"swap_id,omitempty" yaml:"swap_id"`
+}
+
+func (m *MsgCreateLocalSwapResponse) Reset()         { *m = MsgCreateLocalSwapResponse{} }
+func (m *MsgCreateLocalSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateLocalSwapResponse) ProtoMessage()    {}
+func (*MsgCreateLocalSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faa2ab2616d5892c, []int{10}
+}
+func (m *MsgCreateLocalSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateLocalSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateLocalSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateLocalSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateLocalSwapResponse.Merge(m, src)
+}
+func (m *MsgCreateLocalSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateLocalSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateLocalSwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateLocalSwapResponse proto.InternalMessageInfo
+
+func (m *MsgCreateLocalSwapResponse) GetSwapID() string {
+	if m != nil {
+		return m.SwapID
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateAtomicSwapResponse)(nil), "bep3.MsgCreateAtomicSwapResponse")
 	proto.RegisterType((*MsgClaimAtomicSwapResponse)(nil), "bep3.MsgClaimAtomicSwapResponse")
 	proto.RegisterType((*MsgRefundAtomicSwapResponse)(nil), "bep3.MsgRefundAtomicSwapResponse")
+	proto.RegisterType((*BatchItemResult)(nil), "bep3.BatchItemResult")
+	proto.RegisterType((*MsgBatchClaimAtomicSwapsResponse)(nil), "bep3.MsgBatchClaimAtomicSwapsResponse")
+	proto.RegisterType((*MsgBatchRefundAtomicSwapsResponse)(nil), "bep3.MsgBatchRefundAtomicSwapsResponse")
+	proto.RegisterType((*MsgAnnotateSwapResponse)(nil), "bep3.MsgAnnotateSwapResponse")
+	proto.RegisterType((*MsgDeputyBondResponse)(nil), "bep3.MsgDeputyBondResponse")
+	proto.RegisterType((*MsgDeputyUnbondResponse)(nil), "bep3.MsgDeputyUnbondResponse")
+	proto.RegisterType((*MsgCreateInterchainSwapResponse)(nil), "bep3.MsgCreateInterchainSwapResponse")
+	proto.RegisterType((*MsgCreateLocalSwapResponse)(nil), "bep3.MsgCreateLocalSwapResponse")
 }
 
 func init() { proto.RegisterFile("bep3/tx.proto", fileDescriptor_faa2ab2616d5892c) }
 
 var fileDescriptor_faa2ab2616d5892c = []byte{
-	// 415 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x53, 0xb1, 0xce, 0xd3, 0x30,
-	0x18, 0xac, 0x29, 0x2a, 0xaa, 0x25, 0x68, 0x14, 0x75, 0x68, 0x03, 0x24, 0x25, 0x62, 0x60, 0x21,
-	0x56, 0x5b, 0xb1, 0x30, 0x20, 0x51, 0x18, 0xa8, 0x50, 0x51, 0x15, 0x36, 0x96, 0xca, 0x49, 0xdc,
-	0x24, 0x52, 0x1c, 0x47, 0xb1, 0x43, 0xe9, 0x5b, 0xf0, 0x02, 0x20, 0xf1, 0x36, 0x8c, 0x1d, 0x99,
-	0x22, 0x94, 0xbe, 0x41, 0x27, 0x46, 0x14, 0x27, 0x4a, 0x4b, 0xa0, 0x23, 0xd2, 0xbf, 0xd9, 0xdf,
-	0x9d, 0xef, 0x3b, 0x9f, 0xfd, 0xc1, 0xbb, 0x0e, 0x49, 0xe6, 0x48, 0x7c, 0xb2, 0x92, 0x94, 0x09,
-	0xa6, 0xde, 0x2e, 0xb7, 0xda, 0xd0, 0x67, 0x3e, 0x93, 0x05, 0x54, 0xae, 0x2a, 0x4c, 0x33, 0x7c,
-	0xc6, 0xfc, 0x88, 0x20, 0xb9, 0x73, 0xb2, 0x2d, 0x12, 0x21, 0x25, 0x5c, 0x60, 0x9a, 0xd4, 0x04,
-	0xdd, 0x65, 0x9c, 0x32, 0x8e, 0x1c, 0xcc, 0x09, 0xfa, 0x38, 0x75, 0x88, 0xc0, 0x53, 0xe4, 0xb2,
-	0x30, 0xae, 0xf1, 0x81, 0xec, 0xc5, 0x77, 0xb8, 0x3e, 0x60, 0x7e, 0x03, 0xf0, 0xfe, 0x8a, 0xfb,
-	0xaf, 0x52, 0x82, 0x05, 0x79, 0x29, 0x18, 0x0d, 0xdd, 0xf7, 0x3b, 0x9c, 0xd8, 0x84, 0x27, 0x2c,
-	0xe6, 0x44, 0x7d, 0x0b, 0xd5, 0x14, 0xc7, 0x1e, 0xa3, 0x9b, 0x38, 0xa3, 0x0e, 0x49, 0x37, 0x01,
-	0xe6, 0xc1, 0x08, 0x4c, 0xc0, 0x93, 0xfe, 0xe2, 0xe1, 0x29, 0x37, 0xc6, 0x7b, 0x4c, 0xa3, 0xe7,
-	0xe6, 0xdf, 0x1c, 0xd3, 0x56, 0xaa, 0xe2, 0x3b, 0x59, 0x7b, 0x83, 0x79, 0xa0, 0x3e, 0x83, 0x77,
-	0xca, 0xd6, 0x9b, 0xd0, 0x1b, 0xdd, 0x92, 0x0a, 0x0f, 0x8a, 0xdc, 0xe8, 0x95, 0xfd, 0x96, 0xaf,
-	0x4f, 0xb9, 0x71, 0xaf, 0xd2, 0xaa, 0x29, 0xa6, 0xdd, 0x2b, 0x57, 0x4b, 0xcf, 0xfc, 0x02, 0xa0,
-	0x56, 0x7a, 0x8c, 0x70, 0x48, 0xff, 0xb7, 0xc5, 0x19, 0xec, 0x37, 0x99, 0x4a, 0x93, 0xdd, 0xc5,
-	0xf0, 0x94, 0x1b, 0x4a, 0xa5, 0xd1, 0x40, 0xa6, 0x7d, 0xa6, 0x99, 0x5f, 0xab, 0x0c, 0x6d, 0xb2,
-	0xcd, 0x62, 0xef, 0x06, 0x1a, 0x9c, 0xfd, 0x02, 0xb0, 0xbb, 0xe2, 0xbe, 0xba, 0x86, 0x4a, 0xfb,
-	0xa1, 0xd5, 0xb1, 0x55, 0x7e, 0x09, 0xeb, 0x1f, 0x7f, 0x40, 0x7b, 0x74, 0x15, 0x6a, 0xae, 0xb6,
-	0x82, 0x83, 0xd6, 0xb3, 0xa8, 0xa3, 0xf3, 0xa9, 0x3f, 0x11, 0x6d, 0x72, 0x0d, 0x69, 0xe4, 0xd6,
-	0x50, 0x69, 0xa7, 0x78, 0x61, 0xb0, 0x0d, 0x5d, 0x18, 0xbc, 0x96, 0xfd, 0xe2, 0xc5, 0xf7, 0x42,
-	0x07, 0x87, 0x42, 0x07, 0x3f, 0x0b, 0x1d, 0x7c, 0x3e, 0xea, 0x9d, 0xc3, 0x51, 0xef, 0xfc, 0x38,
-	0xea, 0x9d, 0x0f, 0x8f, 0xfd, 0x50, 0x04, 0x99, 0x63, 0xb9, 0x8c, 0x22, 0xf2, 0x94, 0xb2, 0x98,
-	0xec, 0x91, 0x9c, 0x0e, 0xca, 0xbc, 0x2c, 0x22, 0x48, 0xec, 0x13, 0xc2, 0x9d, 0x9e, 0x1c, 0x93,
-	0xf9, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa1, 0x21, 0xe8, 0xc3, 0xa5, 0x03, 0x00, 0x00,
+	// 763 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4d, 0x6e, 0xdb, 0x46,
+	0x14, 0x16, 0xab, 0x56, 0xae, 0xc7, 0xae, 0x25, 0x4c, 0x2d, 0x5b, 0xa6, 0x2b, 0x52, 0x1e, 0xb4,
+	0xae, 0x17, 0xad, 0x08, 0xcb, 0xe8, 0xa6, 0x0b, 0x03, 0x66, 0x0d, 0x34, 0x42, 0xa2, 0xc0, 0xa0,
+	0x13, 0x20, 0x08, 0x02, 0x08, 0x24, 0x35, 0xa6, 0x88, 0x90, 0x1c, 0x82, 0x33, 0x8c, 0xa3, 0x6d,
+	0x4e, 0xe0, 0x0b, 0x24, 0x40, 0x2e, 0x91, 0x33, 0x78, 0xe9, 0x65, 0x56, 0x4a, 0x20, 0xdf, 0x40,
+	0x27, 0x08, 0x48, 0x4a, 0x23, 0x8a, 0xa6, 0x9c, 0x20, 0x88, 0x81, 0xec, 0xc8, 0xef, 0x7b, 0xef,
+	0x7b, 0x9f, 0xf8, 0x7e, 0x20, 0xf0, 0x8b, 0x81, 0xfd, 0x03, 0x85, 0xbd, 0x6c, 0xfa, 0x01, 0x61,
+	0x04, 0xfe, 0x18, 0xbd, 0x8a, 0xeb, 0x16, 0xb1, 0x48, 0x0c, 0x28, 0xd1, 0x53, 0xc2, 0x89, 0xb2,
+	0x45, 0x88, 0xe5, 0x60, 0x25, 0x7e, 0x33, 0xc2, 0x33, 0x85, 0xd9, 0x2e, 0xa6, 0x4c, 0x77, 0xfd,
+	0x49, 0x80, 0x64, 0x12, 0xea, 0x12, 0xaa, 0x18, 0x3a, 0xc5, 0xca, 0x8b, 0x7d, 0x03, 0x33, 0x7d,
+	0x5f, 0x31, 0x89, 0xed, 0x4d, 0xf8, 0x72, 0x5c, 0x8b, 0x9e, 0xeb, 0x93, 0x04, 0xf4, 0x56, 0x00,
+	0xdb, 0x1d, 0x6a, 0xfd, 0x17, 0x60, 0x9d, 0xe1, 0x23, 0x46, 0x5c, 0xdb, 0x3c, 0x3d, 0xd7, 0x7d,
+	0x0d, 0x53, 0x9f, 0x78, 0x14, 0xc3, 0xfb, 0x00, 0x06, 0xba, 0xd7, 0x23, 0x6e, 0xd7, 0x0b, 0x5d,
+	0x03, 0x07, 0xdd, 0xbe, 0x4e, 0xfb, 0x35, 0xa1, 0x21, 0xec, 0x2d, 0xab, 0xf5, 0xf1, 0x50, 0xde,
+	0x1a, 0xe8, 0xae, 0xf3, 0x2f, 0xba, 0x19, 0x83, 0xb4, 0x4a, 0x02, 0x3e, 0x8c, 0xb1, 0x7b, 0x3a,
+	0xed, 0xc3, 0x7f, 0xc0, 0x52, 0x54, 0xba, 0x6b, 0xf7, 0x6a, 0x3f, 0xc4, 0x0a, 0xbf, 0x8d, 0x86,
+	0x72, 0x29, 0xaa, 0xd7, 0x3e, 0x1e, 0x0f, 0xe5, 0xb5, 0x44, 0x6b, 0x12, 0x82, 0xb4, 0x52, 0xf4,
+	0xd4, 0xee, 0xa1, 0xd7, 0x02, 0x10, 0x23, 0x8f, 0x8e, 0x6e, 0xbb, 0x77, 0x6d, 0xb1, 0x05, 0x96,
+	0xf9, 0x37, 0x8d, 0x4d, 0x16, 0xd5, 0xf5, 0xf1, 0x50, 0xae, 0x24, 0x1a, 0x9c, 0x42, 0xda, 0x2c,
+	0x0c, 0xbd, 0x49, 0xbe, 0xa1, 0x86, 0xcf, 0x42, 0xaf, 0xf7, 0x9d, 0x1a, 0x2c, 0xab, 0x3a, 0x33,
+	0xfb, 0x6d, 0x86, 0x5d, 0x0d, 0xd3, 0xd0, 0x61, 0xe9, 0x5e, 0x08, 0x5f, 0xde, 0x0b, 0xf8, 0x17,
+	0x58, 0xa2, 0xa1, 0x69, 0x62, 0x4a, 0xe3, 0xe2, 0x3f, 0xab, 0x30, 0x15, 0x9c, 0x10, 0x48, 0x9b,
+	0x86, 0xc0, 0x5d, 0xf0, 0x13, 0x0e, 0x02, 0x12, 0xd4, 0x8a, 0x71, 0x89, 0xca, 0x78, 0x28, 0xaf,
+	0x26, 0xb1, 0x31, 0x8c, 0xb4, 0x84, 0x46, 0xcf, 0x41, 0xa3, 0x43, 0xad, 0xd8, 0x62, 0xa6, 0xcb,
+	0x94, 0x7f, 0xc5, 0xff, 0xc1, 0x52, 0x10, 0x5b, 0xa7, 0x35, 0xa1, 0x51, 0xdc, 0x5b, 0x69, 0x55,
+	0x9b, 0xd1, 0x30, 0x37, 0x33, 0x3f, 0x4c, 0xdd, 0xb8, 0x1c, 0xca, 0x85, 0x99, 0xa9, 0x49, 0x0e,
+	0xd2, 0xa6, 0xd9, 0xc8, 0x01, 0x3b, 0xd3, 0x62, 0xd9, 0x96, 0xdd, 0x41, 0xb5, 0x2d, 0xb0, 0xd9,
+	0xa1, 0xd6, 0x91, 0xe7, 0x11, 0xa6, 0x33, 0x9c, 0x9e, 0x0b, 0xb4, 0x09, 0xaa, 0x1d, 0x6a, 0x1d,
+	0x63, 0x3f, 0x64, 0x03, 0x95, 0x78, 0x3d, 0x4e, 0xbc, 0x12, 0xc0, 0x26, 0x67, 0x1e, 0x7b, 0x46,
+	0x8a, 0x83, 0x16, 0x28, 0x9b, 0xc4, 0xf5, 0x1d, 0xcc, 0x6c, 0xe2, 0x75, 0xa3, 0x1e, 0xc7, 0xfd,
+	0x5b, 0x69, 0x89, 0xcd, 0xe4, 0x38, 0x34, 0xa7, 0xc7, 0xa1, 0xf9, 0x68, 0x3a, 0x00, 0x2a, 0x9a,
+	0xb8, 0xdc, 0x48, 0x5c, 0x66, 0x04, 0xd0, 0xc5, 0x07, 0x59, 0xd0, 0xd6, 0x66, 0x68, 0x94, 0x88,
+	0x9e, 0x00, 0x99, 0x1f, 0x86, 0xb6, 0xc7, 0x70, 0x60, 0xf6, 0x75, 0xdb, 0x9b, 0x1b, 0xec, 0xaf,
+	0x9b, 0x21, 0x74, 0x0a, 0x44, 0xae, 0xfc, 0x80, 0x98, 0xba, 0xf3, 0x0d, 0x44, 0x5b, 0xef, 0x4a,
+	0xa0, 0xd8, 0xa1, 0x16, 0x3c, 0x01, 0x95, 0xec, 0x31, 0x83, 0x5b, 0x49, 0xef, 0x72, 0xee, 0x9c,
+	0xb8, 0xb3, 0x90, 0xe2, 0x86, 0x3a, 0xa0, 0x9c, 0x19, 0x4a, 0x58, 0x9b, 0x65, 0xcd, 0x33, 0x62,
+	0x63, 0x11, 0xc3, 0xe5, 0x4e, 0x40, 0x25, 0x3b, 0x76, 0x29, 0x83, 0x59, 0x4a, 0xdc, 0x59, 0x48,
+	0x71, 0xc5, 0x2e, 0xa8, 0xe6, 0xae, 0x0e, 0x94, 0x78, 0x6e, 0x2e, 0x2f, 0xee, 0xde, 0xce, 0xf3,
+	0x02, 0x06, 0xd8, 0xc8, 0x5f, 0x17, 0x28, 0xcf, 0x2b, 0xdc, 0x08, 0x10, 0xff, 0xfc, 0x4c, 0x00,
+	0xaf, 0x71, 0x0c, 0x56, 0xd3, 0x4b, 0x02, 0xab, 0x3c, 0x31, 0x0d, 0x8b, 0xf5, 0x5c, 0x98, 0xab,
+	0x1c, 0x02, 0x30, 0xdb, 0x27, 0xf8, 0x2b, 0x0f, 0x9e, 0x81, 0xe2, 0x76, 0x0e, 0x98, 0x76, 0x91,
+	0xde, 0xba, 0x94, 0x8b, 0x34, 0x2c, 0xd6, 0x73, 0x61, 0xae, 0xf2, 0x0c, 0xac, 0xe7, 0xed, 0x0d,
+	0xac, 0x67, 0x86, 0x6d, 0x9e, 0x16, 0xff, 0xb8, 0x95, 0x9e, 0x9b, 0xc7, 0xf9, 0xdd, 0x49, 0xcf,
+	0xe3, 0x3c, 0x23, 0x36, 0x16, 0x31, 0x53, 0x39, 0xf5, 0xf0, 0x72, 0x24, 0x09, 0x57, 0x23, 0x49,
+	0xf8, 0x38, 0x92, 0x84, 0x8b, 0x6b, 0xa9, 0x70, 0x75, 0x2d, 0x15, 0xde, 0x5f, 0x4b, 0x85, 0xa7,
+	0xbf, 0x5b, 0x36, 0xeb, 0x87, 0x46, 0xd3, 0x24, 0xae, 0x82, 0xff, 0x76, 0x89, 0x87, 0x07, 0x4a,
+	0xfc, 0xff, 0xc1, 0x25, 0xbd, 0xd0, 0xc1, 0x0a, 0x1b, 0xf8, 0x98, 0x1a, 0xa5, 0xf8, 0xde, 0x1c,
+	0x7c, 0x1a, 0x00, 0xcd, 0x8a, 0x55, 0x68, 0xc7, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -239,6 +625,13 @@ type MsgClient interface {
 	CreateAtomicSwap(ctx context.Context, in *MsgCreateAtomicSwap, opts ...grpc.CallOption) (*MsgCreateAtomicSwapResponse, error)
 	ClaimAtomicSwap(ctx context.Context, in *MsgClaimAtomicSwap, opts ...grpc.CallOption) (*MsgClaimAtomicSwapResponse, error)
 	RefundAtomicSwap(ctx context.Context, in *MsgRefundAtomicSwap, opts ...grpc.CallOption) (*MsgRefundAtomicSwapResponse, error)
+	BatchClaimAtomicSwaps(ctx context.Context, in *MsgBatchClaimAtomicSwaps, opts ...grpc.CallOption) (*MsgBatchClaimAtomicSwapsResponse, error)
+	BatchRefundAtomicSwaps(ctx context.Context, in *MsgBatchRefundAtomicSwaps, opts ...grpc.CallOption) (*MsgBatchRefundAtomicSwapsResponse, error)
+	AnnotateSwap(ctx context.Context, in *MsgAnnotateSwap, opts ...grpc.CallOption) (*MsgAnnotateSwapResponse, error)
+	DeputyBond(ctx context.Context, in *MsgDeputyBond, opts ...grpc.CallOption) (*MsgDeputyBondResponse, error)
+	DeputyUnbond(ctx context.Context, in *MsgDeputyUnbond, opts ...grpc.CallOption) (*MsgDeputyUnbondResponse, error)
+	CreateInterchainSwap(ctx context.Context, in *MsgCreateInterchainSwap, opts ...grpc.CallOption) (*MsgCreateInterchainSwapResponse, error)
+	CreateLocalSwap(ctx context.Context, in *MsgCreateLocalSwap, opts ...grpc.CallOption) (*MsgCreateLocalSwapResponse, error)
 }
 
 type msgClient struct {
@@ -276,11 +669,81 @@ func (c *msgClient) RefundAtomicSwap(ctx context.Context, in *MsgRefundAtomicSwa
 	return out, nil
 }
 
+func (c *msgClient) BatchClaimAtomicSwaps(ctx context.Context, in *MsgBatchClaimAtomicSwaps, opts ...grpc.CallOption) (*MsgBatchClaimAtomicSwapsResponse, error) {
+	out := new(MsgBatchClaimAtomicSwapsResponse)
+	err := c.cc.Invoke(ctx, "/bep3.Msg/BatchClaimAtomicSwaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BatchRefundAtomicSwaps(ctx context.Context, in *MsgBatchRefundAtomicSwaps, opts ...grpc.CallOption) (*MsgBatchRefundAtomicSwapsResponse, error) {
+	out := new(MsgBatchRefundAtomicSwapsResponse)
+	err := c.cc.Invoke(ctx, "/bep3.Msg/BatchRefundAtomicSwaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AnnotateSwap(ctx context.Context, in *MsgAnnotateSwap, opts ...grpc.CallOption) (*MsgAnnotateSwapResponse, error) {
+	out := new(MsgAnnotateSwapResponse)
+	err := c.cc.Invoke(ctx, "/bep3.Msg/AnnotateSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeputyBond(ctx context.Context, in *MsgDeputyBond, opts ...grpc.CallOption) (*MsgDeputyBondResponse, error) {
+	out := new(MsgDeputyBondResponse)
+	err := c.cc.Invoke(ctx, "/bep3.Msg/DeputyBond", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeputyUnbond(ctx context.Context, in *MsgDeputyUnbond, opts ...grpc.CallOption) (*MsgDeputyUnbondResponse, error) {
+	out := new(MsgDeputyUnbondResponse)
+	err := c.cc.Invoke(ctx, "/bep3.Msg/DeputyUnbond", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateInterchainSwap(ctx context.Context, in *MsgCreateInterchainSwap, opts ...grpc.CallOption) (*MsgCreateInterchainSwapResponse, error) {
+	out := new(MsgCreateInterchainSwapResponse)
+	err := c.cc.Invoke(ctx, "/bep3.Msg/CreateInterchainSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateLocalSwap(ctx context.Context, in *MsgCreateLocalSwap, opts ...grpc.CallOption) (*MsgCreateLocalSwapResponse, error) {
+	out := new(MsgCreateLocalSwapResponse)
+	err := c.cc.Invoke(ctx, "/bep3.Msg/CreateLocalSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateAtomicSwap(context.Context, *MsgCreateAtomicSwap) (*MsgCreateAtomicSwapResponse, error)
 	ClaimAtomicSwap(context.Context, *MsgClaimAtomicSwap) (*MsgClaimAtomicSwapResponse, error)
 	RefundAtomicSwap(context.Context, *MsgRefundAtomicSwap) (*MsgRefundAtomicSwapResponse, error)
+	BatchClaimAtomicSwaps(context.Context, *MsgBatchClaimAtomicSwaps) (*MsgBatchClaimAtomicSwapsResponse, error)
+	BatchRefundAtomicSwaps(context.Context, *MsgBatchRefundAtomicSwaps) (*MsgBatchRefundAtomicSwapsResponse, error)
+	AnnotateSwap(context.Context, *MsgAnnotateSwap) (*MsgAnnotateSwapResponse, error)
+	DeputyBond(context.Context, *MsgDeputyBond) (*MsgDeputyBondResponse, error)
+	DeputyUnbond(context.Context, *MsgDeputyUnbond) (*MsgDeputyUnbondResponse, error)
+	CreateInterchainSwap(context.Context, *MsgCreateInterchainSwap) (*MsgCreateInterchainSwapResponse, error)
+	CreateLocalSwap(context.Context, *MsgCreateLocalSwap) (*MsgCreateLocalSwapResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -296,12 +759,33 @@ func (*UnimplementedMsgServer) ClaimAtomicSwap(ctx context.Context, req *MsgClai
 func (*UnimplementedMsgServer) RefundAtomicSwap(ctx context.Context, req *MsgRefundAtomicSwap) (*MsgRefundAtomicSwapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefundAtomicSwap not implemented")
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (*UnimplementedMsgServer) BatchClaimAtomicSwaps(ctx context.Context, req *MsgBatchClaimAtomicSwaps) (*MsgBatchClaimAtomicSwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchClaimAtomicSwaps not implemented")
 }
-
-func _Msg_CreateAtomicSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func (*UnimplementedMsgServer) BatchRefundAtomicSwaps(ctx context.Context, req *MsgBatchRefundAtomicSwaps) (*MsgBatchRefundAtomicSwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchRefundAtomicSwaps not implemented")
+}
+func (*UnimplementedMsgServer) AnnotateSwap(ctx context.Context, req *MsgAnnotateSwap) (*MsgAnnotateSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnotateSwap not implemented")
+}
+func (*UnimplementedMsgServer) DeputyBond(ctx context.Context, req *MsgDeputyBond) (*MsgDeputyBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeputyBond not implemented")
+}
+func (*UnimplementedMsgServer) DeputyUnbond(ctx context.Context, req *MsgDeputyUnbond) (*MsgDeputyUnbondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeputyUnbond not implemented")
+}
+func (*UnimplementedMsgServer) CreateInterchainSwap(ctx context.Context, req *MsgCreateInterchainSwap) (*MsgCreateInterchainSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInterchainSwap not implemented")
+}
+func (*UnimplementedMsgServer) CreateLocalSwap(ctx context.Context, req *MsgCreateLocalSwap) (*MsgCreateLocalSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLocalSwap not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateAtomicSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCreateAtomicSwap)
 	if err := dec(in); err != nil {
 		return nil, err
@@ -355,6 +839,132 @@ func _Msg_RefundAtomicSwap_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BatchClaimAtomicSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchClaimAtomicSwaps)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchClaimAtomicSwaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bep3.Msg/BatchClaimAtomicSwaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchClaimAtomicSwaps(ctx, req.(*MsgBatchClaimAtomicSwaps))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchRefundAtomicSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchRefundAtomicSwaps)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchRefundAtomicSwaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bep3.Msg/BatchRefundAtomicSwaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchRefundAtomicSwaps(ctx, req.(*MsgBatchRefundAtomicSwaps))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AnnotateSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAnnotateSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AnnotateSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bep3.Msg/AnnotateSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AnnotateSwap(ctx, req.(*MsgAnnotateSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeputyBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeputyBond)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeputyBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bep3.Msg/DeputyBond",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeputyBond(ctx, req.(*MsgDeputyBond))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeputyUnbond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeputyUnbond)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeputyUnbond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bep3.Msg/DeputyUnbond",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeputyUnbond(ctx, req.(*MsgDeputyUnbond))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateInterchainSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateInterchainSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateInterchainSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bep3.Msg/CreateInterchainSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateInterchainSwap(ctx, req.(*MsgCreateInterchainSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateLocalSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateLocalSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateLocalSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bep3.Msg/CreateLocalSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateLocalSwap(ctx, req.(*MsgCreateLocalSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "bep3.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -371,6 +981,34 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RefundAtomicSwap",
 			Handler:    _Msg_RefundAtomicSwap_Handler,
 		},
+		{
+			MethodName: "BatchClaimAtomicSwaps",
+			Handler:    _Msg_BatchClaimAtomicSwaps_Handler,
+		},
+		{
+			MethodName: "BatchRefundAtomicSwaps",
+			Handler:    _Msg_BatchRefundAtomicSwaps_Handler,
+		},
+		{
+			MethodName: "AnnotateSwap",
+			Handler:    _Msg_AnnotateSwap_Handler,
+		},
+		{
+			MethodName: "DeputyBond",
+			Handler:    _Msg_DeputyBond_Handler,
+		},
+		{
+			MethodName: "DeputyUnbond",
+			Handler:    _Msg_DeputyUnbond_Handler,
+		},
+		{
+			MethodName: "CreateInterchainSwap",
+			Handler:    _Msg_CreateInterchainSwap_Handler,
+		},
+		{
+			MethodName: "CreateLocalSwap",
+			Handler:    _Msg_CreateLocalSwap_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "bep3/tx.proto",
@@ -483,73 +1121,970 @@ func (m *MsgRefundAtomicSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BatchItemResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateAtomicSwapResponse) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *BatchItemResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchItemResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SwapID) > 0 {
+		i -= len(m.SwapID)
+		copy(dAtA[i:], m.SwapID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SwapID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchClaimAtomicSwapsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchClaimAtomicSwapsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchClaimAtomicSwapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RandomNumberHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	l = len(m.SwapID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchRefundAtomicSwapsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgClaimAtomicSwapResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBatchRefundAtomicSwapsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchRefundAtomicSwapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAnnotateSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAnnotateSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAnnotateSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RandomNumberHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeputyBondResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Timestamp != 0 {
-		n += 1 + sovTx(uint64(m.Timestamp))
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeputyBondResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeputyBondResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeputyUnbondResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeputyUnbondResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeputyUnbondResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateInterchainSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateInterchainSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateInterchainSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SwapID) > 0 {
+		i -= len(m.SwapID)
+		copy(dAtA[i:], m.SwapID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SwapID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateLocalSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateLocalSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateLocalSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SwapID) > 0 {
+		i -= len(m.SwapID)
+		copy(dAtA[i:], m.SwapID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SwapID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateAtomicSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RandomNumberHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SwapID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimAtomicSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RandomNumberHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTx(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *MsgRefundAtomicSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RandomNumberHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTx(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *BatchItemResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SwapID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBatchClaimAtomicSwapsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBatchRefundAtomicSwapsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAnnotateSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeputyBondResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeputyUnbondResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateInterchainSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SwapID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateLocalSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SwapID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateAtomicSwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateAtomicSwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateAtomicSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandomNumberHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RandomNumberHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimAtomicSwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAtomicSwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAtomicSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandomNumberHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RandomNumberHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundAtomicSwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundAtomicSwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundAtomicSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandomNumberHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RandomNumberHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchItemResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchItemResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchItemResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchClaimAtomicSwapsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchClaimAtomicSwapsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchClaimAtomicSwapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, BatchItemResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgRefundAtomicSwapResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RandomNumberHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Timestamp != 0 {
-		n += 1 + sovTx(uint64(m.Timestamp))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateAtomicSwapResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchRefundAtomicSwapsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -572,17 +2107,17 @@ func (m *MsgCreateAtomicSwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateAtomicSwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchRefundAtomicSwapsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateAtomicSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchRefundAtomicSwapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RandomNumberHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -592,29 +2127,181 @@ func (m *MsgCreateAtomicSwapResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RandomNumberHash = string(dAtA[iNdEx:postIndex])
+			m.Results = append(m.Results, BatchItemResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAnnotateSwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAnnotateSwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAnnotateSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeputyBondResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeputyBondResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeputyBondResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeputyUnbondResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeputyUnbondResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeputyUnbondResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -624,23 +2311,24 @@ func (m *MsgCreateAtomicSwapResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SwapID = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -663,7 +2351,7 @@ func (m *MsgCreateAtomicSwapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimAtomicSwapResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateInterchainSwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -686,15 +2374,15 @@ func (m *MsgClaimAtomicSwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimAtomicSwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateInterchainSwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimAtomicSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateInterchainSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RandomNumberHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -722,27 +2410,8 @@ func (m *MsgClaimAtomicSwapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RandomNumberHash = string(dAtA[iNdEx:postIndex])
+			m.SwapID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
-			}
-			m.Timestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -764,7 +2433,7 @@ func (m *MsgClaimAtomicSwapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRefundAtomicSwapResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateLocalSwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -787,15 +2456,15 @@ func (m *MsgRefundAtomicSwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRefundAtomicSwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateLocalSwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRefundAtomicSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateLocalSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RandomNumberHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -823,27 +2492,8 @@ func (m *MsgRefundAtomicSwapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RandomNumberHash = string(dAtA[iNdEx:postIndex])
+			m.SwapID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
-			}
-			m.Timestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
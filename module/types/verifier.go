@@ -0,0 +1,111 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
+)
+
+// SwapVerifier verifies that the HTLC an incoming swap mirrors exists on the counterparty chain of its asset. It is
+// set on the keeper of chains with assets that verify incoming swaps.
+type SwapVerifier interface {
+	// VerifyHeader checks a header of the counterparty chain against the trusted light client state and returns the
+	// light client state at the header.
+	VerifyHeader(trusted LightClientState, header []byte) (LightClientState, error)
+	// VerifyHTLC checks that the proof includes an HTLC matching the msg, including its sender and recipient on this
+	// chain, in the counterparty chain state committed to by the light client state.
+	VerifyHTLC(state LightClientState, msg MsgCreateAtomicSwap, proof []byte) error
+}
+
+// NewLightClientState returns a new LightClientState
+func NewLightClientState(denom, chainID string, height int64, root, data []byte) LightClientState {
+	return LightClientState{
+		Denom:   denom,
+		ChainID: chainID,
+		Height:  height,
+		Root:    root,
+		Data:    data,
+	}
+}
+
+// Validate performs a basic validation of a light client state
+func (s LightClientState) Validate() error {
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return err
+	}
+	if strings.TrimSpace(s.ChainID) == "" {
+		return fmt.Errorf("light client state of %s has no chain ID", s.Denom)
+	}
+	if s.Height <= 0 {
+		return fmt.Errorf("light client state of %s has non positive height %d", s.Denom, s.Height)
+	}
+	if len(s.Root) == 0 {
+		return fmt.Errorf("light client state of %s has no root", s.Denom)
+	}
+	return nil
+}
+
+// MockSwapVerifier is a SwapVerifier for tests that trusts every header of the counterparty chain. A header is the
+// marshalled light client state at the header, and a proof is a marshalled tendermint Merkle proof of the leaf
+// returned by MockHTLCLeaf.
+type MockSwapVerifier struct{}
+
+var _ SwapVerifier = MockSwapVerifier{}
+
+// VerifyHeader implements SwapVerifier
+func (MockSwapVerifier) VerifyHeader(trusted LightClientState, header []byte) (LightClientState, error) {
+	var state LightClientState
+	if err := state.Unmarshal(header); err != nil {
+		return LightClientState{}, err
+	}
+	if state.ChainID != trusted.ChainID {
+		return LightClientState{}, fmt.Errorf("header of chain %s, expected %s", state.ChainID, trusted.ChainID)
+	}
+	state.Denom = trusted.Denom
+	return state, state.Validate()
+}
+
+// VerifyHTLC implements SwapVerifier
+func (MockSwapVerifier) VerifyHTLC(state LightClientState, msg MsgCreateAtomicSwap, proof []byte) error {
+	var pb tmcrypto.Proof
+	if err := pb.Unmarshal(proof); err != nil {
+		return err
+	}
+	merkleProof, err := merkle.ProofFromProto(&pb)
+	if err != nil {
+		return err
+	}
+	return merkleProof.Verify(state.Root, MockHTLCLeaf(msg))
+}
+
+// MockHTLCLeaf returns the leaf MockSwapVerifier expects to be included in the counterparty chain state for the HTLC
+// an incoming swap mirrors. The HTLC names the deputy and the recipient on this chain, so that a proof cannot be
+// replayed to pay another recipient.
+func MockHTLCLeaf(msg MsgCreateAtomicSwap) []byte {
+	var leaf bytes.Buffer
+	for _, field := range []string{msg.From, msg.To, msg.SenderOtherChain, msg.RecipientOtherChain,
+		msg.RandomNumberHash.String(), fmt.Sprint(msg.Timestamp), msg.Amount.String()} {
+		leaf.WriteString(field)
+		leaf.WriteByte('/')
+	}
+	return leaf.Bytes()
+}
+
+// ValidateSwapProof performs a basic validation of the proof carried by a MsgCreateAtomicSwap
+func ValidateSwapProof(proof *SwapProof) error {
+	if proof == nil {
+		return nil
+	}
+	if len(proof.Header) == 0 {
+		return sdkerrors.Wrap(ErrInvalidSwapProof, "no header")
+	}
+	if len(proof.Proof) == 0 {
+		return sdkerrors.Wrap(ErrInvalidSwapProof, "no inclusion proof")
+	}
+	return nil
+}
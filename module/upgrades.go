@@ -0,0 +1,20 @@
+package bep3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// LongtermStorageUpgradeName is the name of the software upgrade plan that migrates the state of chains started with a
+// module version that keyed the longterm storage index by deletion height, and did not index swaps by random number
+// hash.
+const LongtermStorageUpgradeName = "bep3-longterm-storage"
+
+// NewLongtermStorageUpgradeHandler returns the upgrade handler of LongtermStorageUpgradeName, which chains register
+// with their upgrade keeper before the plan's height is reached.
+func NewLongtermStorageUpgradeHandler(k Keeper) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan) {
+		k.MigrateLongtermStorage(ctx)
+		k.MigrateRandomNumberHashIndex(ctx)
+	}
+}
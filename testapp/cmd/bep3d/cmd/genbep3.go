@@ -0,0 +1,346 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	bep3 "github.com/e-money/bep3/module"
+	"github.com/spf13/cobra"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// Flags of the bep3 genesis commands
+const (
+	flagFixedFee              = "fixed-fee"
+	flagMinSwapAmount         = "min-swap-amount"
+	flagMaxSwapAmount         = "max-swap-amount"
+	flagSwapTimeSpan          = "swap-time-span"
+	flagTimeBasedLimit        = "time-based-limit"
+	flagTimePeriod            = "time-period"
+	flagInactive              = "inactive"
+	flagMaxDeputyInactivity   = "max-deputy-inactivity"
+	flagDeputyBond            = "deputy-bond"
+	flagDeputyUnbondingPeriod = "deputy-unbonding-period"
+	flagDeputySlashAmount     = "deputy-slash-amount"
+	flagSlashOnRefund         = "slash-on-refund"
+	flagFundDeputy            = "fund-deputy"
+)
+
+// AddGenesisBep3AssetCmd returns add-genesis-bep3-asset cobra Command.
+func AddGenesisBep3AssetCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-bep3-asset [denom] [coin-id] [supply-limit] [deputy_address_or_key_name]",
+		Short: "Add a bep3 asset to genesis.json",
+		Long: `Add a supported asset to the bep3 params in genesis.json, together with an asset supply of zero.
+The coin ID is the SLIP-0044 coin type of the asset. The asset param is validated together with the
+assets already in genesis.json. With --fund-deputy, the deputy account is added if it does not exist
+and funded with the supply limit of the asset, so that it can create outgoing swaps.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cdc := clientCtx.JSONMarshaler.(codec.Marshaler)
+
+			config := server.GetServerContextFromCmd(cmd).Config
+			config.SetRoot(clientCtx.HomeDir)
+
+			coinID, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse coin id: %w", err)
+			}
+			limit, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("failed to parse supply limit %s", args[2])
+			}
+			deputy, err := getAddress(cmd, clientCtx, args[3])
+			if err != nil {
+				return err
+			}
+
+			asset := bep3.AssetParam{
+				Denom:  args[0],
+				CoinID: coinID,
+				SupplyLimit: bep3.SupplyLimit{
+					Limit:          limit,
+					TimeBasedLimit: sdk.ZeroInt(),
+				},
+				Active:          true,
+				DeputyAddress:   deputy.String(),
+				FixedFee:        sdk.ZeroInt(),
+				MinSwapAmount:   sdk.OneInt(),
+				MaxSwapAmount:   limit,
+				SwapTimestamp:   bep3.DefaultSwapBlockTimestamp,
+				SwapTimeSpanMin: bep3.DefaultSwapTimeSpanMinutes,
+			}
+			if err := applyAssetFlags(cmd, &asset); err != nil {
+				return err
+			}
+			if err := applyDeputyFlags(cmd, &asset); err != nil {
+				return err
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			var bep3GenState bep3.GenesisState
+			if err := cdc.UnmarshalJSON(appState[bep3.ModuleName], &bep3GenState); err != nil {
+				return fmt.Errorf("failed to unmarshal bep3 genesis state: %w", err)
+			}
+			for _, supply := range bep3GenState.Supplies.AssetSupplies {
+				if supply.GetDenom() == asset.Denom {
+					return fmt.Errorf("cannot add asset %s with an existing asset supply", asset.Denom)
+				}
+			}
+
+			// Duplicate denoms are rejected by the params validation
+			bep3GenState.Params.AssetParams = append(bep3GenState.Params.AssetParams, asset)
+			if err := bep3GenState.Params.Validate(); err != nil {
+				return fmt.Errorf("invalid asset: %w", err)
+			}
+			zero := sdk.NewCoin(asset.Denom, sdk.ZeroInt())
+			bep3GenState.Supplies.AssetSupplies = append(bep3GenState.Supplies.AssetSupplies,
+				bep3.NewAssetSupply(zero, zero, zero, zero, 0))
+
+			if appState[bep3.ModuleName], err = cdc.MarshalJSON(&bep3GenState); err != nil {
+				return fmt.Errorf("failed to marshal bep3 genesis state: %w", err)
+			}
+			return writeBep3Genesis(cmd, cdc, appState, genDoc, genFile, asset)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	cmd.Flags().String(flagMinSwapAmount, "1", "Minimum amount of a swap")
+	cmd.Flags().String(flagMaxSwapAmount, "", "Maximum amount of a swap, the supply limit if empty")
+	cmd.Flags().Int64(flagSwapTimeSpan, bep3.DefaultSwapTimeSpanMinutes, "Minutes before a swap expires")
+	cmd.Flags().String(flagTimeBasedLimit, "", "Limit of the supply increase in each time period, no limit if empty")
+	cmd.Flags().Duration(flagTimePeriod, 24*time.Hour, "Time period of the time based supply limit")
+	cmd.Flags().Bool(flagInactive, false, "Add the asset as inactive")
+	addDeputyFlags(cmd)
+
+	return cmd
+}
+
+// AddGenesisBep3DeputyCmd returns add-genesis-bep3-deputy cobra Command.
+func AddGenesisBep3DeputyCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-bep3-deputy [address_or_key_name] [denom]",
+		Short: "Set the deputy of a bep3 asset in genesis.json",
+		Long: `Set the deputy of an asset in the bep3 params of genesis.json, which may have been added with
+add-genesis-bep3-asset. The deputy settings given by flags replace those of the asset, the others are
+kept. With --fund-deputy, the deputy account is added if it does not exist and funded with the supply
+limit of the asset, so that it can create outgoing swaps.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cdc := clientCtx.JSONMarshaler.(codec.Marshaler)
+
+			config := server.GetServerContextFromCmd(cmd).Config
+			config.SetRoot(clientCtx.HomeDir)
+
+			deputy, err := getAddress(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			var bep3GenState bep3.GenesisState
+			if err := cdc.UnmarshalJSON(appState[bep3.ModuleName], &bep3GenState); err != nil {
+				return fmt.Errorf("failed to unmarshal bep3 genesis state: %w", err)
+			}
+			var asset *bep3.AssetParam
+			for i := range bep3GenState.Params.AssetParams {
+				if bep3GenState.Params.AssetParams[i].Denom == args[1] {
+					asset = &bep3GenState.Params.AssetParams[i]
+				}
+			}
+			if asset == nil {
+				return fmt.Errorf("asset %s is not in the bep3 genesis state", args[1])
+			}
+
+			asset.DeputyAddress = deputy.String()
+			if err := applyDeputyFlags(cmd, asset); err != nil {
+				return err
+			}
+			if err := bep3GenState.Params.Validate(); err != nil {
+				return fmt.Errorf("invalid deputy: %w", err)
+			}
+
+			if appState[bep3.ModuleName], err = cdc.MarshalJSON(&bep3GenState); err != nil {
+				return fmt.Errorf("failed to marshal bep3 genesis state: %w", err)
+			}
+			return writeBep3Genesis(cmd, cdc, appState, genDoc, genFile, *asset)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	addDeputyFlags(cmd)
+
+	return cmd
+}
+
+func addDeputyFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagFixedFee, "0", "Fixed fee the deputy charges for outgoing swaps")
+	cmd.Flags().Int64(flagMaxDeputyInactivity, 0, "Blocks the deputy may go without acting before outgoing swaps are suspended, 0 disables the check")
+	cmd.Flags().String(flagDeputyBond, "", "Collateral the deputy must have bonded for swaps to be created")
+	cmd.Flags().Duration(flagDeputyUnbondingPeriod, 0, "Time unbonded collateral remains slashable")
+	cmd.Flags().String(flagDeputySlashAmount, "", "Collateral slashed for each swap the deputy failed to honor")
+	cmd.Flags().Bool(flagSlashOnRefund, false, "Slash the deputy when an expired outgoing swap is refunded")
+	cmd.Flags().Bool(flagFundDeputy, false, "Fund the deputy account with the supply limit of the asset")
+}
+
+// applyAssetFlags sets the swap and supply limit settings of an asset given by flags
+func applyAssetFlags(cmd *cobra.Command, asset *bep3.AssetParam) error {
+	minSwapAmount, _ := cmd.Flags().GetString(flagMinSwapAmount)
+	amount, ok := sdk.NewIntFromString(minSwapAmount)
+	if !ok {
+		return fmt.Errorf("failed to parse %s %s", flagMinSwapAmount, minSwapAmount)
+	}
+	asset.MinSwapAmount = amount
+
+	if maxSwapAmount, _ := cmd.Flags().GetString(flagMaxSwapAmount); maxSwapAmount != "" {
+		amount, ok := sdk.NewIntFromString(maxSwapAmount)
+		if !ok {
+			return fmt.Errorf("failed to parse %s %s", flagMaxSwapAmount, maxSwapAmount)
+		}
+		asset.MaxSwapAmount = amount
+	}
+
+	if timeBasedLimit, _ := cmd.Flags().GetString(flagTimeBasedLimit); timeBasedLimit != "" {
+		limit, ok := sdk.NewIntFromString(timeBasedLimit)
+		if !ok {
+			return fmt.Errorf("failed to parse %s %s", flagTimeBasedLimit, timeBasedLimit)
+		}
+		timePeriod, _ := cmd.Flags().GetDuration(flagTimePeriod)
+		asset.SupplyLimit.TimeLimited = true
+		asset.SupplyLimit.TimeBasedLimit = limit
+		asset.SupplyLimit.TimePeriod = int64(timePeriod)
+	}
+
+	asset.SwapTimeSpanMin, _ = cmd.Flags().GetInt64(flagSwapTimeSpan)
+	inactive, _ := cmd.Flags().GetBool(flagInactive)
+	asset.Active = !inactive
+	return nil
+}
+
+// applyDeputyFlags sets the deputy settings of an asset that were given by flags
+func applyDeputyFlags(cmd *cobra.Command, asset *bep3.AssetParam) error {
+	if cmd.Flags().Changed(flagFixedFee) {
+		fixedFee, _ := cmd.Flags().GetString(flagFixedFee)
+		fee, ok := sdk.NewIntFromString(fixedFee)
+		if !ok {
+			return fmt.Errorf("failed to parse %s %s", flagFixedFee, fixedFee)
+		}
+		asset.FixedFee = fee
+	}
+	if cmd.Flags().Changed(flagMaxDeputyInactivity) {
+		asset.MaxDeputyInactivity, _ = cmd.Flags().GetInt64(flagMaxDeputyInactivity)
+	}
+	if cmd.Flags().Changed(flagDeputyBond) {
+		deputyBond, _ := cmd.Flags().GetString(flagDeputyBond)
+		coins, err := sdk.ParseCoinsNormalized(deputyBond)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", flagDeputyBond, err)
+		}
+		asset.DeputyBond = coins
+	}
+	if cmd.Flags().Changed(flagDeputyUnbondingPeriod) {
+		period, _ := cmd.Flags().GetDuration(flagDeputyUnbondingPeriod)
+		asset.DeputyUnbondingPeriod = int64(period / time.Second)
+	}
+	if cmd.Flags().Changed(flagDeputySlashAmount) {
+		slashAmount, _ := cmd.Flags().GetString(flagDeputySlashAmount)
+		coins, err := sdk.ParseCoinsNormalized(slashAmount)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", flagDeputySlashAmount, err)
+		}
+		asset.DeputySlashAmount = coins
+	}
+	if cmd.Flags().Changed(flagSlashOnRefund) {
+		asset.SlashOnRefund, _ = cmd.Flags().GetBool(flagSlashOnRefund)
+	}
+	return nil
+}
+
+// writeBep3Genesis funds the deputy of an asset if --fund-deputy is given and writes the genesis file
+func writeBep3Genesis(cmd *cobra.Command, cdc codec.Marshaler, appState map[string]json.RawMessage,
+	genDoc *tmtypes.GenesisDoc, genFile string, asset bep3.AssetParam) error {
+	if fund, _ := cmd.Flags().GetBool(flagFundDeputy); fund {
+		coins := sdk.NewCoins(sdk.NewCoin(asset.Denom, asset.SupplyLimit.Limit))
+		if err := fundGenesisAccount(cdc, appState, asset.DeputyAddress, coins); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	genDoc.AppState, err = json.Marshal(appState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal application genesis state: %w", err)
+	}
+	return genutil.ExportGenesisFile(genDoc, genFile)
+}
+
+// fundGenesisAccount adds coins to the balance of an account and to the total supply in bank genesis, adding the
+// account to auth genesis if it does not exist yet. Like the simulation's bank genesis, it funds a deputy with
+// the supply limit of its asset.
+func fundGenesisAccount(cdc codec.Marshaler, appState map[string]json.RawMessage, address string, coins sdk.Coins) error {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return err
+	}
+
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return fmt.Errorf("failed to get accounts from any: %w", err)
+	}
+	if !accs.Contains(addr) {
+		accs = authtypes.SanitizeGenesisAccounts(append(accs, authtypes.NewBaseAccount(addr, nil, 0, 0)))
+		authGenState.Accounts, err = authtypes.PackAccounts(accs)
+		if err != nil {
+			return fmt.Errorf("failed to convert accounts into any's: %w", err)
+		}
+		appState[authtypes.ModuleName], err = cdc.MarshalJSON(&authGenState)
+		if err != nil {
+			return fmt.Errorf("failed to marshal auth genesis state: %w", err)
+		}
+	}
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	funded := false
+	for i, balance := range bankGenState.Balances {
+		if balance.Address == address {
+			bankGenState.Balances[i].Coins = balance.Coins.Add(coins...)
+			funded = true
+		}
+	}
+	if !funded {
+		bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: address, Coins: coins})
+	}
+	bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+	bankGenState.Supply = bankGenState.Supply.Add(coins...)
+	appState[banktypes.ModuleName], err = cdc.MarshalJSON(bankGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package cmd_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltest "github.com/cosmos/cosmos-sdk/x/genutil/client/testutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	bep3 "github.com/e-money/bep3/module"
+	"github.com/e-money/bep3/testapp"
+	"github.com/e-money/bep3/testapp/cmd/bep3d/cmd"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestAddGenesisBep3AssetAndDeputy(t *testing.T) {
+	home := t.TempDir()
+	cfg, err := genutiltest.CreateDefaultTendermintConfig(home)
+	require.NoError(t, err)
+	cdc := testapp.MakeEncodingConfig().Marshaller
+	require.NoError(t, genutiltest.ExecInitCmd(testapp.ModuleBasics, home, cdc))
+
+	serverCtx := server.NewContext(viper.New(), cfg, log.NewNopLogger())
+	clientCtx := client.Context{}.WithJSONMarshaler(cdc).WithHomeDir(home)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+	ctx = context.WithValue(ctx, server.ServerContextKey, serverCtx)
+	execute := func(command *cobra.Command, args ...string) error {
+		command.SetArgs(append(args, "--home", home))
+		return command.ExecuteContext(ctx)
+	}
+
+	deputy := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	require.NoError(t, execute(cmd.AddGenesisBep3AssetCmd(home), "bnb", "714", "1000000", deputy.String(),
+		"--fixed-fee", "1000", "--max-swap-amount", "50000", "--time-based-limit", "100000", "--fund-deputy"))
+
+	// An asset can only be added once, and must be valid
+	require.Error(t, execute(cmd.AddGenesisBep3AssetCmd(home), "bnb", "714", "1000000", deputy.String()))
+	require.Error(t, execute(cmd.AddGenesisBep3AssetCmd(home), "inc", "9999", "1000", deputy.String(),
+		"--min-swap-amount", "2000"))
+
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(cfg.GenesisFile())
+	require.NoError(t, err)
+	var bep3GenState bep3.GenesisState
+	cdc.MustUnmarshalJSON(appState[bep3.ModuleName], &bep3GenState)
+	require.NoError(t, bep3GenState.Validate())
+	require.Len(t, bep3GenState.Params.AssetParams, 1)
+	asset := bep3GenState.Params.AssetParams[0]
+	require.Equal(t, deputy.String(), asset.DeputyAddress)
+	require.Equal(t, sdk.NewInt(1000), asset.FixedFee)
+	require.Equal(t, sdk.NewInt(50000), asset.MaxSwapAmount)
+	require.True(t, asset.SupplyLimit.TimeLimited)
+	require.True(t, asset.Active)
+	zero := sdk.NewInt64Coin("bnb", 0)
+	require.Equal(t, []bep3.AssetSupply{bep3.NewAssetSupply(zero, zero, zero, zero, 0)},
+		bep3GenState.Supplies.AssetSupplies)
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	require.Equal(t, []banktypes.Balance{{Address: deputy.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("bnb", 1000000))}},
+		bankGenState.Balances)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("bnb", 1000000)), bankGenState.Supply)
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	require.NoError(t, err)
+	require.True(t, accs.Contains(deputy))
+
+	// Replacing the deputy keeps the settings that are not given
+	newDeputy := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	require.Error(t, execute(cmd.AddGenesisBep3DeputyCmd(home), newDeputy.String(), "inc"))
+	require.NoError(t, execute(cmd.AddGenesisBep3DeputyCmd(home), newDeputy.String(), "bnb",
+		"--max-deputy-inactivity", "100", "--deputy-bond", "500stake", "--fund-deputy"))
+
+	appState, _, err = genutiltypes.GenesisStateFromGenFile(cfg.GenesisFile())
+	require.NoError(t, err)
+	cdc.MustUnmarshalJSON(appState[bep3.ModuleName], &bep3GenState)
+	asset = bep3GenState.Params.AssetParams[0]
+	require.Equal(t, newDeputy.String(), asset.DeputyAddress)
+	require.Equal(t, sdk.NewInt(1000), asset.FixedFee)
+	require.Equal(t, int64(100), asset.MaxDeputyInactivity)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 500)), asset.DeputyBond)
+	bankGenState = banktypes.GetGenesisStateFromAppState(cdc, appState)
+	require.Len(t, bankGenState.Balances, 2)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("bnb", 2000000)), bankGenState.Supply)
+}
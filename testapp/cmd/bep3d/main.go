@@ -0,0 +1,24 @@
+package main
+
+import (
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+	"github.com/e-money/bep3/testapp"
+	"github.com/e-money/bep3/testapp/cmd/bep3d/cmd"
+)
+
+func main() {
+	rootCmd := cmd.NewRootCmd()
+
+	if err := svrcmd.Execute(rootCmd, testapp.DefaultNodeHome); err != nil {
+		switch e := err.(type) {
+		case server.ErrorCode:
+			os.Exit(e.Code)
+
+		default:
+			os.Exit(1)
+		}
+	}
+}
@@ -28,6 +28,20 @@ func CreateTestComponents(t *testing.T) (
 	bep3types.AccountKeeper,
 	bep3types.BankKeeper,
 	bep3.AppModule) {
+	ctx, cdc, bep3Keeper, accountKeeper, bankKeeper, appModule, _ := CreateTestComponentsWithStoreKey(t)
+	return ctx, cdc, bep3Keeper, accountKeeper, bankKeeper, appModule
+}
+
+// CreateTestComponentsWithStoreKey is CreateTestComponents that also returns the bep3 store key, for tests that
+// write module state directly.
+func CreateTestComponentsWithStoreKey(t *testing.T) (
+	sdk.Context,
+	codec.JSONMarshaler,
+	bep3.Keeper,
+	bep3types.AccountKeeper,
+	bep3types.BankKeeper,
+	bep3.AppModule,
+	sdk.StoreKey) {
 	encoding := bep3.MakeProtoEncodingConfig()
 
 	db := dbm.NewMemDB()
@@ -75,5 +89,6 @@ func CreateTestComponents(t *testing.T) (
 		bep3Keeper,
 		accountKeeper,
 		bankKeeper,
-		bep3.NewAppModule(bep3Keeper, accountKeeper, bankKeeper)
+		bep3.NewAppModule(bep3Keeper, accountKeeper, bankKeeper),
+		keys[bep3.StoreKey]
 }
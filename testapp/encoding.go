@@ -0,0 +1,31 @@
+package testapp
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+	bep3 "github.com/e-money/bep3/module"
+)
+
+// MakeEncodingConfig creates the protobuf EncodingConfig of the test app with all its modules registered.
+// Transactions are signed with SIGN_MODE_DIRECT.
+func MakeEncodingConfig() bep3.EncodingConfig {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	marshaller := codec.NewProtoCodec(interfaceRegistry)
+
+	encodingConfig := bep3.EncodingConfig{
+		InterfaceRegistry: interfaceRegistry,
+		Marshaller:        marshaller,
+		TxConfig:          tx.NewTxConfig(marshaller, []signing.SignMode{signing.SignMode_SIGN_MODE_DIRECT}),
+		Amino:             codec.NewLegacyAmino(),
+	}
+
+	std.RegisterLegacyAminoCodec(encodingConfig.Amino)
+	std.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	ModuleBasics.RegisterLegacyAminoCodec(encodingConfig.Amino)
+	ModuleBasics.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+
+	return encodingConfig
+}
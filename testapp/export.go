@@ -0,0 +1,86 @@
+package testapp
+
+import (
+	"encoding/json"
+	"fmt"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	bep3 "github.com/e-money/bep3/module"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// ExportAppStateAndValidators exports the state of the application for a genesis file.
+func (app *App) ExportAppStateAndValidators(
+	forZeroHeight bool, jailAllowedAddrs []string,
+) (servertypes.ExportedApp, error) {
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+
+	// We export at last height + 1, because that's the height at which Tendermint will start InitChain.
+	height := app.LastBlockHeight() + 1
+	if forZeroHeight {
+		height = 0
+		if err := app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs); err != nil {
+			return servertypes.ExportedApp{}, err
+		}
+	}
+
+	genState := app.mm.ExportGenesis(ctx, app.appCodec)
+	if forZeroHeight {
+		// Record the export height, so that the swaps are rebased onto the heights and times of the new chain
+		genState[bep3.ModuleName] = app.appCodec.MustMarshalJSON(bep3.ExportGenesisForZeroHeight(ctx, app.Bep3Keeper))
+	}
+	appState, err := json.MarshalIndent(genState, "", "  ")
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	validators, err := staking.WriteValidators(ctx, app.StakingKeeper)
+	return servertypes.ExportedApp{
+		AppState:        appState,
+		Validators:      validators,
+		Height:          height,
+		ConsensusParams: app.BaseApp.GetConsensusParams(ctx),
+	}, err
+}
+
+// prepForZeroHeightGenesis resets the heights recorded in the staking state for a fresh start at height zero,
+// jailing the validators not in jailAllowedAddrs if it is not empty.
+func (app *App) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []string) error {
+	allowedAddrs := make(map[string]bool)
+	for _, addr := range jailAllowedAddrs {
+		if _, err := sdk.ValAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid jail allowed address %s: %w", addr, err)
+		}
+		allowedAddrs[addr] = true
+	}
+
+	app.StakingKeeper.IterateRedelegations(ctx, func(_ int64, red stakingtypes.Redelegation) (stop bool) {
+		for i := range red.Entries {
+			red.Entries[i].CreationHeight = 0
+		}
+		app.StakingKeeper.SetRedelegation(ctx, red)
+		return false
+	})
+
+	app.StakingKeeper.IterateUnbondingDelegations(ctx, func(_ int64, ubd stakingtypes.UnbondingDelegation) (stop bool) {
+		for i := range ubd.Entries {
+			ubd.Entries[i].CreationHeight = 0
+		}
+		app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
+		return false
+	})
+
+	for _, validator := range app.StakingKeeper.GetAllValidators(ctx) {
+		validator.UnbondingHeight = 0
+		if len(allowedAddrs) > 0 && !allowedAddrs[validator.OperatorAddress] {
+			validator.Jailed = true
+		}
+		app.StakingKeeper.SetValidator(ctx, validator)
+	}
+
+	_, err := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	return err
+}
@@ -0,0 +1,494 @@
+// Package ibctesting runs in-memory chains of the test app and relays IBC messages between them. It adapts the
+// TestChain and Coordinator of the SDK's x/ibc/testing package, which only run simapp, and reuses its connection and
+// channel types and its light client parameters.
+package ibctesting
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/core/03-connection/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/23-commitment/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+	"github.com/cosmos/cosmos-sdk/x/ibc/core/exported"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/light-clients/07-tendermint/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+	"github.com/cosmos/cosmos-sdk/x/ibc/testing/mock"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	bep3types "github.com/e-money/bep3/module/types"
+	app "github.com/e-money/bep3/testapp"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmprotoversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	tmtypes "github.com/tendermint/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/version"
+	dbm "github.com/tendermint/tm-db"
+)
+
+type (
+	TestConnection = ibctesting.TestConnection
+	TestChannel    = ibctesting.TestChannel
+)
+
+// GenesisModifier changes the genesis state of a TestChain before the chain is initialized
+type GenesisModifier func(chain *TestChain, genesisState app.GenesisState)
+
+// TestChain is an in-memory chain of the test app with a single validator. The SenderAccount signs the transactions
+// delivered to the chain and the IBC messages relayed to it.
+type TestChain struct {
+	t *testing.T
+
+	App           *app.App
+	ChainID       string
+	LastHeader    *ibctmtypes.Header // header for last block height committed
+	CurrentHeader tmproto.Header     // header for current block height
+	TxConfig      client.TxConfig
+
+	Vals    *tmtypes.ValidatorSet
+	Signers []tmtypes.PrivValidator
+
+	senderPrivKey cryptotypes.PrivKey
+	SenderAccount authtypes.AccountI
+
+	ClientIDs   []string
+	Connections []*TestConnection
+}
+
+// NewTestChain initializes a TestChain whose sender account holds the given coins besides the staking denom. The
+// genesis state is the default one of the test app, changed by modifyGenesis if it is not nil.
+//
+// The genesis block is committed so that clients of the chain can be created on counterparty chains, and the chain
+// returns at block height 2.
+func NewTestChain(t *testing.T, chainID string, coins sdk.Coins, modifyGenesis GenesisModifier) *TestChain {
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(pubKey, 1)})
+
+	senderPrivKey := secp256k1.GenPrivKey()
+	acc := authtypes.NewBaseAccount(senderPrivKey.PubKey().Address().Bytes(), senderPrivKey.PubKey(), 0, 0)
+
+	encodingConfig := app.MakeEncodingConfig()
+	chain := &TestChain{
+		t:             t,
+		App:           app.NewApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, 0, encodingConfig),
+		ChainID:       chainID,
+		TxConfig:      encodingConfig.TxConfig,
+		Vals:          valSet,
+		Signers:       []tmtypes.PrivValidator{privVal},
+		senderPrivKey: senderPrivKey,
+		SenderAccount: acc,
+	}
+	genesisState := chain.genesisState(coins)
+	if modifyGenesis != nil {
+		modifyGenesis(chain, genesisState)
+	}
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+
+	chain.App.InitChain(abci.RequestInitChain{
+		ChainId:         chainID,
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	chain.App.Commit()
+
+	chain.CurrentHeader = tmproto.Header{ChainID: chainID, Height: 1, Time: globalStartTime}
+	chain.NextBlock()
+	return chain
+}
+
+// genesisState returns the default genesis state with the validator of the chain bonded by the sender account
+func (chain *TestChain) genesisState(coins sdk.Coins) app.GenesisState {
+	cdc := chain.App.AppCodec()
+	genesisState := app.NewDefaultGenesisState(cdc)
+
+	authGenesis := authtypes.NewGenesisState(authtypes.DefaultParams(), []authtypes.GenesisAccount{chain.SenderAccount.(authtypes.GenesisAccount)})
+	genesisState[authtypes.ModuleName] = cdc.MustMarshalJSON(authGenesis)
+
+	bondAmt := sdk.NewInt(1000000)
+	validators := make([]stakingtypes.Validator, 0, len(chain.Vals.Validators))
+	delegations := make([]stakingtypes.Delegation, 0, len(chain.Vals.Validators))
+	for _, val := range chain.Vals.Validators {
+		pk, err := cryptocodec.FromTmPubKeyInterface(val.PubKey)
+		require.NoError(chain.t, err)
+		pkAny, err := codectypes.NewAnyWithValue(pk)
+		require.NoError(chain.t, err)
+		validators = append(validators, stakingtypes.Validator{
+			OperatorAddress:   sdk.ValAddress(val.Address).String(),
+			ConsensusPubkey:   pkAny,
+			Status:            stakingtypes.Bonded,
+			Tokens:            bondAmt,
+			DelegatorShares:   sdk.OneDec(),
+			UnbondingTime:     time.Unix(0, 0).UTC(),
+			Commission:        stakingtypes.NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+			MinSelfDelegation: sdk.ZeroInt(),
+		})
+		delegations = append(delegations, stakingtypes.NewDelegation(chain.SenderAccount.GetAddress(), val.Address.Bytes(), sdk.OneDec()))
+	}
+	stakingGenesis := stakingtypes.NewGenesisState(stakingtypes.DefaultParams(), validators, delegations)
+	genesisState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(stakingGenesis)
+
+	balance := banktypes.Balance{
+		Address: chain.SenderAccount.GetAddress().String(),
+		Coins:   coins.Add(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000000000000))),
+	}
+	totalSupply := balance.Coins.Add(sdk.NewCoin(sdk.DefaultBondDenom, bondAmt))
+	bankGenesis := banktypes.NewGenesisState(banktypes.DefaultGenesisState().Params, []banktypes.Balance{balance},
+		totalSupply, []banktypes.Metadata{})
+	genesisState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankGenesis)
+
+	return genesisState
+}
+
+// GetContext returns the current context for the application.
+func (chain *TestChain) GetContext() sdk.Context {
+	return chain.App.BaseApp.NewContext(false, chain.CurrentHeader)
+}
+
+// QueryProof performs an abci query with the given key and returns the proto encoded merkle proof for the query and
+// the height at which the proof will succeed on a tendermint verifier.
+func (chain *TestChain) QueryProof(key []byte) ([]byte, clienttypes.Height) {
+	res := chain.App.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("store/%s/key", host.StoreKey),
+		Height: chain.App.LastBlockHeight() - 1,
+		Data:   key,
+		Prove:  true,
+	})
+
+	merkleProof, err := commitmenttypes.ConvertProofs(res.ProofOps)
+	require.NoError(chain.t, err)
+
+	proof, err := chain.App.AppCodec().MarshalBinaryBare(&merkleProof)
+	require.NoError(chain.t, err)
+
+	// The proof is of the IAVL tree at res.Height, which tendermint headers commit to one height later
+	revision := clienttypes.ParseChainID(chain.ChainID)
+	return proof, clienttypes.NewHeight(revision, uint64(res.Height)+1)
+}
+
+// QueryClientStateProof performs an abci query for a client state stored with a given clientID and returns the
+// ClientState along with the proof
+func (chain *TestChain) QueryClientStateProof(clientID string) (exported.ClientState, []byte) {
+	clientState := chain.GetClientState(clientID)
+	proofClient, _ := chain.QueryProof(host.FullClientStateKey(clientID))
+	return clientState, proofClient
+}
+
+// QueryConsensusStateProof performs an abci query for a consensus state stored on the given clientID. The proof and
+// consensusHeight are returned.
+func (chain *TestChain) QueryConsensusStateProof(clientID string) ([]byte, clienttypes.Height) {
+	consensusHeight := chain.GetClientState(clientID).GetLatestHeight().(clienttypes.Height)
+	proofConsensus, _ := chain.QueryProof(host.FullConsensusStateKey(clientID, consensusHeight))
+	return proofConsensus, consensusHeight
+}
+
+// NextBlock sets the last header to the current header and begins the next block. It does not update the time as
+// that is handled by the Coordinator.
+//
+// CONTRACT: this function must only be called after app.Commit() occurs
+func (chain *TestChain) NextBlock() {
+	chain.LastHeader = chain.CurrentTMClientHeader()
+
+	chain.CurrentHeader = tmproto.Header{
+		ChainID:            chain.ChainID,
+		Height:             chain.App.LastBlockHeight() + 1,
+		AppHash:            chain.App.LastCommitID().Hash,
+		Time:               chain.CurrentHeader.Time,
+		ValidatorsHash:     chain.Vals.Hash(),
+		NextValidatorsHash: chain.Vals.Hash(),
+	}
+
+	chain.App.BeginBlock(abci.RequestBeginBlock{Header: chain.CurrentHeader})
+}
+
+// SendMsgs delivers a transaction of the messages signed by the sender account and commits the block. Unlike the
+// SDK's TestChain, a failing transaction is returned as an error rather than failing the test.
+func (chain *TestChain) SendMsgs(msgs ...sdk.Msg) (*sdk.Result, error) {
+	tx, err := helpers.GenTx(chain.TxConfig, msgs, sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)},
+		helpers.DefaultGenTxGas, chain.ChainID, []uint64{chain.SenderAccount.GetAccountNumber()},
+		[]uint64{chain.SenderAccount.GetSequence()}, chain.senderPrivKey)
+	require.NoError(chain.t, err)
+
+	_, res, err := chain.App.Deliver(chain.TxConfig.TxEncoder(), tx)
+	chain.App.EndBlock(abci.RequestEndBlock{Height: chain.CurrentHeader.Height})
+	chain.App.Commit()
+	chain.NextBlock()
+
+	// The sequence is incremented by the ante handler, even if the messages fail
+	chain.SenderAccount = chain.App.AccountKeeper.GetAccount(chain.GetContext(), chain.SenderAccount.GetAddress())
+	return res, err
+}
+
+// GetClientState retrieves the client state for the provided clientID. The client is expected to exist otherwise
+// testing will fail.
+func (chain *TestChain) GetClientState(clientID string) exported.ClientState {
+	clientState, found := chain.App.IBCKeeper.ClientKeeper.GetClientState(chain.GetContext(), clientID)
+	require.True(chain.t, found)
+	return clientState
+}
+
+// GetValsAtHeight will return the validator set of the chain at a given height. It will return a success boolean
+// depending on if the validator set exists or not at that height.
+func (chain *TestChain) GetValsAtHeight(height int64) (*tmtypes.ValidatorSet, bool) {
+	histInfo, ok := chain.App.StakingKeeper.GetHistoricalInfo(chain.GetContext(), height)
+	if !ok {
+		return nil, false
+	}
+	tmValidators, err := teststaking.ToTmValidators(stakingtypes.Validators(histInfo.Valset))
+	require.NoError(chain.t, err)
+	return tmtypes.NewValidatorSet(tmValidators), true
+}
+
+// GetChannel retrieves an IBC Channel for the provided TestChannel. The channel is expected to exist otherwise
+// testing will fail.
+func (chain *TestChain) GetChannel(testChannel TestChannel) channeltypes.Channel {
+	channel, found := chain.App.IBCKeeper.ChannelKeeper.GetChannel(chain.GetContext(), testChannel.PortID, testChannel.ID)
+	require.True(chain.t, found)
+	return channel
+}
+
+// GetPrefix returns the prefix for used by a chain in connection creation
+func (chain *TestChain) GetPrefix() commitmenttypes.MerklePrefix {
+	return commitmenttypes.NewMerklePrefix(chain.App.IBCKeeper.ConnectionKeeper.GetCommitmentPrefix().Bytes())
+}
+
+// NewClientID appends a new clientID string in the format <client-type>-<index>
+func (chain *TestChain) NewClientID(clientType string) string {
+	clientID := fmt.Sprintf("%s-%s", clientType, strconv.Itoa(len(chain.ClientIDs)))
+	chain.ClientIDs = append(chain.ClientIDs, clientID)
+	return clientID
+}
+
+// AddTestConnection appends a new TestConnection which contains references to the connection id, client id and
+// counterparty client id. Channels of the connection use the bep3 interchain swap version.
+func (chain *TestChain) AddTestConnection(clientID, counterpartyClientID string) *TestConnection {
+	conn := &TestConnection{
+		ID:                   connectiontypes.FormatConnectionIdentifier(uint64(len(chain.Connections))),
+		ClientID:             clientID,
+		NextChannelVersion:   bep3types.Version,
+		CounterpartyClientID: counterpartyClientID,
+	}
+	chain.Connections = append(chain.Connections, conn)
+	return conn
+}
+
+// AddTestChannel appends the TestChannel the next channel of the port opened on the connection will be
+func (chain *TestChain) AddTestChannel(conn *TestConnection, portID string) TestChannel {
+	nextChanSeq := chain.App.IBCKeeper.ChannelKeeper.GetNextChannelSequence(chain.GetContext())
+	channel := TestChannel{
+		PortID:               portID,
+		ID:                   channeltypes.FormatChannelIdentifier(nextChanSeq),
+		ClientID:             conn.ClientID,
+		CounterpartyClientID: conn.CounterpartyClientID,
+		Version:              conn.NextChannelVersion,
+	}
+	conn.Channels = append(conn.Channels, channel)
+	return channel
+}
+
+// CreateTMClient will construct and execute a 07-tendermint MsgCreateClient. A counterparty client will be created on
+// the (target) chain.
+func (chain *TestChain) CreateTMClient(counterparty *TestChain, clientID string) error {
+	height := counterparty.LastHeader.GetHeight().(clienttypes.Height)
+	clientState := ibctmtypes.NewClientState(
+		counterparty.ChainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod,
+		ibctesting.MaxClockDrift, height, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath, false, false,
+	)
+	msg, err := clienttypes.NewMsgCreateClient(clientState, counterparty.LastHeader.ConsensusState(), chain.SenderAccount.GetAddress())
+	require.NoError(chain.t, err)
+	_, err = chain.SendMsgs(msg)
+	return err
+}
+
+// UpdateTMClient will construct and execute a 07-tendermint MsgUpdateClient. The counterparty client will be updated
+// on the (target) chain.
+func (chain *TestChain) UpdateTMClient(counterparty *TestChain, clientID string) error {
+	header, err := chain.ConstructUpdateTMClientHeader(counterparty, clientID)
+	require.NoError(chain.t, err)
+
+	msg, err := clienttypes.NewMsgUpdateClient(clientID, header, chain.SenderAccount.GetAddress())
+	require.NoError(chain.t, err)
+	_, err = chain.SendMsgs(msg)
+	return err
+}
+
+// ConstructUpdateTMClientHeader will construct a valid 07-tendermint Header to update the light client on the source
+// chain.
+func (chain *TestChain) ConstructUpdateTMClientHeader(counterparty *TestChain, clientID string) (*ibctmtypes.Header, error) {
+	header := counterparty.LastHeader
+	// Relayer must query for LatestHeight on client to get TrustedHeight
+	trustedHeight := chain.GetClientState(clientID).GetLatestHeight().(clienttypes.Height)
+	tmTrustedVals := counterparty.Vals
+	if trustedHeight != counterparty.LastHeader.GetHeight() {
+		// The trusted validators of a header at height h are the next validators committed to in header h, which are
+		// the validators at h+1
+		var ok bool
+		tmTrustedVals, ok = counterparty.GetValsAtHeight(int64(trustedHeight.RevisionHeight + 1))
+		if !ok {
+			return nil, fmt.Errorf("could not retrieve trusted validators at trustedHeight: %d", trustedHeight)
+		}
+	}
+	header.TrustedHeight = trustedHeight
+
+	trustedVals, err := tmTrustedVals.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	header.TrustedValidators = trustedVals
+	return header, nil
+}
+
+// CurrentTMClientHeader creates a TM header using the current header parameters on the chain. The trusted fields in
+// the header are set to nil.
+func (chain *TestChain) CurrentTMClientHeader() *ibctmtypes.Header {
+	return chain.CreateTMClientHeader(chain.ChainID, chain.CurrentHeader.Height, chain.CurrentHeader.Time)
+}
+
+// CreateTMClientHeader creates a TM header signed by the validators of the chain to update the TM client
+func (chain *TestChain) CreateTMClientHeader(chainID string, blockHeight int64, timestamp time.Time) *ibctmtypes.Header {
+	vsetHash := chain.Vals.Hash()
+	tmHeader := tmtypes.Header{
+		Version:            tmprotoversion.Consensus{Block: tmversion.BlockProtocol, App: 2},
+		ChainID:            chainID,
+		Height:             blockHeight,
+		Time:               timestamp,
+		LastBlockID:        ibctesting.MakeBlockID(make([]byte, tmhash.Size), 10_000, make([]byte, tmhash.Size)),
+		LastCommitHash:     chain.App.LastCommitID().Hash,
+		DataHash:           tmhash.Sum([]byte("data_hash")),
+		ValidatorsHash:     vsetHash,
+		NextValidatorsHash: vsetHash,
+		ConsensusHash:      tmhash.Sum([]byte("consensus_hash")),
+		AppHash:            chain.CurrentHeader.AppHash,
+		LastResultsHash:    tmhash.Sum([]byte("last_results_hash")),
+		EvidenceHash:       tmhash.Sum([]byte("evidence_hash")),
+		ProposerAddress:    chain.Vals.Proposer.Address, //nolint:staticcheck
+	}
+	blockID := ibctesting.MakeBlockID(tmHeader.Hash(), 3, tmhash.Sum([]byte("part_set")))
+	voteSet := tmtypes.NewVoteSet(chainID, blockHeight, 1, tmproto.PrecommitType, chain.Vals)
+
+	commit, err := tmtypes.MakeCommit(blockID, blockHeight, 1, voteSet, chain.Signers, timestamp)
+	require.NoError(chain.t, err)
+
+	valSet, err := chain.Vals.ToProto()
+	require.NoError(chain.t, err)
+
+	// The trusted fields are injected before relaying the header to a client
+	return &ibctmtypes.Header{
+		SignedHeader: &tmproto.SignedHeader{
+			Header: tmHeader.ToProto(),
+			Commit: commit.ToProto(),
+		},
+		ValidatorSet: valSet,
+	}
+}
+
+// ConnectionOpenInit will construct and execute a MsgConnectionOpenInit.
+func (chain *TestChain) ConnectionOpenInit(counterparty *TestChain, connection *TestConnection) error {
+	msg := connectiontypes.NewMsgConnectionOpenInit(
+		connection.ClientID, connection.CounterpartyClientID, counterparty.GetPrefix(),
+		ibctesting.DefaultOpenInitVersion, ibctesting.DefaultDelayPeriod, chain.SenderAccount.GetAddress(),
+	)
+	_, err := chain.SendMsgs(msg)
+	return err
+}
+
+// ConnectionOpenTry will construct and execute a MsgConnectionOpenTry.
+func (chain *TestChain) ConnectionOpenTry(counterparty *TestChain, connection, counterpartyConnection *TestConnection) error {
+	counterpartyClient, proofClient := counterparty.QueryClientStateProof(counterpartyConnection.ClientID)
+	proofInit, proofHeight := counterparty.QueryProof(host.ConnectionKey(counterpartyConnection.ID))
+	proofConsensus, consensusHeight := counterparty.QueryConsensusStateProof(counterpartyConnection.ClientID)
+
+	msg := connectiontypes.NewMsgConnectionOpenTry(
+		"", connection.ClientID, counterpartyConnection.ID, counterpartyConnection.ClientID, counterpartyClient,
+		counterparty.GetPrefix(), []*connectiontypes.Version{ibctesting.ConnectionVersion}, ibctesting.DefaultDelayPeriod,
+		proofInit, proofClient, proofConsensus, proofHeight, consensusHeight, chain.SenderAccount.GetAddress(),
+	)
+	_, err := chain.SendMsgs(msg)
+	return err
+}
+
+// ConnectionOpenAck will construct and execute a MsgConnectionOpenAck.
+func (chain *TestChain) ConnectionOpenAck(counterparty *TestChain, connection, counterpartyConnection *TestConnection) error {
+	counterpartyClient, proofClient := counterparty.QueryClientStateProof(counterpartyConnection.ClientID)
+	proofTry, proofHeight := counterparty.QueryProof(host.ConnectionKey(counterpartyConnection.ID))
+	proofConsensus, consensusHeight := counterparty.QueryConsensusStateProof(counterpartyConnection.ClientID)
+
+	msg := connectiontypes.NewMsgConnectionOpenAck(
+		connection.ID, counterpartyConnection.ID, counterpartyClient, proofTry, proofClient, proofConsensus,
+		proofHeight, consensusHeight, ibctesting.ConnectionVersion, chain.SenderAccount.GetAddress(),
+	)
+	_, err := chain.SendMsgs(msg)
+	return err
+}
+
+// ConnectionOpenConfirm will construct and execute a MsgConnectionOpenConfirm.
+func (chain *TestChain) ConnectionOpenConfirm(counterparty *TestChain, connection, counterpartyConnection *TestConnection) error {
+	proof, height := counterparty.QueryProof(host.ConnectionKey(counterpartyConnection.ID))
+
+	msg := connectiontypes.NewMsgConnectionOpenConfirm(connection.ID, proof, height, chain.SenderAccount.GetAddress())
+	_, err := chain.SendMsgs(msg)
+	return err
+}
+
+// ChanOpenInit will construct and execute a MsgChannelOpenInit.
+func (chain *TestChain) ChanOpenInit(ch, counterparty TestChannel, order channeltypes.Order, connectionID string) error {
+	msg := channeltypes.NewMsgChannelOpenInit(
+		ch.PortID, ch.Version, order, []string{connectionID}, counterparty.PortID, chain.SenderAccount.GetAddress(),
+	)
+	_, err := chain.SendMsgs(msg)
+	return err
+}
+
+// ChanOpenTry will construct and execute a MsgChannelOpenTry.
+func (chain *TestChain) ChanOpenTry(counterparty *TestChain, ch, counterpartyCh TestChannel, order channeltypes.Order,
+	connectionID string) error {
+	proof, height := counterparty.QueryProof(host.ChannelKey(counterpartyCh.PortID, counterpartyCh.ID))
+
+	msg := channeltypes.NewMsgChannelOpenTry(
+		ch.PortID, "", ch.Version, order, []string{connectionID}, counterpartyCh.PortID, counterpartyCh.ID,
+		counterpartyCh.Version, proof, height, chain.SenderAccount.GetAddress(),
+	)
+	_, err := chain.SendMsgs(msg)
+	return err
+}
+
+// ChanOpenAck will construct and execute a MsgChannelOpenAck.
+func (chain *TestChain) ChanOpenAck(counterparty *TestChain, ch, counterpartyCh TestChannel) error {
+	proof, height := counterparty.QueryProof(host.ChannelKey(counterpartyCh.PortID, counterpartyCh.ID))
+
+	msg := channeltypes.NewMsgChannelOpenAck(
+		ch.PortID, ch.ID, counterpartyCh.ID, counterpartyCh.Version, proof, height, chain.SenderAccount.GetAddress(),
+	)
+	_, err := chain.SendMsgs(msg)
+	return err
+}
+
+// ChanOpenConfirm will construct and execute a MsgChannelOpenConfirm.
+func (chain *TestChain) ChanOpenConfirm(counterparty *TestChain, ch, counterpartyCh TestChannel) error {
+	proof, height := counterparty.QueryProof(host.ChannelKey(counterpartyCh.PortID, counterpartyCh.ID))
+
+	msg := channeltypes.NewMsgChannelOpenConfirm(ch.PortID, ch.ID, proof, height, chain.SenderAccount.GetAddress())
+	_, err := chain.SendMsgs(msg)
+	return err
+}
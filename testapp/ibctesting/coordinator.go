@@ -0,0 +1,270 @@
+package ibctesting
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+	"github.com/cosmos/cosmos-sdk/x/ibc/core/exported"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+var (
+	ChainIDPrefix   = "testchain"
+	globalStartTime = time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+	TimeIncrement   = time.Second * 5
+)
+
+// Coordinator is a testing struct which contains N TestChain's. It handles keeping all chains in sync with regards to
+// time.
+type Coordinator struct {
+	t *testing.T
+
+	Chains map[string]*TestChain
+}
+
+// NewCoordinator initializes a Coordinator with N TestChain's, whose sender accounts hold the given coins and whose
+// genesis states are changed by modifyGenesis.
+func NewCoordinator(t *testing.T, n int, coins sdk.Coins, modifyGenesis GenesisModifier) *Coordinator {
+	chains := make(map[string]*TestChain)
+
+	for i := 0; i < n; i++ {
+		chainID := GetChainID(i)
+		chains[chainID] = NewTestChain(t, chainID, coins, modifyGenesis)
+	}
+	return &Coordinator{
+		t:      t,
+		Chains: chains,
+	}
+}
+
+// GetChainID returns the chainID used for the provided index.
+func GetChainID(index int) string {
+	return ChainIDPrefix + strconv.Itoa(index)
+}
+
+// GetChain returns the TestChain using the given chainID and returns an error if it does not exist.
+func (coord *Coordinator) GetChain(chainID string) *TestChain {
+	chain, found := coord.Chains[chainID]
+	require.True(coord.t, found, fmt.Sprintf("%s chain does not exist", chainID))
+	return chain
+}
+
+// Setup constructs a TM client, connection, and channel on both chains provided. It will fail if any error occurs.
+// The channel is opened between ports of the same id, which are expected to be bound to the bep3 module.
+func (coord *Coordinator) Setup(chainA, chainB *TestChain, portID string, order channeltypes.Order) (string, string,
+	*TestConnection, *TestConnection, TestChannel, TestChannel) {
+	clientA, clientB, connA, connB := coord.SetupClientConnections(chainA, chainB, exported.Tendermint)
+
+	channelA, channelB := coord.CreateChannel(chainA, chainB, connA, connB, portID, portID, order)
+	return clientA, clientB, connA, connB, channelA, channelB
+}
+
+// SetupClients is a helper function to create clients on both chains. It assumes the caller does not anticipate any
+// errors.
+func (coord *Coordinator) SetupClients(chainA, chainB *TestChain, clientType string) (string, string) {
+	clientA, err := coord.CreateClient(chainA, chainB, clientType)
+	require.NoError(coord.t, err)
+
+	clientB, err := coord.CreateClient(chainB, chainA, clientType)
+	require.NoError(coord.t, err)
+
+	return clientA, clientB
+}
+
+// SetupClientConnections is a helper function to create clients and the appropriate connections on both the source
+// and counterparty chain. It assumes the caller does not anticipate any errors.
+func (coord *Coordinator) SetupClientConnections(chainA, chainB *TestChain, clientType string) (string, string,
+	*TestConnection, *TestConnection) {
+	clientA, clientB := coord.SetupClients(chainA, chainB, clientType)
+
+	connA, connB := coord.CreateConnection(chainA, chainB, clientA, clientB)
+	return clientA, clientB, connA, connB
+}
+
+// CreateClient creates a counterparty client on the source chain and returns the clientID.
+func (coord *Coordinator) CreateClient(source, counterparty *TestChain, clientType string) (string, error) {
+	coord.CommitBlock(source, counterparty)
+
+	clientID := source.NewClientID(clientType)
+	if clientType != exported.Tendermint {
+		return "", fmt.Errorf("client type %s is not supported", clientType)
+	}
+	if err := source.CreateTMClient(counterparty, clientID); err != nil {
+		return "", err
+	}
+
+	coord.IncrementTime()
+	return clientID, nil
+}
+
+// UpdateClient updates a counterparty client on the source chain.
+func (coord *Coordinator) UpdateClient(source, counterparty *TestChain, clientID string) error {
+	coord.CommitBlock(source, counterparty)
+
+	if err := source.UpdateTMClient(counterparty, clientID); err != nil {
+		return err
+	}
+
+	coord.IncrementTime()
+	return nil
+}
+
+// CreateConnection constructs and executes connection handshake messages in order to create OPEN channels on chainA
+// and chainB. The connection information of for chainA and chainB are returned within a TestConnection struct.
+func (coord *Coordinator) CreateConnection(chainA, chainB *TestChain, clientA, clientB string) (*TestConnection, *TestConnection) {
+	connA := chainA.AddTestConnection(clientA, clientB)
+	connB := chainB.AddTestConnection(clientB, clientA)
+
+	require.NoError(coord.t, chainA.ConnectionOpenInit(chainB, connA))
+	require.NoError(coord.t, coord.UpdateClient(chainB, chainA, clientB))
+	require.NoError(coord.t, chainB.ConnectionOpenTry(chainA, connB, connA))
+	require.NoError(coord.t, coord.UpdateClient(chainA, chainB, clientA))
+	require.NoError(coord.t, chainA.ConnectionOpenAck(chainB, connA, connB))
+	require.NoError(coord.t, coord.UpdateClient(chainB, chainA, clientB))
+	require.NoError(coord.t, chainB.ConnectionOpenConfirm(chainA, connB, connA))
+	require.NoError(coord.t, coord.UpdateClient(chainA, chainB, clientA))
+
+	return connA, connB
+}
+
+// CreateChannel constructs and executes channel handshake messages in order to create OPEN channels on chainA and
+// chainB. The function expects the channels to be successfully opened otherwise testing will fail.
+func (coord *Coordinator) CreateChannel(chainA, chainB *TestChain, connA, connB *TestConnection,
+	sourcePortID, counterpartyPortID string, order channeltypes.Order) (TestChannel, TestChannel) {
+	channelA, channelB, err := coord.ChanOpenInit(chainA, chainB, connA, connB, sourcePortID, counterpartyPortID, order)
+	require.NoError(coord.t, err)
+
+	require.NoError(coord.t, coord.ChanOpenTry(chainB, chainA, channelB, channelA, connB, order))
+	require.NoError(coord.t, coord.ChanOpenAck(chainA, chainB, channelA, channelB))
+	require.NoError(coord.t, coord.ChanOpenConfirm(chainB, chainA, channelB, channelA))
+
+	return channelA, channelB
+}
+
+// ChanOpenInit initializes a channel on the source chain with the state INIT using the OpenInit handshake call. The
+// TestChannels are returned even if the handshake fails.
+func (coord *Coordinator) ChanOpenInit(source, counterparty *TestChain, connection, counterpartyConnection *TestConnection,
+	sourcePortID, counterpartyPortID string, order channeltypes.Order) (TestChannel, TestChannel, error) {
+	sourceChannel := source.AddTestChannel(connection, sourcePortID)
+	counterpartyChannel := counterparty.AddTestChannel(counterpartyConnection, counterpartyPortID)
+
+	if err := source.ChanOpenInit(sourceChannel, counterpartyChannel, order, connection.ID); err != nil {
+		return sourceChannel, counterpartyChannel, err
+	}
+
+	coord.IncrementTime()
+	err := coord.UpdateClient(counterparty, source, counterpartyConnection.ClientID)
+	return sourceChannel, counterpartyChannel, err
+}
+
+// ChanOpenTry initializes a channel on the source chain with the state TRYOPEN using the OpenTry handshake call.
+func (coord *Coordinator) ChanOpenTry(source, counterparty *TestChain, sourceChannel, counterpartyChannel TestChannel,
+	connection *TestConnection, order channeltypes.Order) error {
+	if err := source.ChanOpenTry(counterparty, sourceChannel, counterpartyChannel, order, connection.ID); err != nil {
+		return err
+	}
+
+	coord.IncrementTime()
+	return coord.UpdateClient(counterparty, source, connection.CounterpartyClientID)
+}
+
+// ChanOpenAck initializes a channel on the source chain with the state OPEN using the OpenAck handshake call.
+func (coord *Coordinator) ChanOpenAck(source, counterparty *TestChain, sourceChannel, counterpartyChannel TestChannel) error {
+	if err := source.ChanOpenAck(counterparty, sourceChannel, counterpartyChannel); err != nil {
+		return err
+	}
+
+	coord.IncrementTime()
+	return coord.UpdateClient(counterparty, source, sourceChannel.CounterpartyClientID)
+}
+
+// ChanOpenConfirm initializes a channel on the source chain with the state OPEN using the OpenConfirm handshake call.
+func (coord *Coordinator) ChanOpenConfirm(source, counterparty *TestChain, sourceChannel, counterpartyChannel TestChannel) error {
+	if err := source.ChanOpenConfirm(counterparty, sourceChannel, counterpartyChannel); err != nil {
+		return err
+	}
+
+	coord.IncrementTime()
+	return coord.UpdateClient(counterparty, source, sourceChannel.CounterpartyClientID)
+}
+
+// SendMsgs delivers the provided messages to the source chain and updates the client of the source chain on the
+// counterparty chain, so that packets sent by the messages can be relayed.
+func (coord *Coordinator) SendMsgs(source, counterparty *TestChain, counterpartyClientID string, msgs ...sdk.Msg) (*sdk.Result, error) {
+	res, err := source.SendMsgs(msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	coord.IncrementTime()
+	return res, coord.UpdateClient(counterparty, source, counterpartyClientID)
+}
+
+// RecvPacket receives a channel packet on the counterparty chain and updates the client of the counterparty chain on
+// the source chain. The packet is expected to be committed on the source chain. The result carries the events of an
+// acknowledgement written right away.
+func (coord *Coordinator) RecvPacket(source, counterparty *TestChain, sourceClient string, packet channeltypes.Packet) (*sdk.Result, error) {
+	proof, proofHeight := source.QueryProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+
+	recvMsg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, counterparty.SenderAccount.GetAddress())
+	return coord.SendMsgs(counterparty, source, sourceClient, recvMsg)
+}
+
+// AcknowledgePacket acknowledges the packet on the source chain with the acknowledgement the counterparty chain wrote
+// for it and updates the client of the source chain on the counterparty chain. Only a commitment of the
+// acknowledgement is stored, so the caller provides the acknowledgement itself.
+func (coord *Coordinator) AcknowledgePacket(source, counterparty *TestChain, counterpartyClient string,
+	packet channeltypes.Packet, ack []byte) error {
+	proof, proofHeight := counterparty.QueryProof(host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+
+	ackMsg := channeltypes.NewMsgAcknowledgement(packet, ack, proof, proofHeight, source.SenderAccount.GetAddress())
+	_, err := coord.SendMsgs(source, counterparty, counterpartyClient, ackMsg)
+	return err
+}
+
+// TimeoutPacket times the packet out on the source chain, proving the counterparty chain has not received it. The
+// time of the chains is expected to be past the timeout of the packet.
+func (coord *Coordinator) TimeoutPacket(source, counterparty *TestChain, sourceClient string, packet channeltypes.Packet) error {
+	if err := coord.UpdateClient(source, counterparty, sourceClient); err != nil {
+		return err
+	}
+	proof, proofHeight := counterparty.QueryProof(host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+
+	timeoutMsg := channeltypes.NewMsgTimeout(packet, packet.GetSequence(), proof, proofHeight, source.SenderAccount.GetAddress())
+	_, err := source.SendMsgs(timeoutMsg)
+	return err
+}
+
+// IncrementTime iterates through all the TestChain's and increments their current header time by 5 seconds.
+//
+// CONTRACT: this function must be called after every commit on any TestChain.
+func (coord *Coordinator) IncrementTime() {
+	coord.IncrementTimeBy(TimeIncrement)
+}
+
+// IncrementTimeBy iterates through all the TestChain's and increments their current header time by the given
+// duration.
+func (coord *Coordinator) IncrementTimeBy(increment time.Duration) {
+	for _, chain := range coord.Chains {
+		chain.CurrentHeader.Time = chain.CurrentHeader.Time.Add(increment)
+		chain.App.BeginBlock(abci.RequestBeginBlock{Header: chain.CurrentHeader})
+	}
+}
+
+// CommitBlock commits a block on the provided indexes and then increments the global time.
+//
+// CONTRACT: the passed in list of indexes must not contain duplicates
+func (coord *Coordinator) CommitBlock(chains ...*TestChain) {
+	for _, chain := range chains {
+		chain.App.EndBlock(abci.RequestEndBlock{Height: chain.CurrentHeader.Height})
+		chain.App.Commit()
+		chain.NextBlock()
+	}
+	coord.IncrementTime()
+}
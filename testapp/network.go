@@ -0,0 +1,58 @@
+package testapp
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bep3 "github.com/e-money/bep3/module"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// NewAppConstructor returns a network.AppConstructor creating an App for each validator of an in-process network.
+func NewAppConstructor(encodingConfig bep3.EncodingConfig) network.AppConstructor {
+	return func(val network.Validator) servertypes.Application {
+		return NewApp(
+			val.Ctx.Logger, dbm.NewMemDB(), nil, true, 0, encodingConfig,
+			baseapp.SetPruning(storetypes.NewPruningOptionsFromString(val.AppConfig.Pruning)),
+			baseapp.SetMinGasPrices(val.AppConfig.MinGasPrices),
+		)
+	}
+}
+
+// DefaultNetworkConfig returns the configuration of an in-process network of a single App validator, to run
+// CLI and gRPC end-to-end tests against with network.New. The bep3 genesis state can be replaced before the
+// network is started.
+func DefaultNetworkConfig() network.Config {
+	encodingConfig := MakeEncodingConfig()
+
+	return network.Config{
+		Codec:             encodingConfig.Marshaller,
+		TxConfig:          encodingConfig.TxConfig,
+		LegacyAmino:       encodingConfig.Amino,
+		InterfaceRegistry: encodingConfig.InterfaceRegistry,
+		AccountRetriever:  authtypes.AccountRetriever{},
+		AppConstructor:    NewAppConstructor(encodingConfig),
+		GenesisState:      NewDefaultGenesisState(encodingConfig.Marshaller),
+		TimeoutCommit:     time.Second,
+		ChainID:           "chain-" + tmrand.NewRand().Str(6),
+		NumValidators:     1,
+		BondDenom:         sdk.DefaultBondDenom,
+		MinGasPrices:      fmt.Sprintf("0.000006%s", sdk.DefaultBondDenom),
+		AccountTokens:     sdk.TokensFromConsensusPower(1000),
+		StakingTokens:     sdk.TokensFromConsensusPower(500),
+		BondedTokens:      sdk.TokensFromConsensusPower(100),
+		PruningStrategy:   storetypes.PruningOptionNothing,
+		CleanupDir:        true,
+		SigningAlgo:       string(hd.Secp256k1Type),
+		KeyringOptions:    []keyring.Option{},
+	}
+}
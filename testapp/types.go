@@ -1,7 +1,16 @@
 package testapp
 
-import "encoding/json"
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
 
 // Migrated from github/kava/app/genesis.go
 // GenesisState represents the genesis state of the blockchain. It is a map from module names to module genesis states.
 type GenesisState map[string]json.RawMessage
+
+// NewDefaultGenesisState returns the default genesis state of the test app.
+func NewDefaultGenesisState(cdc codec.JSONMarshaler) GenesisState {
+	return ModuleBasics.DefaultGenesis(cdc)
+}
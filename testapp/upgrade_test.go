@@ -0,0 +1,89 @@
+package testapp_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	bep3 "github.com/e-money/bep3/module"
+	"github.com/e-money/bep3/module/types"
+	"github.com/e-money/bep3/testapp"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestLongtermStorageUpgrade(t *testing.T) {
+	app := testapp.NewApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, 0, testapp.MakeEncodingConfig())
+	ctx := app.BaseApp.NewUncachedContext(false, tmproto.Header{Height: 9, Time: time.Unix(1600000000, 0).UTC()})
+
+	// State of a module version without the retention param and with a height keyed longterm storage index
+	randomNumberHash := types.CalculateRandomHash([]byte{1}, 1)
+	swap := types.NewAtomicSwap(sdk.NewCoins(sdk.NewInt64Coin("bnb", 50000)), randomNumberHash, 1000, 1,
+		sdk.AccAddress(crypto.AddressHash([]byte("sender"))), sdk.AccAddress(crypto.AddressHash([]byte("recipient"))),
+		"bnb1sender", "bnb1recipient", 5, types.Completed, true, types.Incoming)
+	app.Bep3Keeper.SetAtomicSwap(ctx, swap)
+	store := prefix.NewStore(ctx.KVStore(app.GetKey(bep3.StoreKey)), types.AtomicSwapLongtermStoragePrefix)
+	store.Set(types.GetAtomicSwapByHeightKey(uint64(swap.ClosedBlock)+100, swap.GetSwapID()), swap.GetSwapID())
+	app.GetSubspace(bep3.DefaultParamspace).Set(ctx, types.KeyAssetParams, []types.AssetParam{})
+	require.Equal(t, types.DefaultLongtermStorageRetention, app.Bep3Keeper.GetLongtermStorageRetention(ctx))
+
+	// The params can be read before the upgrade, as they are by queries and the begin blocker of the module
+	require.Equal(t, types.DefaultLongtermStorageRetention, app.Bep3Keeper.GetParams(ctx).LongtermStorageRetention)
+	_, found := app.Bep3Keeper.GetAssets(ctx)
+	require.False(t, found)
+
+	plan := upgradetypes.Plan{Name: bep3.LongtermStorageUpgradeName, Height: 10}
+	require.NoError(t, app.UpgradeKeeper.ScheduleUpgrade(ctx, plan))
+
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(ctx.BlockTime().Add(5 * time.Second))
+	upgrade.BeginBlocker(app.UpgradeKeeper, ctx, abci.RequestBeginBlock{})
+	require.Equal(t, int64(10), app.UpgradeKeeper.GetDoneHeight(ctx, bep3.LongtermStorageUpgradeName))
+
+	// The index is keyed by close time, which is the upgrade's block time for swaps closed before it
+	var keys [][]byte
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	require.Equal(t, [][]byte{types.GetAtomicSwapByTimestampKey(ctx.BlockTime().Unix(), swap.GetSwapID())}, keys)
+	require.Equal(t, types.DefaultLongtermStorageRetention, app.Bep3Keeper.GetLongtermStorageRetention(ctx))
+}
+
+func TestRandomNumberHashIndexUpgrade(t *testing.T) {
+	app := testapp.NewApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, 0, testapp.MakeEncodingConfig())
+	ctx := app.BaseApp.NewUncachedContext(false, tmproto.Header{Height: 9, Time: time.Unix(1600000000, 0).UTC()})
+
+	// Swaps of a module version without the byRandomNumberHash index, in every status
+	randomNumberHash := types.CalculateRandomHash([]byte{1}, 1)
+	store := prefix.NewStore(ctx.KVStore(app.GetKey(bep3.StoreKey)), types.AtomicSwapKeyPrefix)
+	var swapIDs [][]byte
+	for i, status := range []types.SwapStatus{types.Open, types.Expired, types.Completed} {
+		swap := types.NewAtomicSwap(sdk.NewCoins(sdk.NewInt64Coin("bnb", 50000)), randomNumberHash, 1000, 1,
+			sdk.AccAddress(crypto.AddressHash([]byte{byte(i)})), sdk.AccAddress(crypto.AddressHash([]byte("recipient"))),
+			"bnb1sender", "bnb1recipient", 5, status, true, types.Incoming)
+		store.Set(swap.GetSwapID(), app.AppCodec().MustMarshalBinaryLengthPrefixed(&swap))
+		swapIDs = append(swapIDs, swap.GetSwapID())
+	}
+	require.Empty(t, app.Bep3Keeper.GetAtomicSwapsByRandomNumberHash(ctx, randomNumberHash))
+
+	plan := upgradetypes.Plan{Name: bep3.LongtermStorageUpgradeName, Height: 10}
+	require.NoError(t, app.UpgradeKeeper.ScheduleUpgrade(ctx, plan))
+
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(ctx.BlockTime().Add(5 * time.Second))
+	upgrade.BeginBlocker(app.UpgradeKeeper, ctx, abci.RequestBeginBlock{})
+	require.Equal(t, int64(10), app.UpgradeKeeper.GetDoneHeight(ctx, bep3.LongtermStorageUpgradeName))
+
+	var indexed [][]byte
+	for _, swap := range app.Bep3Keeper.GetAtomicSwapsByRandomNumberHash(ctx, randomNumberHash) {
+		indexed = append(indexed, swap.GetSwapID())
+	}
+	require.ElementsMatch(t, swapIDs, indexed)
+}